@@ -0,0 +1,110 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/sdk/advancedsecurity (interfaces: Client)
+
+// Package azdosdkmocks is a generated GoMock package.
+package azdosdkmocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	advancedsecurity "github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/sdk/advancedsecurity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAdvancedsecurityClient is a mock of Client interface.
+type MockAdvancedsecurityClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockAdvancedsecurityClientMockRecorder
+	isgomock struct{}
+}
+
+// MockAdvancedsecurityClientMockRecorder is the mock recorder for MockAdvancedsecurityClient.
+type MockAdvancedsecurityClientMockRecorder struct {
+	mock *MockAdvancedsecurityClient
+}
+
+// NewMockAdvancedsecurityClient creates a new mock instance.
+func NewMockAdvancedsecurityClient(ctrl *gomock.Controller) *MockAdvancedsecurityClient {
+	mock := &MockAdvancedsecurityClient{ctrl: ctrl}
+	mock.recorder = &MockAdvancedsecurityClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAdvancedsecurityClient) EXPECT() *MockAdvancedsecurityClientMockRecorder {
+	return m.recorder
+}
+
+// GetAlerts mocks base method.
+func (m *MockAdvancedsecurityClient) GetAlerts(arg0 context.Context, arg1 advancedsecurity.GetAlertsArgs) (*advancedsecurity.GetAlertsResponseValue, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAlerts", arg0, arg1)
+	ret0, _ := ret[0].(*advancedsecurity.GetAlertsResponseValue)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAlerts indicates an expected call of GetAlerts.
+func (mr *MockAdvancedsecurityClientMockRecorder) GetAlerts(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAlerts", reflect.TypeOf((*MockAdvancedsecurityClient)(nil).GetAlerts), arg0, arg1)
+}
+
+// GetProjectEnablement mocks base method.
+func (m *MockAdvancedsecurityClient) GetProjectEnablement(arg0 context.Context, arg1 advancedsecurity.GetProjectEnablementArgs) (*advancedsecurity.ProjectEnablement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProjectEnablement", arg0, arg1)
+	ret0, _ := ret[0].(*advancedsecurity.ProjectEnablement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProjectEnablement indicates an expected call of GetProjectEnablement.
+func (mr *MockAdvancedsecurityClientMockRecorder) GetProjectEnablement(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjectEnablement", reflect.TypeOf((*MockAdvancedsecurityClient)(nil).GetProjectEnablement), arg0, arg1)
+}
+
+// GetRepositoryEnablement mocks base method.
+func (m *MockAdvancedsecurityClient) GetRepositoryEnablement(arg0 context.Context, arg1 advancedsecurity.GetRepositoryEnablementArgs) (*advancedsecurity.RepositoryEnablement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRepositoryEnablement", arg0, arg1)
+	ret0, _ := ret[0].(*advancedsecurity.RepositoryEnablement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRepositoryEnablement indicates an expected call of GetRepositoryEnablement.
+func (mr *MockAdvancedsecurityClientMockRecorder) GetRepositoryEnablement(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRepositoryEnablement", reflect.TypeOf((*MockAdvancedsecurityClient)(nil).GetRepositoryEnablement), arg0, arg1)
+}
+
+// UpdateProjectEnablement mocks base method.
+func (m *MockAdvancedsecurityClient) UpdateProjectEnablement(arg0 context.Context, arg1 advancedsecurity.UpdateProjectEnablementArgs) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProjectEnablement", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateProjectEnablement indicates an expected call of UpdateProjectEnablement.
+func (mr *MockAdvancedsecurityClientMockRecorder) UpdateProjectEnablement(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProjectEnablement", reflect.TypeOf((*MockAdvancedsecurityClient)(nil).UpdateProjectEnablement), arg0, arg1)
+}
+
+// UpdateRepositoryEnablement mocks base method.
+func (m *MockAdvancedsecurityClient) UpdateRepositoryEnablement(arg0 context.Context, arg1 advancedsecurity.UpdateRepositoryEnablementArgs) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRepositoryEnablement", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateRepositoryEnablement indicates an expected call of UpdateRepositoryEnablement.
+func (mr *MockAdvancedsecurityClientMockRecorder) UpdateRepositoryEnablement(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRepositoryEnablement", reflect.TypeOf((*MockAdvancedsecurityClient)(nil).UpdateRepositoryEnablement), arg0, arg1)
+}
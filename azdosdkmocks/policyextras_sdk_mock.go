@@ -0,0 +1,53 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/sdk/policyextras (interfaces: Client)
+
+// Package azdosdkmocks is a generated GoMock package.
+package azdosdkmocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	policy "github.com/microsoft/azure-devops-go-api/azuredevops/v7/policy"
+	policyextras "github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/sdk/policyextras"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPolicyextrasClient is a mock of Client interface.
+type MockPolicyextrasClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockPolicyextrasClientMockRecorder
+	isgomock struct{}
+}
+
+// MockPolicyextrasClientMockRecorder is the mock recorder for MockPolicyextrasClient.
+type MockPolicyextrasClientMockRecorder struct {
+	mock *MockPolicyextrasClient
+}
+
+// NewMockPolicyextrasClient creates a new mock instance.
+func NewMockPolicyextrasClient(ctrl *gomock.Controller) *MockPolicyextrasClient {
+	mock := &MockPolicyextrasClient{ctrl: ctrl}
+	mock.recorder = &MockPolicyextrasClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPolicyextrasClient) EXPECT() *MockPolicyextrasClientMockRecorder {
+	return m.recorder
+}
+
+// GetPolicyConfigurations mocks base method.
+func (m *MockPolicyextrasClient) GetPolicyConfigurations(arg0 context.Context, arg1 policyextras.GetPolicyConfigurationsArgs) (*policy.GetPolicyConfigurationsResponseValue, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPolicyConfigurations", arg0, arg1)
+	ret0, _ := ret[0].(*policy.GetPolicyConfigurationsResponseValue)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPolicyConfigurations indicates an expected call of GetPolicyConfigurations.
+func (mr *MockPolicyextrasClientMockRecorder) GetPolicyConfigurations(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPolicyConfigurations", reflect.TypeOf((*MockPolicyextrasClient)(nil).GetPolicyConfigurations), arg0, arg1)
+}
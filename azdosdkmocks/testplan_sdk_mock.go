@@ -0,0 +1,615 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/microsoft/azure-devops-go-api/azuredevops/v7/testplan (interfaces: Client)
+
+// Package azdosdkmocks is a generated GoMock package.
+package azdosdkmocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	testplan "github.com/microsoft/azure-devops-go-api/azuredevops/v7/testplan"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTestplanClient is a mock of Client interface.
+type MockTestplanClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockTestplanClientMockRecorder
+	isgomock struct{}
+}
+
+// MockTestplanClientMockRecorder is the mock recorder for MockTestplanClient.
+type MockTestplanClientMockRecorder struct {
+	mock *MockTestplanClient
+}
+
+// NewMockTestplanClient creates a new mock instance.
+func NewMockTestplanClient(ctrl *gomock.Controller) *MockTestplanClient {
+	mock := &MockTestplanClient{ctrl: ctrl}
+	mock.recorder = &MockTestplanClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTestplanClient) EXPECT() *MockTestplanClientMockRecorder {
+	return m.recorder
+}
+
+// AddTestCasesToSuite mocks base method.
+func (m *MockTestplanClient) AddTestCasesToSuite(arg0 context.Context, arg1 testplan.AddTestCasesToSuiteArgs) (*[]testplan.TestCase, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddTestCasesToSuite", arg0, arg1)
+	ret0, _ := ret[0].(*[]testplan.TestCase)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddTestCasesToSuite indicates an expected call of AddTestCasesToSuite.
+func (mr *MockTestplanClientMockRecorder) AddTestCasesToSuite(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTestCasesToSuite", reflect.TypeOf((*MockTestplanClient)(nil).AddTestCasesToSuite), arg0, arg1)
+}
+
+// CloneTestCase mocks base method.
+func (m *MockTestplanClient) CloneTestCase(arg0 context.Context, arg1 testplan.CloneTestCaseArgs) (*testplan.CloneTestCaseOperationInformation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloneTestCase", arg0, arg1)
+	ret0, _ := ret[0].(*testplan.CloneTestCaseOperationInformation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CloneTestCase indicates an expected call of CloneTestCase.
+func (mr *MockTestplanClientMockRecorder) CloneTestCase(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloneTestCase", reflect.TypeOf((*MockTestplanClient)(nil).CloneTestCase), arg0, arg1)
+}
+
+// CloneTestPlan mocks base method.
+func (m *MockTestplanClient) CloneTestPlan(arg0 context.Context, arg1 testplan.CloneTestPlanArgs) (*testplan.CloneTestPlanOperationInformation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloneTestPlan", arg0, arg1)
+	ret0, _ := ret[0].(*testplan.CloneTestPlanOperationInformation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CloneTestPlan indicates an expected call of CloneTestPlan.
+func (mr *MockTestplanClientMockRecorder) CloneTestPlan(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloneTestPlan", reflect.TypeOf((*MockTestplanClient)(nil).CloneTestPlan), arg0, arg1)
+}
+
+// CloneTestSuite mocks base method.
+func (m *MockTestplanClient) CloneTestSuite(arg0 context.Context, arg1 testplan.CloneTestSuiteArgs) (*testplan.CloneTestSuiteOperationInformation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloneTestSuite", arg0, arg1)
+	ret0, _ := ret[0].(*testplan.CloneTestSuiteOperationInformation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CloneTestSuite indicates an expected call of CloneTestSuite.
+func (mr *MockTestplanClientMockRecorder) CloneTestSuite(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloneTestSuite", reflect.TypeOf((*MockTestplanClient)(nil).CloneTestSuite), arg0, arg1)
+}
+
+// CreateTestConfiguration mocks base method.
+func (m *MockTestplanClient) CreateTestConfiguration(arg0 context.Context, arg1 testplan.CreateTestConfigurationArgs) (*testplan.TestConfiguration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTestConfiguration", arg0, arg1)
+	ret0, _ := ret[0].(*testplan.TestConfiguration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTestConfiguration indicates an expected call of CreateTestConfiguration.
+func (mr *MockTestplanClientMockRecorder) CreateTestConfiguration(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTestConfiguration", reflect.TypeOf((*MockTestplanClient)(nil).CreateTestConfiguration), arg0, arg1)
+}
+
+// CreateTestPlan mocks base method.
+func (m *MockTestplanClient) CreateTestPlan(arg0 context.Context, arg1 testplan.CreateTestPlanArgs) (*testplan.TestPlan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTestPlan", arg0, arg1)
+	ret0, _ := ret[0].(*testplan.TestPlan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTestPlan indicates an expected call of CreateTestPlan.
+func (mr *MockTestplanClientMockRecorder) CreateTestPlan(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTestPlan", reflect.TypeOf((*MockTestplanClient)(nil).CreateTestPlan), arg0, arg1)
+}
+
+// CreateTestSuite mocks base method.
+func (m *MockTestplanClient) CreateTestSuite(arg0 context.Context, arg1 testplan.CreateTestSuiteArgs) (*testplan.TestSuite, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTestSuite", arg0, arg1)
+	ret0, _ := ret[0].(*testplan.TestSuite)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTestSuite indicates an expected call of CreateTestSuite.
+func (mr *MockTestplanClientMockRecorder) CreateTestSuite(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTestSuite", reflect.TypeOf((*MockTestplanClient)(nil).CreateTestSuite), arg0, arg1)
+}
+
+// CreateTestVariable mocks base method.
+func (m *MockTestplanClient) CreateTestVariable(arg0 context.Context, arg1 testplan.CreateTestVariableArgs) (*testplan.TestVariable, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTestVariable", arg0, arg1)
+	ret0, _ := ret[0].(*testplan.TestVariable)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTestVariable indicates an expected call of CreateTestVariable.
+func (mr *MockTestplanClientMockRecorder) CreateTestVariable(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTestVariable", reflect.TypeOf((*MockTestplanClient)(nil).CreateTestVariable), arg0, arg1)
+}
+
+// DeleteTestCase mocks base method.
+func (m *MockTestplanClient) DeleteTestCase(arg0 context.Context, arg1 testplan.DeleteTestCaseArgs) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTestCase", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTestCase indicates an expected call of DeleteTestCase.
+func (mr *MockTestplanClientMockRecorder) DeleteTestCase(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTestCase", reflect.TypeOf((*MockTestplanClient)(nil).DeleteTestCase), arg0, arg1)
+}
+
+// DeleteTestConfguration mocks base method.
+func (m *MockTestplanClient) DeleteTestConfguration(arg0 context.Context, arg1 testplan.DeleteTestConfgurationArgs) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTestConfguration", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTestConfguration indicates an expected call of DeleteTestConfguration.
+func (mr *MockTestplanClientMockRecorder) DeleteTestConfguration(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTestConfguration", reflect.TypeOf((*MockTestplanClient)(nil).DeleteTestConfguration), arg0, arg1)
+}
+
+// DeleteTestPlan mocks base method.
+func (m *MockTestplanClient) DeleteTestPlan(arg0 context.Context, arg1 testplan.DeleteTestPlanArgs) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTestPlan", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTestPlan indicates an expected call of DeleteTestPlan.
+func (mr *MockTestplanClientMockRecorder) DeleteTestPlan(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTestPlan", reflect.TypeOf((*MockTestplanClient)(nil).DeleteTestPlan), arg0, arg1)
+}
+
+// DeleteTestSuite mocks base method.
+func (m *MockTestplanClient) DeleteTestSuite(arg0 context.Context, arg1 testplan.DeleteTestSuiteArgs) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTestSuite", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTestSuite indicates an expected call of DeleteTestSuite.
+func (mr *MockTestplanClientMockRecorder) DeleteTestSuite(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTestSuite", reflect.TypeOf((*MockTestplanClient)(nil).DeleteTestSuite), arg0, arg1)
+}
+
+// DeleteTestVariable mocks base method.
+func (m *MockTestplanClient) DeleteTestVariable(arg0 context.Context, arg1 testplan.DeleteTestVariableArgs) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTestVariable", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTestVariable indicates an expected call of DeleteTestVariable.
+func (mr *MockTestplanClientMockRecorder) DeleteTestVariable(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTestVariable", reflect.TypeOf((*MockTestplanClient)(nil).DeleteTestVariable), arg0, arg1)
+}
+
+// GetCloneInformation mocks base method.
+func (m *MockTestplanClient) GetCloneInformation(arg0 context.Context, arg1 testplan.GetCloneInformationArgs) (*testplan.CloneTestPlanOperationInformation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCloneInformation", arg0, arg1)
+	ret0, _ := ret[0].(*testplan.CloneTestPlanOperationInformation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCloneInformation indicates an expected call of GetCloneInformation.
+func (mr *MockTestplanClientMockRecorder) GetCloneInformation(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCloneInformation", reflect.TypeOf((*MockTestplanClient)(nil).GetCloneInformation), arg0, arg1)
+}
+
+// GetPoints mocks base method.
+func (m *MockTestplanClient) GetPoints(arg0 context.Context, arg1 testplan.GetPointsArgs) (*[]testplan.TestPoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPoints", arg0, arg1)
+	ret0, _ := ret[0].(*[]testplan.TestPoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPoints indicates an expected call of GetPoints.
+func (mr *MockTestplanClientMockRecorder) GetPoints(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPoints", reflect.TypeOf((*MockTestplanClient)(nil).GetPoints), arg0, arg1)
+}
+
+// GetPointsList mocks base method.
+func (m *MockTestplanClient) GetPointsList(arg0 context.Context, arg1 testplan.GetPointsListArgs) (*testplan.GetPointsListResponseValue, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPointsList", arg0, arg1)
+	ret0, _ := ret[0].(*testplan.GetPointsListResponseValue)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPointsList indicates an expected call of GetPointsList.
+func (mr *MockTestplanClientMockRecorder) GetPointsList(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPointsList", reflect.TypeOf((*MockTestplanClient)(nil).GetPointsList), arg0, arg1)
+}
+
+// GetSuiteCloneInformation mocks base method.
+func (m *MockTestplanClient) GetSuiteCloneInformation(arg0 context.Context, arg1 testplan.GetSuiteCloneInformationArgs) (*testplan.CloneTestSuiteOperationInformation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSuiteCloneInformation", arg0, arg1)
+	ret0, _ := ret[0].(*testplan.CloneTestSuiteOperationInformation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSuiteCloneInformation indicates an expected call of GetSuiteCloneInformation.
+func (mr *MockTestplanClientMockRecorder) GetSuiteCloneInformation(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSuiteCloneInformation", reflect.TypeOf((*MockTestplanClient)(nil).GetSuiteCloneInformation), arg0, arg1)
+}
+
+// GetSuiteEntries mocks base method.
+func (m *MockTestplanClient) GetSuiteEntries(arg0 context.Context, arg1 testplan.GetSuiteEntriesArgs) (*[]testplan.SuiteEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSuiteEntries", arg0, arg1)
+	ret0, _ := ret[0].(*[]testplan.SuiteEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSuiteEntries indicates an expected call of GetSuiteEntries.
+func (mr *MockTestplanClientMockRecorder) GetSuiteEntries(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSuiteEntries", reflect.TypeOf((*MockTestplanClient)(nil).GetSuiteEntries), arg0, arg1)
+}
+
+// GetSuitesByTestCaseId mocks base method.
+func (m *MockTestplanClient) GetSuitesByTestCaseId(arg0 context.Context, arg1 testplan.GetSuitesByTestCaseIdArgs) (*[]testplan.TestSuite, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSuitesByTestCaseId", arg0, arg1)
+	ret0, _ := ret[0].(*[]testplan.TestSuite)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSuitesByTestCaseId indicates an expected call of GetSuitesByTestCaseId.
+func (mr *MockTestplanClientMockRecorder) GetSuitesByTestCaseId(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSuitesByTestCaseId", reflect.TypeOf((*MockTestplanClient)(nil).GetSuitesByTestCaseId), arg0, arg1)
+}
+
+// GetTestCase mocks base method.
+func (m *MockTestplanClient) GetTestCase(arg0 context.Context, arg1 testplan.GetTestCaseArgs) (*[]testplan.TestCase, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTestCase", arg0, arg1)
+	ret0, _ := ret[0].(*[]testplan.TestCase)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTestCase indicates an expected call of GetTestCase.
+func (mr *MockTestplanClientMockRecorder) GetTestCase(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTestCase", reflect.TypeOf((*MockTestplanClient)(nil).GetTestCase), arg0, arg1)
+}
+
+// GetTestCaseCloneInformation mocks base method.
+func (m *MockTestplanClient) GetTestCaseCloneInformation(arg0 context.Context, arg1 testplan.GetTestCaseCloneInformationArgs) (*testplan.CloneTestCaseOperationInformation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTestCaseCloneInformation", arg0, arg1)
+	ret0, _ := ret[0].(*testplan.CloneTestCaseOperationInformation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTestCaseCloneInformation indicates an expected call of GetTestCaseCloneInformation.
+func (mr *MockTestplanClientMockRecorder) GetTestCaseCloneInformation(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTestCaseCloneInformation", reflect.TypeOf((*MockTestplanClient)(nil).GetTestCaseCloneInformation), arg0, arg1)
+}
+
+// GetTestCaseList mocks base method.
+func (m *MockTestplanClient) GetTestCaseList(arg0 context.Context, arg1 testplan.GetTestCaseListArgs) (*testplan.GetTestCaseListResponseValue, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTestCaseList", arg0, arg1)
+	ret0, _ := ret[0].(*testplan.GetTestCaseListResponseValue)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTestCaseList indicates an expected call of GetTestCaseList.
+func (mr *MockTestplanClientMockRecorder) GetTestCaseList(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTestCaseList", reflect.TypeOf((*MockTestplanClient)(nil).GetTestCaseList), arg0, arg1)
+}
+
+// GetTestConfigurationById mocks base method.
+func (m *MockTestplanClient) GetTestConfigurationById(arg0 context.Context, arg1 testplan.GetTestConfigurationByIdArgs) (*testplan.TestConfiguration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTestConfigurationById", arg0, arg1)
+	ret0, _ := ret[0].(*testplan.TestConfiguration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTestConfigurationById indicates an expected call of GetTestConfigurationById.
+func (mr *MockTestplanClientMockRecorder) GetTestConfigurationById(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTestConfigurationById", reflect.TypeOf((*MockTestplanClient)(nil).GetTestConfigurationById), arg0, arg1)
+}
+
+// GetTestConfigurations mocks base method.
+func (m *MockTestplanClient) GetTestConfigurations(arg0 context.Context, arg1 testplan.GetTestConfigurationsArgs) (*testplan.GetTestConfigurationsResponseValue, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTestConfigurations", arg0, arg1)
+	ret0, _ := ret[0].(*testplan.GetTestConfigurationsResponseValue)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTestConfigurations indicates an expected call of GetTestConfigurations.
+func (mr *MockTestplanClientMockRecorder) GetTestConfigurations(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTestConfigurations", reflect.TypeOf((*MockTestplanClient)(nil).GetTestConfigurations), arg0, arg1)
+}
+
+// GetTestPlanById mocks base method.
+func (m *MockTestplanClient) GetTestPlanById(arg0 context.Context, arg1 testplan.GetTestPlanByIdArgs) (*testplan.TestPlan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTestPlanById", arg0, arg1)
+	ret0, _ := ret[0].(*testplan.TestPlan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTestPlanById indicates an expected call of GetTestPlanById.
+func (mr *MockTestplanClientMockRecorder) GetTestPlanById(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTestPlanById", reflect.TypeOf((*MockTestplanClient)(nil).GetTestPlanById), arg0, arg1)
+}
+
+// GetTestPlans mocks base method.
+func (m *MockTestplanClient) GetTestPlans(arg0 context.Context, arg1 testplan.GetTestPlansArgs) (*testplan.GetTestPlansResponseValue, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTestPlans", arg0, arg1)
+	ret0, _ := ret[0].(*testplan.GetTestPlansResponseValue)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTestPlans indicates an expected call of GetTestPlans.
+func (mr *MockTestplanClientMockRecorder) GetTestPlans(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTestPlans", reflect.TypeOf((*MockTestplanClient)(nil).GetTestPlans), arg0, arg1)
+}
+
+// GetTestSuiteById mocks base method.
+func (m *MockTestplanClient) GetTestSuiteById(arg0 context.Context, arg1 testplan.GetTestSuiteByIdArgs) (*testplan.TestSuite, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTestSuiteById", arg0, arg1)
+	ret0, _ := ret[0].(*testplan.TestSuite)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTestSuiteById indicates an expected call of GetTestSuiteById.
+func (mr *MockTestplanClientMockRecorder) GetTestSuiteById(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTestSuiteById", reflect.TypeOf((*MockTestplanClient)(nil).GetTestSuiteById), arg0, arg1)
+}
+
+// GetTestSuitesForPlan mocks base method.
+func (m *MockTestplanClient) GetTestSuitesForPlan(arg0 context.Context, arg1 testplan.GetTestSuitesForPlanArgs) (*testplan.GetTestSuitesForPlanResponseValue, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTestSuitesForPlan", arg0, arg1)
+	ret0, _ := ret[0].(*testplan.GetTestSuitesForPlanResponseValue)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTestSuitesForPlan indicates an expected call of GetTestSuitesForPlan.
+func (mr *MockTestplanClientMockRecorder) GetTestSuitesForPlan(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTestSuitesForPlan", reflect.TypeOf((*MockTestplanClient)(nil).GetTestSuitesForPlan), arg0, arg1)
+}
+
+// GetTestVariableById mocks base method.
+func (m *MockTestplanClient) GetTestVariableById(arg0 context.Context, arg1 testplan.GetTestVariableByIdArgs) (*testplan.TestVariable, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTestVariableById", arg0, arg1)
+	ret0, _ := ret[0].(*testplan.TestVariable)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTestVariableById indicates an expected call of GetTestVariableById.
+func (mr *MockTestplanClientMockRecorder) GetTestVariableById(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTestVariableById", reflect.TypeOf((*MockTestplanClient)(nil).GetTestVariableById), arg0, arg1)
+}
+
+// GetTestVariables mocks base method.
+func (m *MockTestplanClient) GetTestVariables(arg0 context.Context, arg1 testplan.GetTestVariablesArgs) (*testplan.GetTestVariablesResponseValue, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTestVariables", arg0, arg1)
+	ret0, _ := ret[0].(*testplan.GetTestVariablesResponseValue)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTestVariables indicates an expected call of GetTestVariables.
+func (mr *MockTestplanClientMockRecorder) GetTestVariables(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTestVariables", reflect.TypeOf((*MockTestplanClient)(nil).GetTestVariables), arg0, arg1)
+}
+
+// RemoveTestCasesFromSuite mocks base method.
+func (m *MockTestplanClient) RemoveTestCasesFromSuite(arg0 context.Context, arg1 testplan.RemoveTestCasesFromSuiteArgs) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveTestCasesFromSuite", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveTestCasesFromSuite indicates an expected call of RemoveTestCasesFromSuite.
+func (mr *MockTestplanClientMockRecorder) RemoveTestCasesFromSuite(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveTestCasesFromSuite", reflect.TypeOf((*MockTestplanClient)(nil).RemoveTestCasesFromSuite), arg0, arg1)
+}
+
+// RemoveTestCasesListFromSuite mocks base method.
+func (m *MockTestplanClient) RemoveTestCasesListFromSuite(arg0 context.Context, arg1 testplan.RemoveTestCasesListFromSuiteArgs) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveTestCasesListFromSuite", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveTestCasesListFromSuite indicates an expected call of RemoveTestCasesListFromSuite.
+func (mr *MockTestplanClientMockRecorder) RemoveTestCasesListFromSuite(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveTestCasesListFromSuite", reflect.TypeOf((*MockTestplanClient)(nil).RemoveTestCasesListFromSuite), arg0, arg1)
+}
+
+// ReorderSuiteEntries mocks base method.
+func (m *MockTestplanClient) ReorderSuiteEntries(arg0 context.Context, arg1 testplan.ReorderSuiteEntriesArgs) (*[]testplan.SuiteEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReorderSuiteEntries", arg0, arg1)
+	ret0, _ := ret[0].(*[]testplan.SuiteEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReorderSuiteEntries indicates an expected call of ReorderSuiteEntries.
+func (mr *MockTestplanClientMockRecorder) ReorderSuiteEntries(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReorderSuiteEntries", reflect.TypeOf((*MockTestplanClient)(nil).ReorderSuiteEntries), arg0, arg1)
+}
+
+// UpdateSuiteTestCases mocks base method.
+func (m *MockTestplanClient) UpdateSuiteTestCases(arg0 context.Context, arg1 testplan.UpdateSuiteTestCasesArgs) (*[]testplan.TestCase, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSuiteTestCases", arg0, arg1)
+	ret0, _ := ret[0].(*[]testplan.TestCase)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSuiteTestCases indicates an expected call of UpdateSuiteTestCases.
+func (mr *MockTestplanClientMockRecorder) UpdateSuiteTestCases(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSuiteTestCases", reflect.TypeOf((*MockTestplanClient)(nil).UpdateSuiteTestCases), arg0, arg1)
+}
+
+// UpdateTestConfiguration mocks base method.
+func (m *MockTestplanClient) UpdateTestConfiguration(arg0 context.Context, arg1 testplan.UpdateTestConfigurationArgs) (*testplan.TestConfiguration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTestConfiguration", arg0, arg1)
+	ret0, _ := ret[0].(*testplan.TestConfiguration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTestConfiguration indicates an expected call of UpdateTestConfiguration.
+func (mr *MockTestplanClientMockRecorder) UpdateTestConfiguration(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTestConfiguration", reflect.TypeOf((*MockTestplanClient)(nil).UpdateTestConfiguration), arg0, arg1)
+}
+
+// UpdateTestPlan mocks base method.
+func (m *MockTestplanClient) UpdateTestPlan(arg0 context.Context, arg1 testplan.UpdateTestPlanArgs) (*testplan.TestPlan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTestPlan", arg0, arg1)
+	ret0, _ := ret[0].(*testplan.TestPlan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTestPlan indicates an expected call of UpdateTestPlan.
+func (mr *MockTestplanClientMockRecorder) UpdateTestPlan(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTestPlan", reflect.TypeOf((*MockTestplanClient)(nil).UpdateTestPlan), arg0, arg1)
+}
+
+// UpdateTestPoints mocks base method.
+func (m *MockTestplanClient) UpdateTestPoints(arg0 context.Context, arg1 testplan.UpdateTestPointsArgs) (*[]testplan.TestPoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTestPoints", arg0, arg1)
+	ret0, _ := ret[0].(*[]testplan.TestPoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTestPoints indicates an expected call of UpdateTestPoints.
+func (mr *MockTestplanClientMockRecorder) UpdateTestPoints(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTestPoints", reflect.TypeOf((*MockTestplanClient)(nil).UpdateTestPoints), arg0, arg1)
+}
+
+// UpdateTestSuite mocks base method.
+func (m *MockTestplanClient) UpdateTestSuite(arg0 context.Context, arg1 testplan.UpdateTestSuiteArgs) (*testplan.TestSuite, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTestSuite", arg0, arg1)
+	ret0, _ := ret[0].(*testplan.TestSuite)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTestSuite indicates an expected call of UpdateTestSuite.
+func (mr *MockTestplanClientMockRecorder) UpdateTestSuite(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTestSuite", reflect.TypeOf((*MockTestplanClient)(nil).UpdateTestSuite), arg0, arg1)
+}
+
+// UpdateTestVariable mocks base method.
+func (m *MockTestplanClient) UpdateTestVariable(arg0 context.Context, arg1 testplan.UpdateTestVariableArgs) (*testplan.TestVariable, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTestVariable", arg0, arg1)
+	ret0, _ := ret[0].(*testplan.TestVariable)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTestVariable indicates an expected call of UpdateTestVariable.
+func (mr *MockTestplanClientMockRecorder) UpdateTestVariable(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTestVariable", reflect.TypeOf((*MockTestplanClient)(nil).UpdateTestVariable), arg0, arg1)
+}
@@ -0,0 +1,384 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/microsoft/azure-devops-go-api/azuredevops/v7/tfvc (interfaces: Client)
+
+// Package azdosdkmocks is a generated GoMock package.
+package azdosdkmocks
+
+import (
+	context "context"
+	io "io"
+	reflect "reflect"
+
+	git "github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+	tfvc "github.com/microsoft/azure-devops-go-api/azuredevops/v7/tfvc"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTfvcClient is a mock of Client interface.
+type MockTfvcClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockTfvcClientMockRecorder
+	isgomock struct{}
+}
+
+// MockTfvcClientMockRecorder is the mock recorder for MockTfvcClient.
+type MockTfvcClientMockRecorder struct {
+	mock *MockTfvcClient
+}
+
+// NewMockTfvcClient creates a new mock instance.
+func NewMockTfvcClient(ctrl *gomock.Controller) *MockTfvcClient {
+	mock := &MockTfvcClient{ctrl: ctrl}
+	mock.recorder = &MockTfvcClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTfvcClient) EXPECT() *MockTfvcClientMockRecorder {
+	return m.recorder
+}
+
+// CreateChangeset mocks base method.
+func (m *MockTfvcClient) CreateChangeset(arg0 context.Context, arg1 tfvc.CreateChangesetArgs) (*git.TfvcChangesetRef, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateChangeset", arg0, arg1)
+	ret0, _ := ret[0].(*git.TfvcChangesetRef)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateChangeset indicates an expected call of CreateChangeset.
+func (mr *MockTfvcClientMockRecorder) CreateChangeset(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateChangeset", reflect.TypeOf((*MockTfvcClient)(nil).CreateChangeset), arg0, arg1)
+}
+
+// GetBatchedChangesets mocks base method.
+func (m *MockTfvcClient) GetBatchedChangesets(arg0 context.Context, arg1 tfvc.GetBatchedChangesetsArgs) (*[]git.TfvcChangesetRef, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBatchedChangesets", arg0, arg1)
+	ret0, _ := ret[0].(*[]git.TfvcChangesetRef)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBatchedChangesets indicates an expected call of GetBatchedChangesets.
+func (mr *MockTfvcClientMockRecorder) GetBatchedChangesets(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBatchedChangesets", reflect.TypeOf((*MockTfvcClient)(nil).GetBatchedChangesets), arg0, arg1)
+}
+
+// GetBranch mocks base method.
+func (m *MockTfvcClient) GetBranch(arg0 context.Context, arg1 tfvc.GetBranchArgs) (*git.TfvcBranch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBranch", arg0, arg1)
+	ret0, _ := ret[0].(*git.TfvcBranch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBranch indicates an expected call of GetBranch.
+func (mr *MockTfvcClientMockRecorder) GetBranch(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBranch", reflect.TypeOf((*MockTfvcClient)(nil).GetBranch), arg0, arg1)
+}
+
+// GetBranchRefs mocks base method.
+func (m *MockTfvcClient) GetBranchRefs(arg0 context.Context, arg1 tfvc.GetBranchRefsArgs) (*[]git.TfvcBranchRef, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBranchRefs", arg0, arg1)
+	ret0, _ := ret[0].(*[]git.TfvcBranchRef)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBranchRefs indicates an expected call of GetBranchRefs.
+func (mr *MockTfvcClientMockRecorder) GetBranchRefs(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBranchRefs", reflect.TypeOf((*MockTfvcClient)(nil).GetBranchRefs), arg0, arg1)
+}
+
+// GetBranches mocks base method.
+func (m *MockTfvcClient) GetBranches(arg0 context.Context, arg1 tfvc.GetBranchesArgs) (*[]git.TfvcBranch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBranches", arg0, arg1)
+	ret0, _ := ret[0].(*[]git.TfvcBranch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBranches indicates an expected call of GetBranches.
+func (mr *MockTfvcClientMockRecorder) GetBranches(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBranches", reflect.TypeOf((*MockTfvcClient)(nil).GetBranches), arg0, arg1)
+}
+
+// GetChangeset mocks base method.
+func (m *MockTfvcClient) GetChangeset(arg0 context.Context, arg1 tfvc.GetChangesetArgs) (*git.TfvcChangeset, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChangeset", arg0, arg1)
+	ret0, _ := ret[0].(*git.TfvcChangeset)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChangeset indicates an expected call of GetChangeset.
+func (mr *MockTfvcClientMockRecorder) GetChangeset(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChangeset", reflect.TypeOf((*MockTfvcClient)(nil).GetChangeset), arg0, arg1)
+}
+
+// GetChangesetChanges mocks base method.
+func (m *MockTfvcClient) GetChangesetChanges(arg0 context.Context, arg1 tfvc.GetChangesetChangesArgs) (*tfvc.GetChangesetChangesResponseValue, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChangesetChanges", arg0, arg1)
+	ret0, _ := ret[0].(*tfvc.GetChangesetChangesResponseValue)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChangesetChanges indicates an expected call of GetChangesetChanges.
+func (mr *MockTfvcClientMockRecorder) GetChangesetChanges(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChangesetChanges", reflect.TypeOf((*MockTfvcClient)(nil).GetChangesetChanges), arg0, arg1)
+}
+
+// GetChangesetWorkItems mocks base method.
+func (m *MockTfvcClient) GetChangesetWorkItems(arg0 context.Context, arg1 tfvc.GetChangesetWorkItemsArgs) (*[]git.AssociatedWorkItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChangesetWorkItems", arg0, arg1)
+	ret0, _ := ret[0].(*[]git.AssociatedWorkItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChangesetWorkItems indicates an expected call of GetChangesetWorkItems.
+func (mr *MockTfvcClientMockRecorder) GetChangesetWorkItems(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChangesetWorkItems", reflect.TypeOf((*MockTfvcClient)(nil).GetChangesetWorkItems), arg0, arg1)
+}
+
+// GetChangesets mocks base method.
+func (m *MockTfvcClient) GetChangesets(arg0 context.Context, arg1 tfvc.GetChangesetsArgs) (*[]git.TfvcChangesetRef, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChangesets", arg0, arg1)
+	ret0, _ := ret[0].(*[]git.TfvcChangesetRef)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChangesets indicates an expected call of GetChangesets.
+func (mr *MockTfvcClientMockRecorder) GetChangesets(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChangesets", reflect.TypeOf((*MockTfvcClient)(nil).GetChangesets), arg0, arg1)
+}
+
+// GetItem mocks base method.
+func (m *MockTfvcClient) GetItem(arg0 context.Context, arg1 tfvc.GetItemArgs) (*git.TfvcItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetItem", arg0, arg1)
+	ret0, _ := ret[0].(*git.TfvcItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetItem indicates an expected call of GetItem.
+func (mr *MockTfvcClientMockRecorder) GetItem(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetItem", reflect.TypeOf((*MockTfvcClient)(nil).GetItem), arg0, arg1)
+}
+
+// GetItemContent mocks base method.
+func (m *MockTfvcClient) GetItemContent(arg0 context.Context, arg1 tfvc.GetItemContentArgs) (io.ReadCloser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetItemContent", arg0, arg1)
+	ret0, _ := ret[0].(io.ReadCloser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetItemContent indicates an expected call of GetItemContent.
+func (mr *MockTfvcClientMockRecorder) GetItemContent(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetItemContent", reflect.TypeOf((*MockTfvcClient)(nil).GetItemContent), arg0, arg1)
+}
+
+// GetItemText mocks base method.
+func (m *MockTfvcClient) GetItemText(arg0 context.Context, arg1 tfvc.GetItemTextArgs) (io.ReadCloser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetItemText", arg0, arg1)
+	ret0, _ := ret[0].(io.ReadCloser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetItemText indicates an expected call of GetItemText.
+func (mr *MockTfvcClientMockRecorder) GetItemText(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetItemText", reflect.TypeOf((*MockTfvcClient)(nil).GetItemText), arg0, arg1)
+}
+
+// GetItemZip mocks base method.
+func (m *MockTfvcClient) GetItemZip(arg0 context.Context, arg1 tfvc.GetItemZipArgs) (io.ReadCloser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetItemZip", arg0, arg1)
+	ret0, _ := ret[0].(io.ReadCloser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetItemZip indicates an expected call of GetItemZip.
+func (mr *MockTfvcClientMockRecorder) GetItemZip(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetItemZip", reflect.TypeOf((*MockTfvcClient)(nil).GetItemZip), arg0, arg1)
+}
+
+// GetItems mocks base method.
+func (m *MockTfvcClient) GetItems(arg0 context.Context, arg1 tfvc.GetItemsArgs) (*[]git.TfvcItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetItems", arg0, arg1)
+	ret0, _ := ret[0].(*[]git.TfvcItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetItems indicates an expected call of GetItems.
+func (mr *MockTfvcClientMockRecorder) GetItems(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetItems", reflect.TypeOf((*MockTfvcClient)(nil).GetItems), arg0, arg1)
+}
+
+// GetItemsBatch mocks base method.
+func (m *MockTfvcClient) GetItemsBatch(arg0 context.Context, arg1 tfvc.GetItemsBatchArgs) (*[][]git.TfvcItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetItemsBatch", arg0, arg1)
+	ret0, _ := ret[0].(*[][]git.TfvcItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetItemsBatch indicates an expected call of GetItemsBatch.
+func (mr *MockTfvcClientMockRecorder) GetItemsBatch(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetItemsBatch", reflect.TypeOf((*MockTfvcClient)(nil).GetItemsBatch), arg0, arg1)
+}
+
+// GetItemsBatchZip mocks base method.
+func (m *MockTfvcClient) GetItemsBatchZip(arg0 context.Context, arg1 tfvc.GetItemsBatchZipArgs) (io.ReadCloser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetItemsBatchZip", arg0, arg1)
+	ret0, _ := ret[0].(io.ReadCloser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetItemsBatchZip indicates an expected call of GetItemsBatchZip.
+func (mr *MockTfvcClientMockRecorder) GetItemsBatchZip(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetItemsBatchZip", reflect.TypeOf((*MockTfvcClient)(nil).GetItemsBatchZip), arg0, arg1)
+}
+
+// GetLabel mocks base method.
+func (m *MockTfvcClient) GetLabel(arg0 context.Context, arg1 tfvc.GetLabelArgs) (*git.TfvcLabel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLabel", arg0, arg1)
+	ret0, _ := ret[0].(*git.TfvcLabel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLabel indicates an expected call of GetLabel.
+func (mr *MockTfvcClientMockRecorder) GetLabel(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLabel", reflect.TypeOf((*MockTfvcClient)(nil).GetLabel), arg0, arg1)
+}
+
+// GetLabelItems mocks base method.
+func (m *MockTfvcClient) GetLabelItems(arg0 context.Context, arg1 tfvc.GetLabelItemsArgs) (*[]git.TfvcItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLabelItems", arg0, arg1)
+	ret0, _ := ret[0].(*[]git.TfvcItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLabelItems indicates an expected call of GetLabelItems.
+func (mr *MockTfvcClientMockRecorder) GetLabelItems(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLabelItems", reflect.TypeOf((*MockTfvcClient)(nil).GetLabelItems), arg0, arg1)
+}
+
+// GetLabels mocks base method.
+func (m *MockTfvcClient) GetLabels(arg0 context.Context, arg1 tfvc.GetLabelsArgs) (*[]git.TfvcLabelRef, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLabels", arg0, arg1)
+	ret0, _ := ret[0].(*[]git.TfvcLabelRef)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLabels indicates an expected call of GetLabels.
+func (mr *MockTfvcClientMockRecorder) GetLabels(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLabels", reflect.TypeOf((*MockTfvcClient)(nil).GetLabels), arg0, arg1)
+}
+
+// GetShelveset mocks base method.
+func (m *MockTfvcClient) GetShelveset(arg0 context.Context, arg1 tfvc.GetShelvesetArgs) (*git.TfvcShelveset, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetShelveset", arg0, arg1)
+	ret0, _ := ret[0].(*git.TfvcShelveset)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetShelveset indicates an expected call of GetShelveset.
+func (mr *MockTfvcClientMockRecorder) GetShelveset(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShelveset", reflect.TypeOf((*MockTfvcClient)(nil).GetShelveset), arg0, arg1)
+}
+
+// GetShelvesetChanges mocks base method.
+func (m *MockTfvcClient) GetShelvesetChanges(arg0 context.Context, arg1 tfvc.GetShelvesetChangesArgs) (*[]git.TfvcChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetShelvesetChanges", arg0, arg1)
+	ret0, _ := ret[0].(*[]git.TfvcChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetShelvesetChanges indicates an expected call of GetShelvesetChanges.
+func (mr *MockTfvcClientMockRecorder) GetShelvesetChanges(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShelvesetChanges", reflect.TypeOf((*MockTfvcClient)(nil).GetShelvesetChanges), arg0, arg1)
+}
+
+// GetShelvesetWorkItems mocks base method.
+func (m *MockTfvcClient) GetShelvesetWorkItems(arg0 context.Context, arg1 tfvc.GetShelvesetWorkItemsArgs) (*[]git.AssociatedWorkItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetShelvesetWorkItems", arg0, arg1)
+	ret0, _ := ret[0].(*[]git.AssociatedWorkItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetShelvesetWorkItems indicates an expected call of GetShelvesetWorkItems.
+func (mr *MockTfvcClientMockRecorder) GetShelvesetWorkItems(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShelvesetWorkItems", reflect.TypeOf((*MockTfvcClient)(nil).GetShelvesetWorkItems), arg0, arg1)
+}
+
+// GetShelvesets mocks base method.
+func (m *MockTfvcClient) GetShelvesets(arg0 context.Context, arg1 tfvc.GetShelvesetsArgs) (*[]git.TfvcShelvesetRef, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetShelvesets", arg0, arg1)
+	ret0, _ := ret[0].(*[]git.TfvcShelvesetRef)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetShelvesets indicates an expected call of GetShelvesets.
+func (mr *MockTfvcClientMockRecorder) GetShelvesets(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShelvesets", reflect.TypeOf((*MockTfvcClient)(nil).GetShelvesets), arg0, arg1)
+}
@@ -0,0 +1,111 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/sdk/workitemtrackingextras (interfaces: Client)
+
+// Package azdosdkmocks is a generated GoMock package.
+package azdosdkmocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	workitemtrackingextras "github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/sdk/workitemtrackingextras"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockWorkitemtrackingextrasClient is a mock of Client interface.
+type MockWorkitemtrackingextrasClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockWorkitemtrackingextrasClientMockRecorder
+	isgomock struct{}
+}
+
+// MockWorkitemtrackingextrasClientMockRecorder is the mock recorder for MockWorkitemtrackingextrasClient.
+type MockWorkitemtrackingextrasClientMockRecorder struct {
+	mock *MockWorkitemtrackingextrasClient
+}
+
+// NewMockWorkitemtrackingextrasClient creates a new mock instance.
+func NewMockWorkitemtrackingextrasClient(ctrl *gomock.Controller) *MockWorkitemtrackingextrasClient {
+	mock := &MockWorkitemtrackingextrasClient{ctrl: ctrl}
+	mock.recorder = &MockWorkitemtrackingextrasClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWorkitemtrackingextrasClient) EXPECT() *MockWorkitemtrackingextrasClientMockRecorder {
+	return m.recorder
+}
+
+// CreateChartConfiguration mocks base method.
+func (m *MockWorkitemtrackingextrasClient) CreateChartConfiguration(arg0 context.Context, arg1 workitemtrackingextras.CreateChartConfigurationArgs) (*workitemtrackingextras.ChartConfiguration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateChartConfiguration", arg0, arg1)
+	ret0, _ := ret[0].(*workitemtrackingextras.ChartConfiguration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateChartConfiguration indicates an expected call of CreateChartConfiguration.
+func (mr *MockWorkitemtrackingextrasClientMockRecorder) CreateChartConfiguration(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateChartConfiguration", reflect.TypeOf((*MockWorkitemtrackingextrasClient)(nil).CreateChartConfiguration), arg0, arg1)
+}
+
+// DeleteChartConfiguration mocks base method.
+func (m *MockWorkitemtrackingextrasClient) DeleteChartConfiguration(arg0 context.Context, arg1 workitemtrackingextras.DeleteChartConfigurationArgs) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteChartConfiguration", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteChartConfiguration indicates an expected call of DeleteChartConfiguration.
+func (mr *MockWorkitemtrackingextrasClientMockRecorder) DeleteChartConfiguration(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteChartConfiguration", reflect.TypeOf((*MockWorkitemtrackingextrasClient)(nil).DeleteChartConfiguration), arg0, arg1)
+}
+
+// GetChartConfigurations mocks base method.
+func (m *MockWorkitemtrackingextrasClient) GetChartConfigurations(arg0 context.Context, arg1 workitemtrackingextras.GetChartConfigurationsArgs) (*[]workitemtrackingextras.ChartConfiguration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChartConfigurations", arg0, arg1)
+	ret0, _ := ret[0].(*[]workitemtrackingextras.ChartConfiguration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChartConfigurations indicates an expected call of GetChartConfigurations.
+func (mr *MockWorkitemtrackingextrasClientMockRecorder) GetChartConfigurations(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChartConfigurations", reflect.TypeOf((*MockWorkitemtrackingextrasClient)(nil).GetChartConfigurations), arg0, arg1)
+}
+
+// SendBatch mocks base method.
+func (m *MockWorkitemtrackingextrasClient) SendBatch(arg0 context.Context, arg1 workitemtrackingextras.SendBatchArgs) (*[]workitemtrackingextras.BatchResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendBatch", arg0, arg1)
+	ret0, _ := ret[0].(*[]workitemtrackingextras.BatchResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SendBatch indicates an expected call of SendBatch.
+func (mr *MockWorkitemtrackingextrasClientMockRecorder) SendBatch(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendBatch", reflect.TypeOf((*MockWorkitemtrackingextrasClient)(nil).SendBatch), arg0, arg1)
+}
+
+// UpdateChartConfiguration mocks base method.
+func (m *MockWorkitemtrackingextrasClient) UpdateChartConfiguration(arg0 context.Context, arg1 workitemtrackingextras.UpdateChartConfigurationArgs) (*workitemtrackingextras.ChartConfiguration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateChartConfiguration", arg0, arg1)
+	ret0, _ := ret[0].(*workitemtrackingextras.ChartConfiguration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateChartConfiguration indicates an expected call of UpdateChartConfiguration.
+func (mr *MockWorkitemtrackingextrasClientMockRecorder) UpdateChartConfiguration(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateChartConfiguration", reflect.TypeOf((*MockWorkitemtrackingextrasClient)(nil).UpdateChartConfiguration), arg0, arg1)
+}
@@ -0,0 +1,39 @@
+package acceptancetests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+func TestAccAdvancedSecurityAlertsDataSource_basic(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	gitRepoName := testutils.GenerateResourceName()
+	tfNode := "data.azuredevops_advanced_security_alerts.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testutils.PreCheck(t, &[]string{"AZDO_TEST_ADVANCED_SECURITY"}) },
+		ProviderFactories: testutils.GetProviderFactories(),
+		CheckDestroy:      testutils.CheckProjectDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+%s
+
+data "azuredevops_advanced_security_alerts" "test" {
+  project_id    = azuredevops_project.project.id
+  repository_id = azuredevops_git_repository.repository.id
+  alert_type    = "dependency"
+  depends_on    = [azuredevops_advanced_security.test]
+}
+`, hclAdvancedSecurityRepository(projectName, gitRepoName, false)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "total_count", "0"),
+					resource.TestCheckResourceAttr(tfNode, "critical_count", "0"),
+				),
+			},
+		},
+	})
+}
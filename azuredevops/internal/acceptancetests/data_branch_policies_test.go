@@ -0,0 +1,80 @@
+package acceptancetests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+func TestAccDataBranchPolicies_basic(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	repoName := testutils.GenerateResourceName()
+	tfNode := "data.azuredevops_branch_policies.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testutils.PreCheck(t, nil) },
+		ProviderFactories: testutils.GetProviderFactories(),
+		CheckDestroy:      testutils.CheckProjectDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: hclDataBranchPolicies(projectName, repoName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "policies.#", "1"),
+					resource.TestCheckResourceAttr(tfNode, "policies.0.resource_type", "azuredevops_branch_policy_min_reviewers"),
+					resource.TestCheckResourceAttr(tfNode, "policies.0.blocking", "true"),
+					resource.TestCheckResourceAttr(tfNode, "policies.0.scope.0.repository_ref", "refs/heads/main"),
+					resource.TestCheckResourceAttrPair(tfNode, "policies.0.id", "azuredevops_branch_policy_min_reviewers.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func hclDataBranchPolicies(projectName string, repoName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azuredevops_git_repository" "repository" {
+  project_id = azuredevops_project.project.id
+  name       = "%s"
+  initialization {
+    init_type = "Clean"
+  }
+}
+
+resource "azuredevops_branch_policy_min_reviewers" "test" {
+  project_id = azuredevops_project.project.id
+  settings {
+    reviewer_count = 1
+    scope {
+      repository_id  = azuredevops_git_repository.repository.id
+      repository_ref = "refs/heads/main"
+      match_type     = "Exact"
+    }
+  }
+}
+
+resource "azuredevops_branch_policy_comment_resolution" "other" {
+  project_id = azuredevops_project.project.id
+  settings {
+    scope {
+      repository_id  = azuredevops_git_repository.repository.id
+      repository_ref = "refs/heads/develop"
+      match_type     = "Exact"
+    }
+  }
+}
+
+data "azuredevops_branch_policies" "test" {
+  project_id     = azuredevops_project.project.id
+  repository_id  = azuredevops_git_repository.repository.id
+  repository_ref = "refs/heads/main"
+  depends_on = [
+    azuredevops_branch_policy_min_reviewers.test,
+    azuredevops_branch_policy_comment_resolution.other,
+  ]
+}
+`, testutils.HclProjectResource(projectName), repoName)
+}
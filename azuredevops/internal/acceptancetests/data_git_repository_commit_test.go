@@ -0,0 +1,39 @@
+package acceptancetests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+func TestAccGitRepositoryCommit_DataSource(t *testing.T) {
+	tfNode := "data.azuredevops_git_repository_commit.test"
+	projectName := testutils.GenerateResourceName()
+	repoName := testutils.GenerateResourceName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testutils.PreCheck(t, nil) },
+		ProviderFactories: testutils.GetProviderFactories(),
+		CheckDestroy:      testutils.CheckProjectDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+%s
+
+data "azuredevops_git_repository_commit" "test" {
+  repository_id = azuredevops_git_repository.repository.id
+  branch        = azuredevops_git_repository_branch.release.name
+}
+`, hclDataGitRepositoryContent(projectName, repoName)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(tfNode, "commit_id", "azuredevops_git_repository_branch.release", "last_commit_id"),
+					resource.TestCheckResourceAttr(tfNode, "message", "Add version"),
+					resource.TestCheckResourceAttr(tfNode, "parent_ids.#", "1"),
+					resource.TestCheckResourceAttrSet(tfNode, "author_email"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,45 @@
+package acceptancetests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+func TestAccGitRepositoryPullRequestStatuses_DataSource(t *testing.T) {
+	tfNode := "data.azuredevops_git_repository_pull_request_statuses.test"
+	projectName := testutils.GenerateResourceName()
+	repoName := testutils.GenerateResourceName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testutils.PreCheck(t, nil) },
+		ProviderFactories: testutils.GetProviderFactories(),
+		CheckDestroy:      testutils.CheckProjectDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+%s
+
+resource "azuredevops_git_repository" "repository" {
+  project_id = azuredevops_project.project.id
+  name       = "%s"
+  initialization {
+    init_type = "Clean"
+  }
+}
+
+data "azuredevops_git_repository_pull_request_statuses" "test" {
+  repository_id      = azuredevops_git_repository.repository.id
+  pull_request_count = 10
+}
+`, testutils.HclProjectResource(projectName), repoName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "pull_request_count", "10"),
+					resource.TestCheckResourceAttr(tfNode, "statuses.#", "0"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,72 @@
+package acceptancetests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+func TestAccGitRepositoryRefs_DataSource(t *testing.T) {
+	tfNode := "data.azuredevops_git_repository_refs.test"
+	projectName := testutils.GenerateResourceName()
+	repoName := testutils.GenerateResourceName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testutils.PreCheck(t, nil) },
+		ProviderFactories: testutils.GetProviderFactories(),
+		CheckDestroy:      testutils.CheckProjectDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+%s
+
+data "azuredevops_git_repository_refs" "test" {
+  repository_id = azuredevops_git_repository.repository.id
+  type          = "branch"
+  prefix        = "releases/"
+  depends_on    = [azuredevops_git_repository_branch.release]
+}
+`, hclDataGitRepositoryContent(projectName, repoName)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "refs.#", "1"),
+					resource.TestCheckResourceAttr(tfNode, "refs.0.name", "refs/heads/releases/1.0"),
+					resource.TestCheckResourceAttr(tfNode, "refs.0.short_name", "releases/1.0"),
+					resource.TestCheckResourceAttrSet(tfNode, "refs.0.commit_id"),
+				),
+			},
+		},
+	})
+}
+
+// hclDataGitRepositoryContent a repository with a file in a folder and a release branch
+func hclDataGitRepositoryContent(projectName string, repoName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azuredevops_git_repository" "repository" {
+  project_id = azuredevops_project.project.id
+  name       = "%s"
+  initialization {
+    init_type = "Clean"
+  }
+}
+
+resource "azuredevops_git_repository_file" "file" {
+  repository_id       = azuredevops_git_repository.repository.id
+  branch              = "refs/heads/master"
+  file                = "src/version.txt"
+  content             = "1.0.0"
+  commit_message      = "Add version"
+  overwrite_on_create = true
+}
+
+resource "azuredevops_git_repository_branch" "release" {
+  repository_id = azuredevops_git_repository.repository.id
+  name          = "releases/1.0"
+  ref_branch    = "master"
+  depends_on    = [azuredevops_git_repository_file.file]
+}
+`, testutils.HclProjectResource(projectName), repoName)
+}
@@ -0,0 +1,40 @@
+package acceptancetests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+func TestAccGitRepositoryTree_DataSource(t *testing.T) {
+	tfNode := "data.azuredevops_git_repository_tree.test"
+	projectName := testutils.GenerateResourceName()
+	repoName := testutils.GenerateResourceName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testutils.PreCheck(t, nil) },
+		ProviderFactories: testutils.GetProviderFactories(),
+		CheckDestroy:      testutils.CheckProjectDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+%s
+
+data "azuredevops_git_repository_tree" "test" {
+  repository_id = azuredevops_git_repository.repository.id
+  branch        = azuredevops_git_repository_branch.release.name
+  path          = "/src"
+  recursive     = true
+}
+`, hclDataGitRepositoryContent(projectName, repoName)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "items.#", "1"),
+					resource.TestCheckResourceAttr(tfNode, "items.0.path", "/src/version.txt"),
+					resource.TestCheckResourceAttr(tfNode, "items.0.type", "blob"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,41 @@
+package acceptancetests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+func TestAccDataPolicyTypes_basic(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	tfNode := "data.azuredevops_policy_types.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testutils.PreCheck(t, nil) },
+		ProviderFactories: testutils.GetProviderFactories(),
+		CheckDestroy:      testutils.CheckProjectDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: hclDataPolicyTypes(projectName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(tfNode, "policy_types.#"),
+					resource.TestCheckTypeSetElemNestedAttrs(tfNode, "policy_types.*", map[string]string{
+						"id": "fa4e907d-c16b-4a4c-9dfa-4906e5d171dd",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func hclDataPolicyTypes(projectName string) string {
+	return fmt.Sprintf(`
+%s
+
+data "azuredevops_policy_types" "test" {
+  project_id = azuredevops_project.project.id
+}
+`, testutils.HclProjectResource(projectName))
+}
@@ -0,0 +1,66 @@
+package acceptancetests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+func TestAccDataPullRequestPolicyEvaluations_basic(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	gitRepoName := testutils.GenerateResourceName()
+	tfNode := "data.azuredevops_pull_request_policy_evaluations.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testutils.PreCheck(t, nil) },
+		ProviderFactories: testutils.GetProviderFactories(),
+		CheckDestroy:      testutils.CheckProjectDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: hclDataPullRequestPolicyEvaluations(projectName, gitRepoName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "evaluations.#", "1"),
+					resource.TestCheckResourceAttrPair(tfNode, "evaluations.0.configuration_id", "azuredevops_branch_policy_min_reviewers.test", "id"),
+					resource.TestCheckResourceAttr(tfNode, "evaluations.0.type_id", "fa4e907d-c16b-4a4c-9dfa-4906e5d171dd"),
+					resource.TestCheckResourceAttr(tfNode, "evaluations.0.blocking", "true"),
+					resource.TestCheckResourceAttrSet(tfNode, "evaluations.0.status"),
+				),
+			},
+		},
+	})
+}
+
+func hclDataPullRequestPolicyEvaluations(projectName string, gitRepoName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azuredevops_branch_policy_min_reviewers" "test" {
+  project_id = azuredevops_project.project.id
+  settings {
+    reviewer_count = 2
+    scope {
+      repository_id  = azuredevops_git_repository.repository.id
+      repository_ref = "refs/heads/master"
+      match_type     = "Exact"
+    }
+  }
+}
+
+resource "azuredevops_git_pull_request" "test" {
+  repository_id = azuredevops_git_repository.repository.id
+  target_branch = "refs/heads/master"
+  title         = "Update readme"
+  file = {
+    "README.md" = "readme"
+  }
+  depends_on = [azuredevops_branch_policy_min_reviewers.test]
+}
+
+data "azuredevops_pull_request_policy_evaluations" "test" {
+  project_id      = azuredevops_project.project.id
+  pull_request_id = azuredevops_git_pull_request.test.pull_request_id
+}
+`, hclGitPullRequestRepository(projectName, gitRepoName))
+}
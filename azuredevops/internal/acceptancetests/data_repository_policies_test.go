@@ -0,0 +1,70 @@
+package acceptancetests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+func TestAccDataRepositoryPolicies_basic(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	repoName := testutils.GenerateResourceName()
+	tfNode := "data.azuredevops_repository_policies.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testutils.PreCheck(t, nil) },
+		ProviderFactories: testutils.GetProviderFactories(),
+		CheckDestroy:      testutils.CheckProjectDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: hclDataRepositoryPolicies(projectName, repoName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "policies.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(tfNode, "policies.*", map[string]string{
+						"scope": "project",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(tfNode, "policies.*", map[string]string{
+						"scope": "repository",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func hclDataRepositoryPolicies(projectName string, repoName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azuredevops_git_repository" "repository" {
+  project_id = azuredevops_project.project.id
+  name       = "%s"
+  initialization {
+    init_type = "Clean"
+  }
+}
+
+resource "azuredevops_repository_policy_max_file_size" "project" {
+  project_id    = azuredevops_project.project.id
+  max_file_size = 10
+  depends_on    = [azuredevops_git_repository.repository]
+}
+
+resource "azuredevops_repository_policy_max_path_length" "repository" {
+  project_id      = azuredevops_project.project.id
+  max_path_length = 500
+  repository_ids  = [azuredevops_git_repository.repository.id]
+}
+
+data "azuredevops_repository_policies" "test" {
+  project_id    = azuredevops_project.project.id
+  repository_id = azuredevops_git_repository.repository.id
+  depends_on = [
+    azuredevops_repository_policy_max_file_size.project,
+    azuredevops_repository_policy_max_path_length.repository,
+  ]
+}
+`, testutils.HclProjectResource(projectName), repoName)
+}
@@ -0,0 +1,48 @@
+package acceptancetests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+func TestAccWorkItemQueryResultsDataSource_basic(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	workItemTitle := testutils.GenerateResourceName()
+	tfNode := "data.azuredevops_workitemquery_results.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testutils.PreCheck(t, nil) },
+		ProviderFactories: testutils.GetProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: hclWorkItemQueryResultsDataSource(projectName, workItemTitle),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "count", "1"),
+					resource.TestCheckResourceAttr(tfNode, "ids.#", "1"),
+					resource.TestCheckResourceAttrPair(tfNode, "ids.0", "azuredevops_workitem.test", "id"),
+					resource.TestCheckResourceAttr(tfNode, "work_items.0.fields.System.Title", workItemTitle),
+				),
+			},
+		},
+	})
+}
+
+func hclWorkItemQueryResultsDataSource(projectName string, workItemTitle string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azuredevops_workitem" "test" {
+  project_id = azuredevops_project.project.id
+  title      = "%s"
+  type       = "Issue"
+}
+
+data "azuredevops_workitemquery_results" "test" {
+  project_id = azuredevops_project.project.id
+  wiql       = "SELECT [System.Id], [System.Title] FROM WorkItems WHERE [System.Id] = ${azuredevops_workitem.test.id}"
+}
+`, testutils.HclProjectResource(projectName), workItemTitle)
+}
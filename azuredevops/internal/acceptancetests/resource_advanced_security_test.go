@@ -0,0 +1,95 @@
+package acceptancetests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+// Advanced Security is billed per active committer, the tests only run if the organization opted in
+func TestAccAdvancedSecurity_repository(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	gitRepoName := testutils.GenerateResourceName()
+	resNode := "azuredevops_advanced_security.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testutils.PreCheck(t, &[]string{"AZDO_TEST_ADVANCED_SECURITY"}) },
+		ProviderFactories: testutils.GetProviderFactories(),
+		CheckDestroy:      testutils.CheckProjectDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: hclAdvancedSecurityRepository(projectName, gitRepoName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resNode, "repository_id"),
+					resource.TestCheckResourceAttr(resNode, "enabled", "true"),
+					resource.TestCheckResourceAttr(resNode, "push_protection_enabled", "false"),
+				),
+			},
+			{
+				Config: hclAdvancedSecurityRepository(projectName, gitRepoName, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resNode, "push_protection_enabled", "true"),
+				),
+			},
+			{
+				ResourceName:      resNode,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAdvancedSecurity_project(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	resNode := "azuredevops_advanced_security.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testutils.PreCheck(t, &[]string{"AZDO_TEST_ADVANCED_SECURITY"}) },
+		ProviderFactories: testutils.GetProviderFactories(),
+		CheckDestroy:      testutils.CheckProjectDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+%s
+
+resource "azuredevops_advanced_security" "test" {
+  project_id       = azuredevops_project.project.id
+  enable_on_create = true
+}
+`, testutils.HclProjectResource(projectName)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resNode, "repository_id", ""),
+					resource.TestCheckResourceAttr(resNode, "enable_on_create", "true"),
+				),
+			},
+			{
+				ResourceName:      resNode,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func hclAdvancedSecurityRepository(projectName string, gitRepoName string, pushProtection bool) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azuredevops_git_repository" "repository" {
+  project_id = azuredevops_project.project.id
+  name       = "%s"
+  initialization {
+    init_type = "Clean"
+  }
+}
+
+resource "azuredevops_advanced_security" "test" {
+  project_id              = azuredevops_project.project.id
+  repository_id           = azuredevops_git_repository.repository.id
+  push_protection_enabled = %t
+}
+`, testutils.HclProjectResource(projectName), gitRepoName, pushProtection)
+}
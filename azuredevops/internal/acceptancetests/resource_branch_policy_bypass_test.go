@@ -0,0 +1,140 @@
+package acceptancetests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+func TestAccBranchPolicyBypass_branch(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+
+	tfNode := "azuredevops_branch_policy_bypass.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testutils.PreCheck(t, nil) },
+		Providers:    testutils.GetProviders(),
+		CheckDestroy: testutils.CheckProjectDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: hclBranchPolicyBypass(projectName, true, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "branch_name", "main"),
+					resource.TestCheckResourceAttr(tfNode, "bypass_pull_request_policies", "true"),
+					resource.TestCheckResourceAttr(tfNode, "bypass_push_policies", "false"),
+				),
+			},
+			{
+				Config: hclBranchPolicyBypass(projectName, true, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "bypass_pull_request_policies", "true"),
+					resource.TestCheckResourceAttr(tfNode, "bypass_push_policies", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBranchPolicyBypass_refFolder(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+
+	tfNode := "azuredevops_branch_policy_bypass.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testutils.PreCheck(t, nil) },
+		Providers:    testutils.GetProviders(),
+		CheckDestroy: testutils.CheckProjectDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: hclBranchPolicyBypassRefFolder(projectName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "ref_folder", "refs/heads/releases/*"),
+					resource.TestCheckResourceAttr(tfNode, "bypass_push_policies", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBranchPolicyBypassPrincipals_dataSource(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+
+	tfNode := "data.azuredevops_branch_policy_bypass_principals.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testutils.PreCheck(t, nil) },
+		Providers:    testutils.GetProviders(),
+		CheckDestroy: testutils.CheckProjectDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: hclBranchPolicyBypassPrincipals(projectName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs(tfNode, "principals.*", map[string]string{
+						"bypass_pull_request_policies": "true",
+						"bypass_push_policies":         "false",
+					}),
+					resource.TestCheckTypeSetElemAttrPair(tfNode, "principals.*.descriptor", "data.azuredevops_group.test", "descriptor"),
+				),
+			},
+		},
+	})
+}
+
+func hclBranchPolicyBypassTemplate(projectName string) string {
+	return fmt.Sprintf(`
+resource "azuredevops_project" "test" {
+  name = "%s"
+}
+
+resource "azuredevops_git_repository" "test" {
+  project_id = azuredevops_project.test.id
+  name       = "repository"
+  initialization {
+    init_type = "Clean"
+  }
+}
+
+data "azuredevops_group" "test" {
+  project_id = azuredevops_project.test.id
+  name       = "Contributors"
+}`, projectName)
+}
+
+func hclBranchPolicyBypass(projectName string, pullRequest bool, push bool) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azuredevops_branch_policy_bypass" "test" {
+  project_id                   = azuredevops_project.test.id
+  repository_id                = azuredevops_git_repository.test.id
+  branch_name                  = "main"
+  principal                    = data.azuredevops_group.test.id
+  bypass_pull_request_policies = %t
+  bypass_push_policies         = %t
+}`, hclBranchPolicyBypassTemplate(projectName), pullRequest, push)
+}
+
+func hclBranchPolicyBypassRefFolder(projectName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azuredevops_branch_policy_bypass" "test" {
+  project_id           = azuredevops_project.test.id
+  repository_id        = azuredevops_git_repository.test.id
+  ref_folder           = "refs/heads/releases/*"
+  principal            = data.azuredevops_group.test.id
+  bypass_push_policies = true
+}`, hclBranchPolicyBypassTemplate(projectName))
+}
+
+func hclBranchPolicyBypassPrincipals(projectName string) string {
+	return fmt.Sprintf(`
+%s
+
+data "azuredevops_branch_policy_bypass_principals" "test" {
+  project_id    = azuredevops_project.test.id
+  repository_id = azuredevops_git_repository.test.id
+  branch_name   = "main"
+
+  depends_on = [azuredevops_branch_policy_bypass.test]
+}`, hclBranchPolicyBypass(projectName, true, false))
+}
@@ -74,6 +74,33 @@ func TestAccBranchPolicyAutoReviewers_minimumApproverCount(t *testing.T) {
 	})
 }
 
+func TestAccBranchPolicyAutoReviewers_reviewerGroup(t *testing.T) {
+	name := testutils.GenerateResourceName()
+	autoReviewerTfNode := "azuredevops_branch_policy_auto_reviewers.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testutils.PreCheck(t, nil) },
+		Providers: testutils.GetProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config: hclAutoReviewersReviewerGroup(name, 2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(autoReviewerTfNode, "settings.0.reviewer_group.#", "1"),
+					resource.TestCheckResourceAttr(autoReviewerTfNode, "settings.0.reviewer_group.0.name", "reviewer group"),
+					resource.TestCheckResourceAttrPair(autoReviewerTfNode, "settings.0.reviewer_group.0.descriptor", "azuredevops_group.test", "descriptor"),
+					resource.TestCheckResourceAttrPair(autoReviewerTfNode, "settings.0.reviewer_group.0.id", "azuredevops_group.test", "group_id"),
+					resource.TestCheckResourceAttr(autoReviewerTfNode, "settings.0.auto_reviewer_ids.#", "0"),
+					resource.TestCheckResourceAttr(autoReviewerTfNode, "settings.0.minimum_number_of_reviewers", "2"),
+				),
+			}, {
+				Config: hclAutoReviewersReviewerGroup(name, 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(autoReviewerTfNode, "settings.0.minimum_number_of_reviewers", "1"),
+				),
+			},
+		},
+	})
+}
+
 func hclAutoReviewersBasic(name string, enabled, blocking, submitterCanVote bool, message string) string {
 	return fmt.Sprintf(`
 resource "azuredevops_project" "test" {
@@ -146,3 +173,40 @@ resource "azuredevops_branch_policy_auto_reviewers" "test" {
 }
 `, name, enabled, blocking, submitterCanVote, message, numberOfApprovers)
 }
+
+func hclAutoReviewersReviewerGroup(name string, numberOfApprovers int) string {
+	return fmt.Sprintf(`
+resource "azuredevops_project" "test" {
+  name        = "%[1]s"
+  description = "description"
+}
+
+data "azuredevops_git_repository" "test" {
+  project_id = azuredevops_project.test.id
+  name       = "%[1]s"
+}
+
+resource "azuredevops_group" "test" {
+  scope        = azuredevops_project.test.id
+  display_name = "reviewer group"
+}
+
+resource "azuredevops_branch_policy_auto_reviewers" "test" {
+  project_id = azuredevops_project.test.id
+  settings {
+    minimum_number_of_reviewers = %[2]d
+    message                     = "Changes to the API require the approval of the reviewer group"
+    path_filters                = ["/src/Api/*", "!/src/Api/README.md"]
+    reviewer_group {
+      name = "reviewer group"
+    }
+    scope {
+      repository_id  = data.azuredevops_git_repository.test.id
+      repository_ref = "refs/heads/release"
+      match_type     = "Exact"
+    }
+  }
+  depends_on = [azuredevops_group.test]
+}
+`, name, numberOfApprovers)
+}
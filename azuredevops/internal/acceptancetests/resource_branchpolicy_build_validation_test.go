@@ -37,6 +37,44 @@ func TestAccBranchPolicyBuildValidation_basic(t *testing.T) {
 	})
 }
 
+func TestAccBranchPolicyBuildValidation_buildExpiration(t *testing.T) {
+	name := testutils.GenerateResourceName()
+	buildValidationTfNode := "azuredevops_branch_policy_build_validation.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testutils.PreCheck(t, nil) },
+		Providers: testutils.GetProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config: hclBuildValidationBuildExpiration(name, "Immediately"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(buildValidationTfNode, "settings.0.build_expiration", "Immediately"),
+					resource.TestCheckResourceAttr(buildValidationTfNode, "settings.0.queue_on_source_update_only", "false"),
+					resource.TestCheckResourceAttr(buildValidationTfNode, "settings.0.valid_duration", "0"),
+					resource.TestCheckResourceAttr(buildValidationTfNode, "settings.0.display_name", ""),
+				),
+			}, {
+				Config: hclBuildValidationBuildExpiration(name, "Never"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(buildValidationTfNode, "settings.0.build_expiration", "Never"),
+					resource.TestCheckResourceAttr(buildValidationTfNode, "settings.0.queue_on_source_update_only", "true"),
+				),
+			}, {
+				Config: hclBuildValidationBuildExpiration(name, "AfterDuration"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(buildValidationTfNode, "settings.0.build_expiration", "AfterDuration"),
+					resource.TestCheckResourceAttr(buildValidationTfNode, "settings.0.valid_duration", "720"),
+				),
+			}, {
+				ResourceName:            buildValidationTfNode,
+				ImportStateIdFunc:       testutils.ComputeProjectQualifiedResourceImportID(buildValidationTfNode),
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"settings.0.build_expiration"},
+			},
+		},
+	})
+}
+
 func hclBuildValidationBasic(name string, enabled, blocking bool, displayName string, validDuration int) string {
 	return fmt.Sprintf(`
 resource "azuredevops_project" "test" {
@@ -84,3 +122,47 @@ resource "azuredevops_branch_policy_build_validation" "test" {
   }
 }`, name, enabled, blocking, displayName, validDuration)
 }
+
+func hclBuildValidationBuildExpiration(name string, buildExpiration string) string {
+	return fmt.Sprintf(`
+resource "azuredevops_project" "test" {
+  name        = "%[1]s"
+  description = "description"
+}
+
+data "azuredevops_git_repository" "test" {
+  project_id = azuredevops_project.test.id
+  name       = "%[1]s"
+}
+
+resource "azuredevops_build_definition" "test" {
+  project_id      = azuredevops_project.test.id
+  name            = "Example Build Definition"
+  agent_pool_name = "Azure Pipelines"
+  path            = "\\"
+
+  repository {
+    repo_type   = "TfsGit"
+    repo_id     = data.azuredevops_git_repository.test.id
+    branch_name = "main"
+    yml_path    = "path/to/yaml"
+  }
+}
+
+resource "azuredevops_branch_policy_build_validation" "test" {
+  project_id = azuredevops_project.test.id
+  settings {
+    build_definition_id = azuredevops_build_definition.test.id
+    build_expiration    = "%[2]s"
+    filename_patterns = [
+      "/WebApp/*",
+      "!/WebApp/Tests/*",
+    ]
+    scope {
+      repository_id  = data.azuredevops_git_repository.test.id
+      repository_ref = "refs/heads/release"
+      match_type     = "Exact"
+    }
+  }
+}`, name, buildExpiration)
+}
@@ -0,0 +1,70 @@
+package acceptancetests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+func TestAccBranchPolicyGeneric_basic(t *testing.T) {
+	name := testutils.GenerateResourceName()
+	resourceNode := "azuredevops_branch_policy_generic.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testutils.PreCheck(t, nil) },
+		Providers: testutils.GetProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config: hclBranchPolicyGenericBasic(name, 1, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceNode, "type_id", "fa4e907d-c16b-4a4c-9dfa-4906e5d171dd"),
+					resource.TestCheckResourceAttr(resourceNode, "blocking", "true"),
+				),
+			}, {
+				Config: hclBranchPolicyGenericBasic(name, 2, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceNode, "blocking", "false"),
+				),
+			}, {
+				ResourceName:      resourceNode,
+				ImportStateIdFunc: testutils.ComputeProjectQualifiedResourceImportID(resourceNode),
+				ImportState:       true,
+				ImportStateVerify: true,
+				// All settings are tracked after an import, including the defaults added by the service
+				ImportStateVerifyIgnore: []string{"settings.0.json"},
+			},
+		},
+	})
+}
+
+func hclBranchPolicyGenericBasic(name string, approverCount int, blocking bool) string {
+	return fmt.Sprintf(`
+resource "azuredevops_project" "test" {
+  name        = "%[1]s"
+  description = "description"
+}
+
+data "azuredevops_git_repository" "test" {
+  project_id = azuredevops_project.test.id
+  name       = "%[1]s"
+}
+
+resource "azuredevops_branch_policy_generic" "test" {
+  project_id = azuredevops_project.test.id
+  type_id    = "fa4e907d-c16b-4a4c-9dfa-4906e5d171dd"
+  blocking   = %[3]t
+  settings {
+    json = jsonencode({
+      minimumApproverCount = %[2]d
+      creatorVoteCounts    = false
+    })
+    scope {
+      repository_id  = data.azuredevops_git_repository.test.id
+      repository_ref = "refs/heads/release"
+      match_type     = "Exact"
+    }
+  }
+}`, name, approverCount, blocking)
+}
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
 )
 
@@ -135,6 +136,60 @@ func TestAccBranchPolicyMinReviewers_requiresImportError(t *testing.T) {
 	})
 }
 
+func TestAccBranchPolicyMinReviewers_projectWidePrefixScope(t *testing.T) {
+	name := testutils.GenerateResourceName()
+	node := "azuredevops_branch_policy_min_reviewers.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testutils.PreCheck(t, nil) },
+		Providers: testutils.GetProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config: hclPolicyMinReviewersProjectWidePrefixScope(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(node, "id"),
+					resource.TestCheckNoResourceAttr(node, "settings.0.scope.0.repository_id"),
+					resource.TestCheckResourceAttr(node, "settings.0.scope.0.repository_ref", "refs/heads/release/"),
+					resource.TestCheckResourceAttr(node, "settings.0.scope.0.match_type", "Prefix"),
+				),
+			}, {
+				ResourceName:      node,
+				ImportStateIdFunc: testutils.ComputeProjectQualifiedResourceImportID(node),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccBranchPolicyMinReviewers_importByScope(t *testing.T) {
+	name := testutils.GenerateResourceName()
+	node := "azuredevops_branch_policy_min_reviewers.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testutils.PreCheck(t, nil) },
+		Providers: testutils.GetProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config: hclPolicyMinReviewersBasic(1, name),
+				Check:  resource.TestCheckResourceAttrSet(node, "id"),
+			}, {
+				ResourceName: node,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					res, ok := s.RootModule().Resources[node]
+					if !ok {
+						return "", fmt.Errorf("Did not find a resource with name: %s", node)
+					}
+					return fmt.Sprintf("%s/%s/refs/heads/release/fa4e907d-c16b-4a4c-9dfa-4906e5d171dd",
+						res.Primary.Attributes["project_id"], res.Primary.Attributes["settings.0.scope.0.repository_id"]), nil
+				},
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func hclPolicyMinReviewersTemplate(name string) string {
 	return fmt.Sprintf(`
 resource "azuredevops_project" "test" {
@@ -248,3 +303,35 @@ resource "azuredevops_branch_policy_min_reviewers" "import" {
 }
 `, template)
 }
+
+func hclPolicyMinReviewersProjectWidePrefixScope(name string) string {
+	template := hclPolicyMinReviewersTemplate(name)
+	return fmt.Sprintf(`
+
+%s
+
+resource "azuredevops_branch_policy_min_reviewers" "test" {
+  project_id = azuredevops_project.test.id
+  settings {
+    reviewer_count = 2
+    scope {
+      repository_ref = "refs/heads/release/"
+      match_type     = "Prefix"
+    }
+  }
+}
+
+resource "azuredevops_branch_policy_min_reviewers" "repository" {
+  project_id = azuredevops_project.test.id
+  settings {
+    reviewer_count = 1
+    scope {
+      repository_id  = data.azuredevops_git_repository.test.id
+      repository_ref = "refs/heads/release/1.0"
+      match_type     = "Exact"
+    }
+  }
+  depends_on = [azuredevops_branch_policy_min_reviewers.test]
+}
+`, template)
+}
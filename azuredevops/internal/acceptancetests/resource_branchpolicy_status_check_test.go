@@ -92,6 +92,27 @@ func TestAccBranchPolicyStatusCheckUpdate(t *testing.T) {
 	})
 }
 
+func TestAccBranchPolicyStatusCheck_authorDescriptor(t *testing.T) {
+	statusCheckTfNode := "azuredevops_branch_policy_status_check.p"
+	projectName := testutils.GenerateResourceName()
+	repoName := testutils.GenerateResourceName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testutils.PreCheck(t, nil) },
+		Providers: testutils.GetProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config: hclBranchPolicyStatusCheckResourceAuthor(projectName, repoName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(statusCheckTfNode, "settings.0.author.0.descriptor", "azuredevops_user_entitlement.user", "descriptor"),
+					resource.TestCheckResourceAttrPair(statusCheckTfNode, "settings.0.author.0.id", "azuredevops_user_entitlement.user", "id"),
+					resource.TestCheckResourceAttr(statusCheckTfNode, "settings.0.author_id", ""),
+				),
+			},
+		},
+	})
+}
+
 func hclBranchPolicyStatusCheckResourceTemplate(projectName string, repoName string) string {
 	return fmt.Sprintf(`
 resource "azuredevops_project" "p" {
@@ -207,3 +228,33 @@ resource "azuredevops_branch_policy_status_check" "p" {
 		hclBranchPolicyStatusCheckResourceTemplate(projectName, repoName),
 		statusCheck)
 }
+
+func hclBranchPolicyStatusCheckResourceAuthor(projectName string, repoName string) string {
+	return fmt.Sprintf(
+		`%s %s`,
+		hclBranchPolicyStatusCheckResourceTemplate(projectName, repoName), `
+
+resource "azuredevops_user_entitlement" "user" {
+  principal_name       = "mail@email.com"
+  account_license_type = "basic"
+}
+
+resource "azuredevops_branch_policy_status_check" "p" {
+  project_id = azuredevops_project.p.id
+
+  settings {
+    name  = "scan"
+    genre = "security"
+    author {
+      descriptor = azuredevops_user_entitlement.user.descriptor
+    }
+
+    scope {
+      repository_id  = azuredevops_git_repository.r.id
+      repository_ref = azuredevops_git_repository.r.default_branch
+      match_type     = "Exact"
+    }
+  }
+}
+`)
+}
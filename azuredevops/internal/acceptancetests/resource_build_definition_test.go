@@ -187,6 +187,35 @@ func TestAccBuildDefinition_otherGitRepositoryAgentJob_basic(t *testing.T) {
 	})
 }
 
+func TestAccBuildDefinition_tfvcRepository(t *testing.T) {
+	name := testutils.GenerateResourceName()
+
+	tfBuildDefNode := "azuredevops_build_definition.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testutils.PreCheck(t, nil) },
+		Providers:    testutils.GetProviders(),
+		CheckDestroy: checkBuildDefinitionDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: hclBuildDefinitionTfvcRepository(name),
+				Check: resource.ComposeTestCheckFunc(
+					checkBuildDefinitionExists(name),
+					resource.TestCheckResourceAttr(tfBuildDefNode, "repository.0.repo_type", "TfsVersionControl"),
+					resource.TestCheckResourceAttr(tfBuildDefNode, "repository.0.branch_name", "$/"+name),
+					resource.TestCheckResourceAttr(tfBuildDefNode, "repository.0.tfvc_mapping.#", "2"),
+					resource.TestCheckResourceAttr(tfBuildDefNode, "repository.0.tfvc_mapping.1.type", "cloak"),
+				),
+			}, {
+				ResourceName:            tfBuildDefNode,
+				ImportStateIdFunc:       testutils.ComputeProjectQualifiedResourceImportID(tfBuildDefNode),
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"skip_first_run"},
+			},
+		},
+	})
+}
+
 func TestAccBuildDefinition_otherGitRepositoryAgentJob_multiConfiguration(t *testing.T) {
 	name := testutils.GenerateResourceName()
 
@@ -890,6 +919,52 @@ resource "azuredevops_build_definition" "test" {
 `, template, name, path)
 }
 
+func hclBuildDefinitionTfvcRepository(name string) string {
+	return fmt.Sprintf(`
+resource "azuredevops_project" "test" {
+  name               = "%[1]s"
+  description        = "%[1]s-description"
+  visibility         = "private"
+  version_control    = "Tfvc"
+  work_item_template = "Agile"
+}
+
+resource "azuredevops_build_definition" "test" {
+  project_id          = azuredevops_project.test.id
+  name                = "%[1]s"
+  agent_specification = "windows-latest"
+
+  repository {
+    repo_type   = "TfsVersionControl"
+    repo_id     = "$/%[1]s"
+    branch_name = "$/%[1]s"
+
+    tfvc_mapping {
+      server_path = "$/%[1]s"
+      local_path  = "\\"
+    }
+
+    tfvc_mapping {
+      type        = "cloak"
+      server_path = "$/%[1]s/Docs"
+    }
+  }
+
+  jobs {
+    name      = "Agent Job1"
+    ref_name  = "agent_job1"
+    condition = "succeeded()"
+    target {
+      type = "AgentJob"
+      execution_options {
+        type = "None"
+      }
+    }
+  }
+}
+`, name)
+}
+
 func hclBuildDefinitionOtherGitRepositoryAgentJobMultiConfiguration(name, path string) string {
 	template := hclBuildDefinitionTemplate(name)
 	return fmt.Sprintf(`
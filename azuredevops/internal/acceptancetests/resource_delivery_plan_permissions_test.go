@@ -0,0 +1,123 @@
+package acceptancetests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/datahelper"
+)
+
+func TestAccDeliveryPlanPermissions_SetProjectPermissions(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	config := hclDeliveryPlanPermissions(projectName, false, map[string]string{
+		"View":   "Allow",
+		"Edit":   "Deny",
+		"Delete": "Deny",
+	})
+
+	tfNode := "azuredevops_delivery_plan_permissions.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testutils.PreCheck(t, nil) },
+		Providers:    testutils.GetProviders(),
+		CheckDestroy: testutils.CheckProjectDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testutils.CheckProjectExists(projectName),
+					resource.TestCheckResourceAttrSet(tfNode, "project_id"),
+					resource.TestCheckNoResourceAttr(tfNode, "plan_id"),
+					resource.TestCheckResourceAttr(tfNode, "permissions.%", "3"),
+					resource.TestCheckResourceAttr(tfNode, "permissions.View", "allow"),
+					resource.TestCheckResourceAttr(tfNode, "permissions.Edit", "deny"),
+					resource.TestCheckResourceAttr(tfNode, "permissions.Delete", "deny"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDeliveryPlanPermissions_UpdatePlanPermissions(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	config1 := hclDeliveryPlanPermissions(projectName, true, map[string]string{
+		"View":   "Allow",
+		"Edit":   "Allow",
+		"Manage": "NotSet",
+	})
+	config2 := hclDeliveryPlanPermissions(projectName, true, map[string]string{
+		"View":   "Allow",
+		"Edit":   "Deny",
+		"Manage": "Deny",
+	})
+
+	tfNode := "azuredevops_delivery_plan_permissions.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testutils.PreCheck(t, nil) },
+		Providers:    testutils.GetProviders(),
+		CheckDestroy: testutils.CheckProjectDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: config1,
+				Check: resource.ComposeTestCheckFunc(
+					testutils.CheckProjectExists(projectName),
+					resource.TestCheckResourceAttrSet(tfNode, "plan_id"),
+					resource.TestCheckResourceAttr(tfNode, "permissions.%", "3"),
+					resource.TestCheckResourceAttr(tfNode, "permissions.Edit", "allow"),
+					resource.TestCheckResourceAttr(tfNode, "permissions.Manage", "notset"),
+				),
+			},
+			{
+				Config: config2,
+				Check: resource.ComposeTestCheckFunc(
+					testutils.CheckProjectExists(projectName),
+					resource.TestCheckResourceAttrSet(tfNode, "plan_id"),
+					resource.TestCheckResourceAttr(tfNode, "permissions.%", "3"),
+					resource.TestCheckResourceAttr(tfNode, "permissions.Edit", "deny"),
+					resource.TestCheckResourceAttr(tfNode, "permissions.Manage", "deny"),
+				),
+			},
+		},
+	})
+}
+
+func hclDeliveryPlanPermissions(projectName string, planScoped bool, permissions map[string]string) string {
+	planID := ""
+	if planScoped {
+		planID = "plan_id    = azuredevops_delivery_plan.test.id"
+	}
+
+	return fmt.Sprintf(`
+%s
+
+resource "azuredevops_team" "test" {
+  project_id = azuredevops_project.project.id
+  name       = "%s team"
+}
+
+resource "azuredevops_delivery_plan" "test" {
+  project_id = azuredevops_project.project.id
+  name       = "%s plan"
+
+  team {
+    team_id       = azuredevops_team.test.id
+    backlog_level = "Microsoft.FeatureCategory"
+  }
+}
+
+data "azuredevops_group" "tf-project-readers" {
+  project_id = azuredevops_project.project.id
+  name       = "Readers"
+}
+
+resource "azuredevops_delivery_plan_permissions" "test" {
+  project_id = azuredevops_project.project.id
+  %s
+  principal  = data.azuredevops_group.tf-project-readers.id
+  permissions = {
+    %s
+  }
+}
+`, testutils.HclProjectResource(projectName), projectName, projectName, planID, datahelper.JoinMap(permissions, "=", "\n"))
+}
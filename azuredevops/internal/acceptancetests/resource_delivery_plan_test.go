@@ -0,0 +1,200 @@
+package acceptancetests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/work"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+)
+
+func TestAccDeliveryPlan_basic(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	name := testutils.GenerateResourceName()
+
+	tfNode := "azuredevops_delivery_plan.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testutils.PreCheck(t, nil) },
+		ProviderFactories: testutils.GetProviderFactories(),
+		CheckDestroy:      checkDeliveryPlanDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: hclDeliveryPlanBasic(projectName, name),
+				Check: resource.ComposeTestCheckFunc(
+					checkDeliveryPlanExist(name),
+					resource.TestCheckResourceAttr(tfNode, "name", name),
+					resource.TestCheckResourceAttr(tfNode, "team.#", "1"),
+					resource.TestCheckResourceAttrSet(tfNode, "revision"),
+				),
+			},
+			{
+				ResourceName:      tfNode,
+				ImportState:       true,
+				ImportStateIdFunc: testutils.ComputeProjectQualifiedResourceImportID(tfNode),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccDeliveryPlan_update(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	name := testutils.GenerateResourceName()
+
+	tfNode := "azuredevops_delivery_plan.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testutils.PreCheck(t, nil) },
+		ProviderFactories: testutils.GetProviderFactories(),
+		CheckDestroy:      checkDeliveryPlanDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: hclDeliveryPlanBasic(projectName, name),
+				Check: resource.ComposeTestCheckFunc(
+					checkDeliveryPlanExist(name),
+					resource.TestCheckResourceAttr(tfNode, "team.#", "1"),
+				),
+			},
+			{
+				Config: hclDeliveryPlanComplete(projectName, name+"update"),
+				Check: resource.ComposeTestCheckFunc(
+					checkDeliveryPlanExist(name+"update"),
+					resource.TestCheckResourceAttr(tfNode, "description", "description"),
+					resource.TestCheckResourceAttr(tfNode, "team.#", "2"),
+					resource.TestCheckResourceAttr(tfNode, "team.1.backlog_level", "Microsoft.RequirementCategory"),
+					resource.TestCheckResourceAttr(tfNode, "field_criteria.#", "1"),
+					resource.TestCheckResourceAttr(tfNode, "marker.#", "1"),
+					resource.TestCheckResourceAttr(tfNode, "marker.0.label", "Code freeze"),
+					resource.TestCheckResourceAttr(tfNode, "card_settings.0.show_parent", "true"),
+				),
+			},
+			{
+				ResourceName:      tfNode,
+				ImportState:       true,
+				ImportStateIdFunc: testutils.ComputeProjectQualifiedResourceImportID(tfNode),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func checkDeliveryPlanDestroyed(s *terraform.State) error {
+	clients := testutils.GetProvider().Meta().(*client.AggregatedClient)
+
+	for _, resource := range s.RootModule().Resources {
+		if resource.Type != "azuredevops_delivery_plan" {
+			continue
+		}
+
+		id := resource.Primary.ID
+		if _, err := clients.WorkClient.GetPlan(clients.Ctx, work.GetPlanArgs{
+			Project: converter.String(resource.Primary.Attributes["project_id"]),
+			Id:      converter.String(id),
+		}); err == nil {
+			return fmt.Errorf("Delivery plan with ID %s should not exist", id)
+		}
+	}
+	return nil
+}
+
+func checkDeliveryPlanExist(expectedName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		res, ok := s.RootModule().Resources["azuredevops_delivery_plan.test"]
+		if !ok {
+			return fmt.Errorf("Did not find a `azuredevops_delivery_plan` in the Terraform state")
+		}
+
+		clients := testutils.GetProvider().Meta().(*client.AggregatedClient)
+
+		id := res.Primary.ID
+		plan, err := clients.WorkClient.GetPlan(clients.Ctx, work.GetPlanArgs{
+			Project: converter.String(res.Primary.Attributes["project_id"]),
+			Id:      converter.String(id),
+		})
+		if err != nil {
+			return fmt.Errorf("Delivery plan with ID: %s cannot be found!. Error: %v", id, err)
+		}
+
+		if *plan.Name != expectedName {
+			return fmt.Errorf("Delivery plan with ID: %s has Name: %s, but expected Name: %s", id, *plan.Name, expectedName)
+		}
+		return nil
+	}
+}
+
+func hclDeliveryPlanTemplate(projectName string) string {
+	return fmt.Sprintf(`
+resource "azuredevops_project" "test" {
+  name               = "%[1]s"
+  work_item_template = "Agile"
+}
+
+resource "azuredevops_team" "test" {
+  project_id = azuredevops_project.test.id
+  name       = "%[1]s team"
+}
+
+resource "azuredevops_team" "second" {
+  project_id = azuredevops_project.test.id
+  name       = "%[1]s second team"
+}
+`, projectName)
+}
+
+func hclDeliveryPlanBasic(projectName, name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azuredevops_delivery_plan" "test" {
+  project_id = azuredevops_project.test.id
+  name       = "%s"
+
+  team {
+    team_id       = azuredevops_team.test.id
+    backlog_level = "Microsoft.FeatureCategory"
+  }
+}
+`, hclDeliveryPlanTemplate(projectName), name)
+}
+
+func hclDeliveryPlanComplete(projectName, name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azuredevops_delivery_plan" "test" {
+  project_id  = azuredevops_project.test.id
+  name        = "%s"
+  description = "description"
+
+  team {
+    team_id       = azuredevops_team.test.id
+    backlog_level = "Microsoft.FeatureCategory"
+  }
+
+  team {
+    team_id       = azuredevops_team.second.id
+    backlog_level = "Microsoft.RequirementCategory"
+  }
+
+  field_criteria {
+    field_name = "System.Tags"
+    operator   = "Contains"
+    value      = "release"
+  }
+
+  marker {
+    date  = "2030-01-01T00:00:00Z"
+    label = "Code freeze"
+    color = "#e60017"
+  }
+
+  card_settings {
+    show_parent       = true
+    show_child_rollup = true
+  }
+}
+`, hclDeliveryPlanTemplate(projectName), name)
+}
@@ -34,6 +34,26 @@ func TestAccGitPermissions_projectGroup(t *testing.T) {
 	})
 }
 
+func TestAccGitPermissions_refFolder(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+
+	tfNode := "azuredevops_git_permissions.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testutils.PreCheck(t, nil) },
+		Providers:    testutils.GetProviders(),
+		CheckDestroy: testutils.CheckProjectDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: hclGitPermissionsRefFolder(projectName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "ref_folder", "refs/heads/releases/*"),
+					resource.TestCheckResourceAttr(tfNode, "permissions.%", "2"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccGitPermissions_organizationGroup(t *testing.T) {
 	projectName := testutils.GenerateResourceName()
 
@@ -149,6 +169,37 @@ resource "azuredevops_git_permissions" "test" {
 }`, projectName)
 }
 
+func hclGitPermissionsRefFolder(projectName string) string {
+	return fmt.Sprintf(`
+resource "azuredevops_project" "test" {
+  name = "%s"
+}
+
+resource "azuredevops_git_repository" "test" {
+  project_id = azuredevops_project.test.id
+  name       = "repository"
+  initialization {
+    init_type = "Clean"
+  }
+}
+
+data "azuredevops_group" "test" {
+  project_id = azuredevops_project.test.id
+  name       = "Contributors"
+}
+
+resource "azuredevops_git_permissions" "test" {
+  project_id    = azuredevops_project.test.id
+  repository_id = azuredevops_git_repository.test.id
+  ref_folder    = "refs/heads/releases/*"
+  principal     = data.azuredevops_group.test.id
+  permissions = {
+    GenericContribute = "Deny"
+    CreateBranch      = "Deny"
+  }
+}`, projectName)
+}
+
 func hclGitPermissionsOrganizationGroup(projectName string) string {
 	return fmt.Sprintf(`
 resource "azuredevops_project" "test" {
@@ -0,0 +1,131 @@
+package acceptancetests
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+func TestAccGitPullRequest_files(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	gitRepoName := testutils.GenerateResourceName()
+	tfNode := "azuredevops_git_pull_request.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testutils.PreCheck(t, nil) },
+		ProviderFactories: testutils.GetProviderFactories(),
+		CheckDestroy:      testutils.CheckProjectDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: hclGitPullRequest(projectName, gitRepoName, "Update readme", "readme"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(tfNode, "pull_request_id"),
+					resource.TestCheckResourceAttrSet(tfNode, "commit_id"),
+					resource.TestCheckResourceAttr(tfNode, "status", "active"),
+					resource.TestCheckResourceAttr(tfNode, "target_branch", "refs/heads/master"),
+					resource.TestMatchResourceAttr(tfNode, "source_branch", regexp.MustCompile("^refs/heads/terraform/")),
+				),
+			},
+			{
+				Config: hclGitPullRequest(projectName, gitRepoName, "Update readme again", "readme updated"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "title", "Update readme again"),
+					resource.TestCheckResourceAttr(tfNode, "status", "active"),
+				),
+			},
+			{
+				ResourceName:            tfNode,
+				ImportState:             true,
+				ImportStateIdFunc:       testAccGitPullRequestImportID(tfNode),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"file", "file_object_ids", "commit_id", "commit_message", "source_branch_generated"},
+			},
+		},
+	})
+}
+
+func TestAccGitPullRequest_autoComplete(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	gitRepoName := testutils.GenerateResourceName()
+	tfNode := "azuredevops_git_pull_request.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testutils.PreCheck(t, nil) },
+		ProviderFactories: testutils.GetProviderFactories(),
+		CheckDestroy:      testutils.CheckProjectDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: hclGitPullRequestAutoComplete(projectName, gitRepoName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "status", "completed"),
+					resource.TestCheckResourceAttr(tfNode, "merge_strategy", "squash"),
+					resource.TestCheckResourceAttrSet(tfNode, "merge_commit_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccGitPullRequestImportID(resourceNode string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceNode]
+		if !ok {
+			return "", fmt.Errorf("Resource node not found: %s", resourceNode)
+		}
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["repository_id"], rs.Primary.ID), nil
+	}
+}
+
+func hclGitPullRequestRepository(projectName string, gitRepoName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azuredevops_git_repository" "repository" {
+  project_id = azuredevops_project.project.id
+  name       = "%s"
+  initialization {
+    init_type = "Clean"
+  }
+}
+`, testutils.HclProjectResource(projectName), gitRepoName)
+}
+
+func hclGitPullRequest(projectName string, gitRepoName string, title string, readme string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azuredevops_git_pull_request" "test" {
+  repository_id  = azuredevops_git_repository.repository.id
+  target_branch  = "refs/heads/master"
+  title          = "%s"
+  description    = "Managed by Terraform"
+  commit_message = "Update readme"
+  file = {
+    "README.md" = "%s"
+  }
+}
+`, hclGitPullRequestRepository(projectName, gitRepoName), title, readme)
+}
+
+func hclGitPullRequestAutoComplete(projectName string, gitRepoName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azuredevops_git_pull_request" "test" {
+  repository_id        = azuredevops_git_repository.repository.id
+  target_branch        = "master"
+  title                = "Add pipeline"
+  auto_complete        = true
+  merge_strategy       = "squash"
+  delete_source_branch = true
+  wait_for_completion  = true
+  file = {
+    "pipelines/ci.yml" = "trigger: none"
+  }
+}
+`, hclGitPullRequestRepository(projectName, gitRepoName))
+}
@@ -0,0 +1,60 @@
+package acceptancetests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+func TestAccGitRepoBranchLock_basic(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	gitRepoName := testutils.GenerateResourceName()
+	resNode := "azuredevops_git_repository_branch_lock.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testutils.PreCheck(t, nil) },
+		ProviderFactories: testutils.GetProviderFactories(),
+		CheckDestroy:      testutils.CheckProjectDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: hclGitRepoBranchLock(projectName, gitRepoName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resNode, "branch", "releases/1.0"),
+					resource.TestCheckResourceAttrSet(resNode, "locked_by"),
+				),
+			},
+			{
+				ResourceName:      resNode,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func hclGitRepoBranchLock(projectName string, gitRepoName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azuredevops_git_repository" "repository" {
+  project_id = azuredevops_project.project.id
+  name       = "%s"
+  initialization {
+    init_type = "Clean"
+  }
+}
+
+resource "azuredevops_git_repository_branch" "release" {
+  repository_id = azuredevops_git_repository.repository.id
+  name          = "releases/1.0"
+  ref_branch    = "master"
+}
+
+resource "azuredevops_git_repository_branch_lock" "test" {
+  repository_id = azuredevops_git_repository.repository.id
+  branch        = azuredevops_git_repository_branch.release.name
+}
+`, testutils.HclProjectResource(projectName), gitRepoName)
+}
@@ -0,0 +1,70 @@
+package acceptancetests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+func TestAccGitRepositoryFiles_basic(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	gitRepoName := testutils.GenerateResourceName()
+	tfNode := "azuredevops_git_repository_files.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testutils.PreCheck(t, nil) },
+		ProviderFactories: testutils.GetProviderFactories(),
+		CheckDestroy:      testutils.CheckProjectDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: hclGitRepositoryFiles(projectName, gitRepoName, `
+    "README.md"          = "readme"
+    "docs/guide.md"      = "guide"
+    "pipelines/ci.yml"   = "trigger: none"
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "file_object_ids.%", "3"),
+					// git hash-object of "readme"
+					resource.TestCheckResourceAttr(tfNode, "file_object_ids.README.md", "ea786ff2cf69cdc0e487ad1cea3b8bd361eb66a3"),
+					resource.TestCheckResourceAttrSet(tfNode, "commit_id"),
+				),
+			},
+			{
+				Config: hclGitRepositoryFiles(projectName, gitRepoName, `
+    "README.md"          = "readme updated"
+    "pipelines/ci.yml"   = "trigger: none"
+    "pipelines/cd.yml"   = "trigger: none"
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "file_object_ids.%", "3"),
+					resource.TestCheckNoResourceAttr(tfNode, "file_object_ids.docs/guide.md"),
+					resource.TestCheckResourceAttrSet(tfNode, "file_object_ids.pipelines/cd.yml"),
+				),
+			},
+		},
+	})
+}
+
+func hclGitRepositoryFiles(projectName string, gitRepoName string, files string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azuredevops_git_repository" "repository" {
+  project_id = azuredevops_project.project.id
+  name       = "%s"
+  initialization {
+    init_type = "Clean"
+  }
+}
+
+resource "azuredevops_git_repository_files" "test" {
+  repository_id  = azuredevops_git_repository.repository.id
+  commit_message = "Seed repository"
+  file = {
+%s
+  }
+}
+`, testutils.HclProjectResource(projectName), gitRepoName, files)
+}
@@ -0,0 +1,67 @@
+package acceptancetests
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+func TestAccGitRepositoryImport_public(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	gitRepoName := testutils.GenerateResourceName()
+	tfNode := "azuredevops_git_repository_import.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testutils.PreCheck(t, nil) },
+		ProviderFactories: testutils.GetProviderFactories(),
+		CheckDestroy:      testutils.CheckProjectDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: hclGitRepositoryImportRequest(projectName, gitRepoName, "https://github.com/microsoft/terraform-provider-azuredevops.git"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "status", "completed"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGitRepositoryImport_failureDetails(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	gitRepoName := testutils.GenerateResourceName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testutils.PreCheck(t, nil) },
+		ProviderFactories: testutils.GetProviderFactories(),
+		CheckDestroy:      testutils.CheckProjectDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config:      hclGitRepositoryImportRequest(projectName, gitRepoName, "https://github.com/microsoft/does-not-exist-"+gitRepoName+".git"),
+				ExpectError: regexp.MustCompile(`Creating import request|failed at step`),
+			},
+		},
+	})
+}
+
+func hclGitRepositoryImportRequest(projectName string, gitRepoName string, sourceURL string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azuredevops_git_repository" "repository" {
+  project_id = azuredevops_project.project.id
+  name       = "%s"
+  initialization {
+    init_type = "Uninitialized"
+  }
+}
+
+resource "azuredevops_git_repository_import" "test" {
+  project_id    = azuredevops_project.project.id
+  repository_id = azuredevops_git_repository.repository.id
+  source_url    = "%s"
+}
+`, testutils.HclProjectResource(projectName), gitRepoName, sourceURL)
+}
@@ -0,0 +1,91 @@
+package acceptancetests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+func TestAccGitRepositorySettings_basic(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	gitRepoName := testutils.GenerateResourceName()
+	resNode := "azuredevops_git_repository_settings.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testutils.PreCheck(t, nil) },
+		ProviderFactories: testutils.GetProviderFactories(),
+		CheckDestroy:      testutils.CheckProjectDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: hclGitRepositorySettings(projectName, gitRepoName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resNode, "repository_id"),
+					resource.TestCheckResourceAttr(resNode, "allow_forks", "false"),
+					resource.TestCheckResourceAttr(resNode, "strict_vote_mode", "true"),
+				),
+			},
+			{
+				Config: hclGitRepositorySettings(projectName, gitRepoName, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resNode, "allow_forks", "true"),
+				),
+			},
+			{
+				ResourceName:      resNode,
+				ImportStateIdFunc: testutils.ComputeProjectQualifiedResourceImportID(resNode),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccGitRepositorySettings_projectDefaultBranchName(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	resNode := "azuredevops_git_repository_settings.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testutils.PreCheck(t, nil) },
+		ProviderFactories: testutils.GetProviderFactories(),
+		CheckDestroy:      testutils.CheckProjectDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+%s
+
+resource "azuredevops_git_repository_settings" "test" {
+  project_id          = azuredevops_project.project.id
+  default_branch_name = "main"
+}
+`, testutils.HclProjectResource(projectName)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resNode, "repository_id", ""),
+					resource.TestCheckResourceAttr(resNode, "default_branch_name", "main"),
+				),
+			},
+		},
+	})
+}
+
+func hclGitRepositorySettings(projectName string, gitRepoName string, allowForks bool) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azuredevops_git_repository" "repository" {
+  project_id = azuredevops_project.project.id
+  name       = "%s"
+  initialization {
+    init_type = "Clean"
+  }
+}
+
+resource "azuredevops_git_repository_settings" "test" {
+  project_id       = azuredevops_project.project.id
+  repository_id    = azuredevops_git_repository.repository.id
+  allow_forks      = %t
+  strict_vote_mode = true
+}
+`, testutils.HclProjectResource(projectName), gitRepoName, allowForks)
+}
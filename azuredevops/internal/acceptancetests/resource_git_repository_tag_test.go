@@ -0,0 +1,85 @@
+package acceptancetests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+func TestAccGitRepoTag_lightweightAndAnnotated(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	gitRepoName := testutils.GenerateResourceName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testutils.PreCheck(t, nil) },
+		ProviderFactories: testutils.GetProviderFactories(),
+		CheckDestroy:      testutils.CheckProjectDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: hclGitRepoTags(projectName, gitRepoName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("azuredevops_git_repository_tag.lightweight", "commit_id", "azuredevops_git_repository_tag.lightweight", "object_id"),
+					resource.TestCheckResourceAttrPair("azuredevops_git_repository_tag.annotated", "commit_id", "azuredevops_git_repository_tag.lightweight", "commit_id"),
+					resource.TestCheckResourceAttr("azuredevops_git_repository_tag.annotated", "message", "Release 1.0.0"),
+					resource.TestCheckResourceAttr("azuredevops_git_repository_tag.annotated", "tagger_name", "Release Bot"),
+				),
+			},
+			{
+				ResourceName:            "azuredevops_git_repository_tag.lightweight",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdFunc:       hclRepositoryTagID("azuredevops_git_repository_tag.lightweight"),
+				ImportStateVerifyIgnore: []string{"ref_branch"},
+			},
+			{
+				ResourceName:            "azuredevops_git_repository_tag.annotated",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdFunc:       hclRepositoryTagID("azuredevops_git_repository_tag.annotated"),
+				ImportStateVerifyIgnore: []string{"ref_commit_id"},
+			},
+		},
+	})
+}
+
+func hclRepositoryTagID(resourceNode string) resource.ImportStateIdFunc {
+	return func(state *terraform.State) (string, error) {
+		res, ok := state.RootModule().Resources[resourceNode]
+		if !ok {
+			return "", fmt.Errorf("Resource node not found: %s", resourceNode)
+		}
+		return fmt.Sprintf("%s:%s", res.Primary.Attributes["repository_id"], res.Primary.Attributes["name"]), nil
+	}
+}
+
+func hclGitRepoTags(projectName string, gitRepoName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azuredevops_git_repository" "repository" {
+  project_id = azuredevops_project.project.id
+  name       = "%s"
+  initialization {
+    init_type = "Clean"
+  }
+}
+
+resource "azuredevops_git_repository_tag" "lightweight" {
+  repository_id = azuredevops_git_repository.repository.id
+  name          = "latest"
+  ref_branch    = "master"
+}
+
+resource "azuredevops_git_repository_tag" "annotated" {
+  repository_id = azuredevops_git_repository.repository.id
+  name          = "v1.0.0"
+  ref_commit_id = azuredevops_git_repository_tag.lightweight.commit_id
+  message       = "Release 1.0.0"
+  tagger_name   = "Release Bot"
+  tagger_email  = "bot@example.com"
+}
+`, testutils.HclProjectResource(projectName), gitRepoName)
+}
@@ -0,0 +1,68 @@
+package acceptancetests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+func TestAccRepositoryPolicyBlockedFileTypes_update(t *testing.T) {
+	tfNode := "azuredevops_repository_policy_blocked_file_types.test"
+	projectName := testutils.GenerateResourceName()
+	repoName := testutils.GenerateResourceName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testutils.PreCheck(t, nil) },
+		Providers:    testutils.GetProviders(),
+		CheckDestroy: testutils.CheckProjectDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: hclRepoPolicyBlockedFileTypes(projectName, repoName, `["exe"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "enabled", "true"),
+					resource.TestCheckResourceAttr(tfNode, "file_extensions.#", "1"),
+					resource.TestCheckResourceAttr(tfNode, "filepath_patterns.0", "*.exe"),
+				),
+			}, {
+				Config: hclRepoPolicyBlockedFileTypes(projectName, repoName, `["exe", "dll", "tar.gz"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "file_extensions.#", "3"),
+					resource.TestCheckResourceAttr(tfNode, "filepath_patterns.#", "3"),
+					resource.TestCheckResourceAttr(tfNode, "filepath_patterns.0", "*.dll"),
+				),
+			}, {
+				// Reordering the extensions does not change the policy
+				Config:   hclRepoPolicyBlockedFileTypes(projectName, repoName, `["tar.gz", "dll", "exe"]`),
+				PlanOnly: true,
+			}, {
+				ResourceName:      tfNode,
+				ImportStateIdFunc: testutils.ComputeProjectQualifiedResourceImportID(tfNode),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func hclRepoPolicyBlockedFileTypes(projectName string, repoName string, extensions string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azuredevops_git_repository" "test" {
+  project_id = azuredevops_project.project.id
+  name       = "%s"
+  initialization {
+    init_type = "Clean"
+  }
+}
+
+resource "azuredevops_repository_policy_blocked_file_types" "test" {
+  project_id      = azuredevops_project.project.id
+  enabled         = true
+  blocking        = true
+  file_extensions = %s
+  repository_ids  = [azuredevops_git_repository.test.id]
+}`, testutils.HclProjectResource(projectName), repoName, extensions)
+}
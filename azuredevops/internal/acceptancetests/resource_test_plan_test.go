@@ -0,0 +1,229 @@
+package acceptancetests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+func TestAccTestPlan_basic(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	name := testutils.GenerateResourceName()
+
+	tfNode := "azuredevops_test_plan.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testutils.PreCheck(t, nil) },
+		ProviderFactories: testutils.GetProviderFactories(),
+		CheckDestroy:      testutils.CheckProjectDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: hclTestPlanBasic(projectName, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "name", name),
+					resource.TestCheckResourceAttr(tfNode, "state", "Active"),
+					resource.TestCheckResourceAttrSet(tfNode, "root_suite_id"),
+					resource.TestCheckResourceAttrSet(tfNode, "start_date"),
+				),
+			},
+			{
+				Config: hclTestPlanUpdate(projectName, name+"update"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "name", name+"update"),
+					resource.TestCheckResourceAttr(tfNode, "description", "regression"),
+					resource.TestCheckResourceAttr(tfNode, "start_date", "2025-03-01T00:00:00Z"),
+					resource.TestCheckResourceAttr(tfNode, "end_date", "2025-03-31T00:00:00Z"),
+					resource.TestCheckResourceAttr(tfNode, "state", "Inactive"),
+				),
+			},
+			{
+				ResourceName:      tfNode,
+				ImportState:       true,
+				ImportStateIdFunc: testutils.ComputeProjectQualifiedResourceImportID(tfNode),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccTestSuite_types(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	name := testutils.GenerateResourceName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testutils.PreCheck(t, nil) },
+		ProviderFactories: testutils.GetProviderFactories(),
+		CheckDestroy:      testutils.CheckProjectDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: hclTestSuiteTypes(projectName, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("azuredevops_test_suite.static", "parent_suite_id", "azuredevops_test_plan.test", "root_suite_id"),
+					resource.TestCheckResourceAttrPair("azuredevops_test_suite.query", "parent_suite_id", "azuredevops_test_suite.static", "id"),
+					resource.TestCheckResourceAttr("azuredevops_test_suite.query", "type", "query_based"),
+					resource.TestCheckResourceAttr("azuredevops_test_suite.requirement", "type", "requirement_based"),
+					resource.TestCheckResourceAttrSet("azuredevops_test_suite.requirement", "name"),
+					resource.TestCheckResourceAttr("azuredevops_test_suite.static", "default_configuration_ids.#", "1"),
+				),
+			},
+			{
+				ResourceName:      "azuredevops_test_suite.static",
+				ImportState:       true,
+				ImportStateIdFunc: testAccTestSuiteImportID("azuredevops_test_suite.static"),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccTestConfiguration_variables(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	name := testutils.GenerateResourceName()
+
+	tfNode := "azuredevops_test_configuration.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testutils.PreCheck(t, nil) },
+		ProviderFactories: testutils.GetProviderFactories(),
+		CheckDestroy:      testutils.CheckProjectDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: hclTestConfiguration(projectName, name, "Edge"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "name", name),
+					resource.TestCheckResourceAttr(tfNode, "variable.#", "1"),
+					resource.TestCheckResourceAttr("azuredevops_test_variable.test", "values.#", "2"),
+				),
+			},
+			{
+				Config: hclTestConfiguration(projectName, name, "Firefox"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs(tfNode, "variable.*", map[string]string{
+						"value": "Firefox",
+					}),
+				),
+			},
+			{
+				ResourceName:      tfNode,
+				ImportState:       true,
+				ImportStateIdFunc: testutils.ComputeProjectQualifiedResourceImportID(tfNode),
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "azuredevops_test_variable.test",
+				ImportState:       true,
+				ImportStateIdFunc: testutils.ComputeProjectQualifiedResourceImportID("azuredevops_test_variable.test"),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccTestSuiteImportID(resourceNode string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceNode]
+		if !ok {
+			return "", fmt.Errorf("Resource node not found: %s", resourceNode)
+		}
+		return fmt.Sprintf("%s/%s/%s", rs.Primary.Attributes["project_id"], rs.Primary.Attributes["test_plan_id"], rs.Primary.ID), nil
+	}
+}
+
+func hclTestPlanBasic(projectName string, name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azuredevops_test_plan" "test" {
+  project_id = azuredevops_project.project.id
+  name       = "%s"
+  iteration  = azuredevops_project.project.name
+}
+`, testutils.HclProjectResource(projectName), name)
+}
+
+func hclTestPlanUpdate(projectName string, name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azuredevops_test_plan" "test" {
+  project_id  = azuredevops_project.project.id
+  name        = "%s"
+  iteration   = azuredevops_project.project.name
+  area_path   = azuredevops_project.project.name
+  description = "regression"
+  start_date  = "2025-03-01T00:00:00Z"
+  end_date    = "2025-03-31T00:00:00Z"
+  state       = "Inactive"
+}
+`, testutils.HclProjectResource(projectName), name)
+}
+
+func hclTestSuiteTypes(projectName string, name string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuredevops_test_plan" "test" {
+  project_id = azuredevops_project.project.id
+  name       = "%[2]s"
+  iteration  = azuredevops_project.project.name
+}
+
+resource "azuredevops_test_configuration" "test" {
+  project_id = azuredevops_project.project.id
+  name       = "%[2]s"
+}
+
+resource "azuredevops_workitem" "story" {
+  project_id = azuredevops_project.project.id
+  title      = "%[2]s story"
+  type       = "User Story"
+}
+
+resource "azuredevops_test_suite" "static" {
+  project_id                = azuredevops_project.project.id
+  test_plan_id              = azuredevops_test_plan.test.id
+  name                      = "Smoke"
+  default_configuration_ids = [azuredevops_test_configuration.test.id]
+}
+
+resource "azuredevops_test_suite" "query" {
+  project_id      = azuredevops_project.project.id
+  test_plan_id    = azuredevops_test_plan.test.id
+  parent_suite_id = azuredevops_test_suite.static.id
+  type            = "query_based"
+  name            = "Priority 1"
+  query_string    = "SELECT [System.Id] FROM WorkItems WHERE [System.WorkItemType] IN GROUP 'Microsoft.TestCaseCategory' AND [Microsoft.VSTS.Common.Priority] = 1"
+}
+
+resource "azuredevops_test_suite" "requirement" {
+  project_id     = azuredevops_project.project.id
+  test_plan_id   = azuredevops_test_plan.test.id
+  type           = "requirement_based"
+  requirement_id = azuredevops_workitem.story.id
+}
+`, testutils.HclProjectResource(projectName), name)
+}
+
+func hclTestConfiguration(projectName string, name string, browser string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuredevops_test_variable" "test" {
+  project_id = azuredevops_project.project.id
+  name       = "%[2]s"
+  values     = ["Edge", "Firefox"]
+}
+
+resource "azuredevops_test_configuration" "test" {
+  project_id  = azuredevops_project.project.id
+  name        = "%[2]s"
+  description = "description"
+
+  variable {
+    name  = azuredevops_test_variable.test.name
+    value = "%[3]s"
+  }
+}
+`, testutils.HclProjectResource(projectName), name, browser)
+}
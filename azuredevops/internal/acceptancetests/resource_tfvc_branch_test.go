@@ -0,0 +1,57 @@
+package acceptancetests
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+// A TFVC branch can only be created from an existing folder, the tests use a source path of an existing project
+func TestAccTfvcBranch_basic(t *testing.T) {
+	branchName := testutils.GenerateResourceName()
+	resNode := "azuredevops_tfvc_branch.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testutils.PreCheck(t, &[]string{"AZDO_TEST_TFVC_PROJECT_ID", "AZDO_TEST_TFVC_SOURCE_PATH"})
+		},
+		ProviderFactories: testutils.GetProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: hclTfvcBranch(branchName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resNode, "changeset_id"),
+					resource.TestCheckResourceAttr(resNode, "source_path", os.Getenv("AZDO_TEST_TFVC_SOURCE_PATH")),
+				),
+			},
+			{
+				ResourceName: resNode,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					res, ok := s.RootModule().Resources[resNode]
+					if !ok {
+						return "", fmt.Errorf("Did not find a TFVC branch in the TF state")
+					}
+					return fmt.Sprintf("%s/%s", res.Primary.Attributes["project_id"], res.Primary.ID), nil
+				},
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"changeset_id", "comment"},
+			},
+		},
+	})
+}
+
+func hclTfvcBranch(branchName string) string {
+	return fmt.Sprintf(`
+resource "azuredevops_tfvc_branch" "test" {
+  project_id  = "%[1]s"
+  source_path = "%[2]s"
+  path        = "%[2]s-%[3]s"
+  comment     = "Create branch %[3]s"
+}
+`, os.Getenv("AZDO_TEST_TFVC_PROJECT_ID"), os.Getenv("AZDO_TEST_TFVC_SOURCE_PATH"), branchName)
+}
@@ -361,3 +361,132 @@ resource "azuredevops_workitem" "test" {
 }
 `, template, title)
 }
+
+func TestAccWorkItem_links(t *testing.T) {
+	workItemTitle := testutils.GenerateResourceName()
+	projectName := testutils.GenerateResourceName()
+	tfNode := "azuredevops_workitem.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testutils.PreCheck(t, nil) },
+		ProviderFactories: testutils.GetProviderFactories(),
+		CheckDestroy:      testutils.CheckProjectDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: workItemLinks(projectName, workItemTitle),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "hyperlink.#", "2"),
+					resource.TestCheckResourceAttr(tfNode, "artifact_link.#", "1"),
+					resource.TestCheckResourceAttrSet(tfNode, "rev"),
+				),
+			},
+			{
+				Config: workItemLinksUpdate(projectName, workItemTitle),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "hyperlink.#", "1"),
+					resource.TestCheckResourceAttr(tfNode, "hyperlink.0.url", "https://example.com/design"),
+					resource.TestCheckResourceAttr(tfNode, "artifact_link.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccWorkItem_ignoreFieldsChangedOutside(t *testing.T) {
+	workItemTitle := testutils.GenerateResourceName()
+	projectName := testutils.GenerateResourceName()
+	tfNode := "azuredevops_workitem.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testutils.PreCheck(t, nil) },
+		ProviderFactories: testutils.GetProviderFactories(),
+		CheckDestroy:      testutils.CheckProjectDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: workItemIgnoreFieldsChangedOutside(projectName, workItemTitle),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "title", workItemTitle),
+					resource.TestCheckResourceAttr(tfNode, "ignore_fields_changed_outside", "true"),
+					resource.TestCheckResourceAttrSet(tfNode, "state"),
+				),
+			},
+		},
+	})
+}
+
+func workItemLinks(projectNane string, title string) string {
+	template := workItemTemplate(projectNane)
+	return fmt.Sprintf(`
+%s
+
+resource "azuredevops_git_repository" "test" {
+  project_id = azuredevops_project.project.id
+  name       = "repo-%[2]s"
+  initialization {
+    init_type = "Clean"
+  }
+}
+
+resource "azuredevops_workitem" "test" {
+  title      = "%[2]s"
+  project_id = azuredevops_project.project.id
+  type       = "Issue"
+
+  hyperlink {
+    url     = "https://example.com/design"
+    comment = "Design document"
+  }
+
+  hyperlink {
+    url = "https://example.com/spec"
+  }
+
+  artifact_link {
+    type          = "branch"
+    repository_id = azuredevops_git_repository.test.id
+    branch_name   = "main"
+  }
+}
+`, template, title)
+}
+
+func workItemLinksUpdate(projectNane string, title string) string {
+	template := workItemTemplate(projectNane)
+	return fmt.Sprintf(`
+%s
+
+resource "azuredevops_git_repository" "test" {
+  project_id = azuredevops_project.project.id
+  name       = "repo-%[2]s"
+  initialization {
+    init_type = "Clean"
+  }
+}
+
+resource "azuredevops_workitem" "test" {
+  title      = "%[2]s"
+  project_id = azuredevops_project.project.id
+  type       = "Issue"
+
+  hyperlink {
+    url     = "https://example.com/design"
+    comment = "Design document"
+  }
+}
+`, template, title)
+}
+
+func workItemIgnoreFieldsChangedOutside(projectNane string, title string) string {
+	template := workItemTemplate(projectNane)
+	return fmt.Sprintf(`
+%s
+
+resource "azuredevops_workitem" "test" {
+  title                         = "%s"
+  project_id                    = azuredevops_project.project.id
+  type                          = "Issue"
+  tags                          = ["tag1"]
+  ignore_fields_changed_outside = true
+}
+`, template, title)
+}
@@ -126,3 +126,51 @@ resource "azuredevops_workitemquery" "query" {
 }
 `, testutils.HclProjectResource(projectName), folderName, queryName, wiql)
 }
+
+// Query with columns, sort columns and a chart
+func TestAccWorkItemQuery_ColumnsSortChart(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	res := "azuredevops_workitemquery.query"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testutils.PreCheck(t, nil) },
+		Providers:    testutils.GetProviders(),
+		CheckDestroy: testutils.CheckProjectDestroyed,
+		Steps: []resource.TestStep{{
+			Config: hclWorkItemQueryColumnsSortChart(projectName),
+			Check: resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr(res, "columns.#", "3"),
+				resource.TestCheckResourceAttr(res, "columns.1", "System.Title"),
+				resource.TestCheckResourceAttr(res, "sort_column.0.field", "System.ChangedDate"),
+				resource.TestCheckResourceAttr(res, "sort_column.0.descending", "true"),
+				resource.TestCheckResourceAttr(res, "chart.#", "1"),
+				resource.TestCheckResourceAttrSet(res, "chart.0.id"),
+			),
+		}},
+	})
+}
+
+func hclWorkItemQueryColumnsSortChart(projectName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azuredevops_workitemquery" "query" {
+  project_id = azuredevops_project.project.id
+  name       = "tfacc-query-columns"
+  area       = "Shared Queries"
+  wiql       = "SELECT [System.Id] FROM WorkItems WHERE [System.TeamProject] = @project"
+  columns    = ["System.Id", "System.Title", "System.State"]
+
+  sort_column {
+    field      = "System.ChangedDate"
+    descending = true
+  }
+
+  chart {
+    title      = "By state"
+    chart_type = "pieChart"
+    group_by   = "System.State"
+  }
+}
+`, testutils.HclProjectResource(projectName))
+}
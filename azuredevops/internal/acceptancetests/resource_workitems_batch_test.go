@@ -0,0 +1,119 @@
+package acceptancetests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+func TestAccWorkItemsBatch_basic(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	tfNode := "azuredevops_workitems_batch.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testutils.PreCheck(t, nil) },
+		ProviderFactories: testutils.GetProviderFactories(),
+		CheckDestroy:      testutils.CheckProjectDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: hclWorkItemsBatchBasic(projectName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "work_item.#", "3"),
+					resource.TestCheckResourceAttr(tfNode, "work_item_ids.%", "3"),
+					resource.TestCheckResourceAttrSet(tfNode, "work_item_ids.epic"),
+					resource.TestCheckResourceAttr(tfNode, "work_item.1.parent_key", "epic"),
+					resource.TestCheckResourceAttr(tfNode, "work_item.2.parent_key", "epic"),
+					resource.TestCheckResourceAttr(tfNode, "work_item.2.related_keys.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccWorkItemsBatch_update(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	tfNode := "azuredevops_workitems_batch.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testutils.PreCheck(t, nil) },
+		ProviderFactories: testutils.GetProviderFactories(),
+		CheckDestroy:      testutils.CheckProjectDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: hclWorkItemsBatchBasic(projectName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "work_item.#", "3"),
+				),
+			},
+			{
+				Config: hclWorkItemsBatchUpdate(projectName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "work_item.#", "2"),
+					resource.TestCheckResourceAttr(tfNode, "work_item_ids.%", "2"),
+					resource.TestCheckResourceAttr(tfNode, "work_item.1.title", "Second issue (updated)"),
+					resource.TestCheckResourceAttr(tfNode, "work_item.1.parent_key", ""),
+					resource.TestCheckResourceAttr(tfNode, "work_item.1.related_keys.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func hclWorkItemsBatchBasic(projectName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azuredevops_workitems_batch" "test" {
+  project_id = azuredevops_project.project.id
+
+  work_item {
+    key   = "epic"
+    type  = "Epic"
+    title = "Epic"
+  }
+
+  work_item {
+    key        = "first"
+    type       = "Issue"
+    title      = "First issue"
+    parent_key = "epic"
+    tags       = ["imported"]
+  }
+
+  work_item {
+    key          = "second"
+    type         = "Issue"
+    title        = "Second issue"
+    parent_key   = "epic"
+    related_keys = ["first"]
+    fields = {
+      "System.Description" = "Imported in a batch"
+    }
+  }
+}
+`, testutils.HclProjectResource(projectName))
+}
+
+func hclWorkItemsBatchUpdate(projectName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azuredevops_workitems_batch" "test" {
+  project_id = azuredevops_project.project.id
+
+  work_item {
+    key   = "epic"
+    type  = "Epic"
+    title = "Epic"
+  }
+
+  work_item {
+    key   = "second"
+    type  = "Issue"
+    title = "Second issue (updated)"
+  }
+}
+`, testutils.HclProjectResource(projectName))
+}
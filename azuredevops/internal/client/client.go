@@ -29,12 +29,18 @@ import (
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/serviceendpoint"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/servicehooks"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/taskagent"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/testplan"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/tfvc"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/wiki"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/work"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/workitemtracking"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/sdk/advancedsecurity"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/sdk/dashboardextras"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/sdk/organization"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/sdk/pipelineschecksextras"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/sdk/policyextras"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/sdk/securityroles"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/sdk/workitemtrackingextras"
 	"github.com/microsoft/terraform-provider-azuredevops/version"
 )
 
@@ -47,6 +53,7 @@ import (
 // Azure DevOps client.
 type AggregatedClient struct {
 	OrganizationURL               string
+	AdvancedSecurityClient        advancedsecurity.Client
 	CoreClient                    core.Client
 	BuildClient                   build.Client
 	DashboardClient               dashboard.Client
@@ -60,18 +67,23 @@ type AggregatedClient struct {
 	PipelinePermissionsClient     pipelinepermissions.Client
 	PipelinesChecksClientExtras   pipelineschecksextras.Client
 	PolicyClient                  policy.Client
+	PolicyClientExtras            policyextras.Client
 	ElasticClient                 elastic.Client
 	ExtensionManagementClient     extensionmanagement.Client
 	ReleaseClient                 release.Client
 	ServiceEndpointClient         serviceendpoint.Client
 	TaskAgentClient               taskagent.Client
+	TestPlanClient                testplan.Client
+	TfvcClient                    tfvc.Client
 	MemberEntitleManagementClient memberentitlementmanagement.Client
 	FeatureManagementClient       featuremanagement.Client
 	FeedClient                    feed.Client
 	SecurityClient                security.Client
 	IdentityClient                identity.Client
 	WikiClient                    wiki.Client
+	WorkClient                    work.Client
 	WorkItemTrackingClient        workitemtracking.Client
+	WorkItemTrackingClientExtras  workitemtrackingextras.Client
 	ServiceHooksClient            servicehooks.Client
 	Ctx                           context.Context
 	SecurityRolesClient           securityroles.Client
@@ -141,6 +153,12 @@ func GetAzdoClient(authProvider azuredevops.AuthProvider, organizationURL string
 		return nil, err
 	}
 
+	tfvcClient, err := tfvc.NewClient(ctx, connection)
+	if err != nil {
+		log.Printf("getAzdoClient(): tfvc.NewClient failed.")
+		return nil, err
+	}
+
 	gitReposClient, err := git.NewClient(ctx, connection)
 	if err != nil {
 		log.Printf("getAzdoClient(): git.NewClient failed.")
@@ -165,6 +183,12 @@ func GetAzdoClient(authProvider azuredevops.AuthProvider, organizationURL string
 		return nil, err
 	}
 
+	policyClientExtras, err := policyextras.NewClient(ctx, connection)
+	if err != nil {
+		log.Printf("getAzdoClient(): policyextras.NewClient failed.")
+		return nil, err
+	}
+
 	releaseClient, err := release.NewClient(ctx, connection)
 	if err != nil {
 		log.Printf("getAzdoClient(): release.NewClient failed.")
@@ -184,6 +208,14 @@ func GetAzdoClient(authProvider azuredevops.AuthProvider, organizationURL string
 		return nil, err
 	}
 
+	workClient, err := work.NewClient(ctx, connection)
+	if err != nil {
+		log.Printf("getAzdoClient(): work.NewClient failed.")
+		return nil, err
+	}
+
+	testPlanClient := testplan.NewClient(ctx, connection)
+
 	featuremanagementClient := featuremanagement.NewClient(ctx, connection)
 
 	feedClient, err := feed.NewClient(ctx, connection)
@@ -198,6 +230,8 @@ func GetAzdoClient(authProvider azuredevops.AuthProvider, organizationURL string
 		return nil, err
 	}
 
+	workitemtrackingClientExtras := workitemtrackingextras.NewClient(ctx, connection)
+
 	pipelines := pipelines.NewClient(ctx, connection)
 
 	pipelinesChecksClient, err := pipelineschecks.NewClient(ctx, connection)
@@ -222,8 +256,11 @@ func GetAzdoClient(authProvider azuredevops.AuthProvider, organizationURL string
 
 	securityRolesClient := securityroles.NewClient(ctx, connection)
 
+	advancedSecurityClient := advancedsecurity.NewClient(ctx, connection)
+
 	aggregatedClient := &AggregatedClient{
 		OrganizationURL:               organizationURL,
+		AdvancedSecurityClient:        advancedSecurityClient,
 		CoreClient:                    coreClient,
 		BuildClient:                   buildClient,
 		DashboardClient:               dashboardClient,
@@ -239,16 +276,21 @@ func GetAzdoClient(authProvider azuredevops.AuthProvider, organizationURL string
 		PipelinePermissionsClient:     pipelinepermissionsClient,
 		PipelinesChecksClientExtras:   pipelinesChecksClientExtras,
 		PolicyClient:                  policyClient,
+		PolicyClientExtras:            policyClientExtras,
 		ReleaseClient:                 releaseClient,
 		ServiceEndpointClient:         serviceEndpointClient,
 		TaskAgentClient:               taskagentClient,
+		TestPlanClient:                testPlanClient,
+		TfvcClient:                    tfvcClient,
 		MemberEntitleManagementClient: memberentitlementmanagementClient,
 		FeatureManagementClient:       featuremanagementClient,
 		FeedClient:                    feedClient,
 		SecurityClient:                securityClient,
 		IdentityClient:                identityClient,
 		WikiClient:                    wikiClient,
+		WorkClient:                    workClient,
 		WorkItemTrackingClient:        workitemtrackingClient,
+		WorkItemTrackingClientExtras:  workitemtrackingClientExtras,
 		ServiceHooksClient:            serviceHooksClient,
 		SecurityRolesClient:           securityRolesClient,
 		Ctx:                           ctx,
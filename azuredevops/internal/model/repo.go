@@ -9,6 +9,7 @@ type repoTypeValuesType struct {
 	Bitbucket        RepoType
 	GitHubEnterprise RepoType
 	OtherGit         RepoType
+	Tfvc             RepoType
 }
 
 // RepoTypeValues enum of the type of the repository
@@ -18,4 +19,31 @@ var RepoTypeValues = repoTypeValuesType{
 	Bitbucket:        "Bitbucket",
 	GitHubEnterprise: "GitHubEnterprise",
 	OtherGit:         "Git",
+	Tfvc:             "TfsVersionControl",
+}
+
+// TfvcMappingType the type of a TFVC workspace mapping
+type TfvcMappingType string
+
+type tfvcMappingTypeValuesType struct {
+	Map   TfvcMappingType
+	Cloak TfvcMappingType
+}
+
+// TfvcMappingTypeValues enum of the type of a TFVC workspace mapping
+var TfvcMappingTypeValues = tfvcMappingTypeValuesType{
+	Map:   "map",
+	Cloak: "cloak",
+}
+
+// TfvcWorkspaceMappings the workspace mappings of a build definition backed by TFVC
+type TfvcWorkspaceMappings struct {
+	Mappings []TfvcWorkspaceMapping `json:"mappings"`
+}
+
+// TfvcWorkspaceMapping a server path mapped into or cloaked from the sources directory
+type TfvcWorkspaceMapping struct {
+	ServerPath  string          `json:"serverPath"`
+	MappingType TfvcMappingType `json:"mappingType"`
+	LocalPath   string          `json:"localPath"`
 }
@@ -0,0 +1,124 @@
+package advancedsecurity
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/sdk/advancedsecurity"
+)
+
+// alertsPageSize the number of alerts requested per page
+const alertsPageSize = 1000
+
+// DataAdvancedSecurityAlerts schema and implementation for the number of Advanced Security alerts of a repository by severity
+func DataAdvancedSecurityAlerts() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceAdvancedSecurityAlertsRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"repository_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"alert_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"code", "secret", "dependency"}, false),
+			},
+			// Only active alerts are counted if no state is specified
+			"states": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{"active", "dismissed", "fixed", "autoDismissed"}, false),
+				},
+			},
+			"total_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"critical_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"high_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"medium_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"low_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAdvancedSecurityAlertsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+	projectID := d.Get("project_id").(string)
+	repoID := d.Get("repository_id").(string)
+
+	states := tfhelper.ExpandStringSet(d.Get("states").(*schema.Set))
+	if len(states) == 0 {
+		states = []string{"active"}
+	}
+	sort.Strings(states)
+
+	args := advancedsecurity.GetAlertsArgs{
+		Project:      &projectID,
+		RepositoryId: &repoID,
+		States:       &states,
+		Top:          converter.Int(alertsPageSize),
+	}
+	alertType := d.Get("alert_type").(string)
+	if alertType != "" {
+		args.AlertType = &alertType
+	}
+
+	counts := map[string]int{}
+	total := 0
+	for {
+		resp, err := clients.AdvancedSecurityClient.GetAlerts(clients.Ctx, args)
+		if err != nil {
+			return diag.Errorf(" Listing Advanced Security alerts of repository %s. Error: %+v", repoID, err)
+		}
+		for _, alert := range resp.Value {
+			counts[strings.ToLower(converter.ToString(alert.Severity, ""))]++
+			total++
+		}
+		if resp.ContinuationToken == "" {
+			break
+		}
+		args.ContinuationToken = converter.String(resp.ContinuationToken)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s:%s:%s", projectID, repoID, alertType, strings.Join(states, ",")))
+	d.Set("total_count", total)
+	d.Set("critical_count", counts["critical"])
+	d.Set("high_count", counts["high"])
+	d.Set("medium_count", counts["medium"])
+	d.Set("low_count", counts["low"])
+	return nil
+}
@@ -0,0 +1,62 @@
+package advancedsecurity
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/sdk/advancedsecurity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestDataAdvancedSecurityAlerts_Read_CountsBySeverity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	advSecClient := azdosdkmocks.NewMockAdvancedsecurityClient(ctrl)
+	clients := &client.AggregatedClient{AdvancedSecurityClient: advSecClient, Ctx: context.Background()}
+
+	gomock.InOrder(
+		advSecClient.EXPECT().GetAlerts(clients.Ctx, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, args advancedsecurity.GetAlertsArgs) (*advancedsecurity.GetAlertsResponseValue, error) {
+				require.Equal(t, []string{"active"}, *args.States)
+				require.Equal(t, "dependency", *args.AlertType)
+				require.Nil(t, args.ContinuationToken)
+				return &advancedsecurity.GetAlertsResponseValue{
+					Value: []advancedsecurity.Alert{
+						{Severity: converter.String("critical")},
+						{Severity: converter.String("high")},
+					},
+					ContinuationToken: "next",
+				}, nil
+			}).Times(1),
+		advSecClient.EXPECT().GetAlerts(clients.Ctx, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, args advancedsecurity.GetAlertsArgs) (*advancedsecurity.GetAlertsResponseValue, error) {
+				require.Equal(t, "next", *args.ContinuationToken)
+				return &advancedsecurity.GetAlertsResponseValue{
+					Value: []advancedsecurity.Alert{
+						{Severity: converter.String("High")},
+						{Severity: converter.String("low")},
+						{Severity: converter.String("note")},
+					},
+				}, nil
+			}).Times(1),
+	)
+
+	d := schema.TestResourceDataRaw(t, DataAdvancedSecurityAlerts().Schema, map[string]interface{}{
+		"project_id":    advancedSecurityProjectID,
+		"repository_id": advancedSecurityRepoID,
+		"alert_type":    "dependency",
+	})
+	require.Empty(t, dataSourceAdvancedSecurityAlertsRead(context.Background(), d, clients))
+	assert.Equal(t, 5, d.Get("total_count"))
+	assert.Equal(t, 1, d.Get("critical_count"))
+	assert.Equal(t, 2, d.Get("high_count"))
+	assert.Equal(t, 0, d.Get("medium_count"))
+	assert.Equal(t, 1, d.Get("low_count"))
+}
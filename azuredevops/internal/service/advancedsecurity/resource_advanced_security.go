@@ -0,0 +1,209 @@
+package advancedsecurity
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/sdk/advancedsecurity"
+)
+
+// ResourceAdvancedSecurity schema and implementation for the Advanced Security enablement of a repository or project
+func ResourceAdvancedSecurity() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAdvancedSecurityCreateUpdate,
+		ReadContext:   resourceAdvancedSecurityRead,
+		UpdateContext: resourceAdvancedSecurityCreateUpdate,
+		DeleteContext: resourceAdvancedSecurityDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			// Advanced Security is managed for all repositories of the project if no repository is specified
+			"repository_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"push_protection_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"dependency_scanning_injection_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"codeql_default_setup_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"enable_on_create": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Default:       false,
+				ConflictsWith: []string{"repository_id"},
+			},
+		},
+	}
+}
+
+func resourceAdvancedSecurityCreateUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+	projectID := d.Get("project_id").(string)
+
+	if v, ok := d.GetOk("repository_id"); ok {
+		repoID := v.(string)
+		err := clients.AdvancedSecurityClient.UpdateRepositoryEnablement(clients.Ctx, advancedsecurity.UpdateRepositoryEnablementArgs{
+			Project:      &projectID,
+			RepositoryId: &repoID,
+			Enablement: &advancedsecurity.RepositoryEnablement{
+				AdvSecEnabled:                      converter.Bool(d.Get("enabled").(bool)),
+				BlockPushes:                        converter.Bool(d.Get("push_protection_enabled").(bool)),
+				DependencyScanningInjectionEnabled: converter.Bool(d.Get("dependency_scanning_injection_enabled").(bool)),
+				CodeQLEnabled:                      converter.Bool(d.Get("codeql_default_setup_enabled").(bool)),
+			},
+		})
+		if err != nil {
+			return diag.Errorf(" Updating Advanced Security of repository %s. Error: %+v", repoID, err)
+		}
+		d.SetId(fmt.Sprintf("%s/%s", projectID, repoID))
+	} else {
+		err := clients.AdvancedSecurityClient.UpdateProjectEnablement(clients.Ctx, advancedsecurity.UpdateProjectEnablementArgs{
+			Project: &projectID,
+			Enablement: &advancedsecurity.ProjectEnablement{
+				AdvSecEnabled:                      converter.Bool(d.Get("enabled").(bool)),
+				EnableOnCreate:                     converter.Bool(d.Get("enable_on_create").(bool)),
+				BlockPushes:                        converter.Bool(d.Get("push_protection_enabled").(bool)),
+				DependencyScanningInjectionEnabled: converter.Bool(d.Get("dependency_scanning_injection_enabled").(bool)),
+				CodeQLEnabled:                      converter.Bool(d.Get("codeql_default_setup_enabled").(bool)),
+			},
+		})
+		if err != nil {
+			return diag.Errorf(" Updating Advanced Security of project %s. Error: %+v", projectID, err)
+		}
+		d.SetId(projectID)
+	}
+	return resourceAdvancedSecurityRead(clients.Ctx, d, m)
+}
+
+func resourceAdvancedSecurityRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+
+	projectID, repoID := parseAdvancedSecurityID(d.Id())
+	d.Set("project_id", projectID)
+	d.Set("repository_id", repoID)
+
+	if repoID != "" {
+		enablement, err := clients.AdvancedSecurityClient.GetRepositoryEnablement(clients.Ctx, advancedsecurity.GetRepositoryEnablementArgs{
+			Project:      &projectID,
+			RepositoryId: &repoID,
+		})
+		if err != nil {
+			if utils.ResponseWasNotFound(err) {
+				d.SetId("")
+				return nil
+			}
+			return diag.Errorf(" Reading Advanced Security of repository %s. Error: %+v", repoID, err)
+		}
+		d.Set("enabled", converter.ToBool(enablement.AdvSecEnabled, false))
+		d.Set("push_protection_enabled", converter.ToBool(enablement.BlockPushes, false))
+		d.Set("dependency_scanning_injection_enabled", converter.ToBool(enablement.DependencyScanningInjectionEnabled, false))
+		d.Set("codeql_default_setup_enabled", converter.ToBool(enablement.CodeQLEnabled, false))
+		d.Set("enable_on_create", false)
+		return nil
+	}
+
+	enablement, err := clients.AdvancedSecurityClient.GetProjectEnablement(clients.Ctx, advancedsecurity.GetProjectEnablementArgs{
+		Project: &projectID,
+	})
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf(" Reading Advanced Security of project %s. Error: %+v", projectID, err)
+	}
+	d.Set("enabled", converter.ToBool(enablement.AdvSecEnabled, false))
+	d.Set("enable_on_create", converter.ToBool(enablement.EnableOnCreate, false))
+	d.Set("push_protection_enabled", converter.ToBool(enablement.BlockPushes, false))
+	d.Set("dependency_scanning_injection_enabled", converter.ToBool(enablement.DependencyScanningInjectionEnabled, false))
+	d.Set("codeql_default_setup_enabled", converter.ToBool(enablement.CodeQLEnabled, false))
+	return nil
+}
+
+func resourceAdvancedSecurityDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+	projectID, repoID := parseAdvancedSecurityID(d.Id())
+
+	// Removing the resource disables Advanced Security, which stops the billing of its committers
+	var err error
+	if repoID != "" {
+		err = clients.AdvancedSecurityClient.UpdateRepositoryEnablement(clients.Ctx, advancedsecurity.UpdateRepositoryEnablementArgs{
+			Project:      &projectID,
+			RepositoryId: &repoID,
+			Enablement: &advancedsecurity.RepositoryEnablement{
+				AdvSecEnabled:                      converter.Bool(false),
+				BlockPushes:                        converter.Bool(false),
+				DependencyScanningInjectionEnabled: converter.Bool(false),
+				CodeQLEnabled:                      converter.Bool(false),
+			},
+		})
+	} else {
+		err = clients.AdvancedSecurityClient.UpdateProjectEnablement(clients.Ctx, advancedsecurity.UpdateProjectEnablementArgs{
+			Project: &projectID,
+			Enablement: &advancedsecurity.ProjectEnablement{
+				AdvSecEnabled:                      converter.Bool(false),
+				EnableOnCreate:                     converter.Bool(false),
+				BlockPushes:                        converter.Bool(false),
+				DependencyScanningInjectionEnabled: converter.Bool(false),
+				CodeQLEnabled:                      converter.Bool(false),
+			},
+		})
+	}
+	if err != nil && !utils.ResponseWasNotFound(err) {
+		return diag.Errorf(" Disabling Advanced Security. Error: %+v", err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// parseAdvancedSecurityID splits an ID in the format `<project ID>` or `<project ID>/<repository ID>`
+func parseAdvancedSecurityID(id string) (string, string) {
+	projectID, repoID, _ := strings.Cut(id, "/")
+	return projectID, repoID
+}
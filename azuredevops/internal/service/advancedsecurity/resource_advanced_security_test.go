@@ -0,0 +1,99 @@
+package advancedsecurity
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/sdk/advancedsecurity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var (
+	advancedSecurityProjectID = uuid.NewString()
+	advancedSecurityRepoID    = uuid.NewString()
+)
+
+func TestAdvancedSecurity_Create_Repository(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	advSecClient := azdosdkmocks.NewMockAdvancedsecurityClient(ctrl)
+	clients := &client.AggregatedClient{AdvancedSecurityClient: advSecClient, Ctx: context.Background()}
+
+	enablement := &advancedsecurity.RepositoryEnablement{
+		AdvSecEnabled:                      converter.Bool(true),
+		BlockPushes:                        converter.Bool(true),
+		DependencyScanningInjectionEnabled: converter.Bool(false),
+		CodeQLEnabled:                      converter.Bool(true),
+	}
+	gomock.InOrder(
+		advSecClient.EXPECT().UpdateRepositoryEnablement(clients.Ctx, advancedsecurity.UpdateRepositoryEnablementArgs{
+			Project:      converter.String(advancedSecurityProjectID),
+			RepositoryId: converter.String(advancedSecurityRepoID),
+			Enablement:   enablement,
+		}).Return(nil).Times(1),
+		advSecClient.EXPECT().GetRepositoryEnablement(clients.Ctx, gomock.Any()).Return(enablement, nil).Times(1),
+	)
+
+	d := schema.TestResourceDataRaw(t, ResourceAdvancedSecurity().Schema, map[string]interface{}{
+		"project_id":                   advancedSecurityProjectID,
+		"repository_id":                advancedSecurityRepoID,
+		"push_protection_enabled":      true,
+		"codeql_default_setup_enabled": true,
+	})
+	require.Empty(t, resourceAdvancedSecurityCreateUpdate(context.Background(), d, clients))
+	assert.Equal(t, advancedSecurityProjectID+"/"+advancedSecurityRepoID, d.Id())
+	assert.Equal(t, true, d.Get("enabled"))
+	assert.Equal(t, true, d.Get("push_protection_enabled"))
+}
+
+func TestAdvancedSecurity_Create_ProjectDoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	advSecClient := azdosdkmocks.NewMockAdvancedsecurityClient(ctrl)
+	clients := &client.AggregatedClient{AdvancedSecurityClient: advSecClient, Ctx: context.Background()}
+
+	advSecClient.EXPECT().UpdateProjectEnablement(clients.Ctx, gomock.Any()).DoAndReturn(
+		func(ctx context.Context, args advancedsecurity.UpdateProjectEnablementArgs) error {
+			require.True(t, *args.Enablement.EnableOnCreate)
+			return errors.New("UpdateProjectEnablement() Failed")
+		}).Times(1)
+
+	d := schema.TestResourceDataRaw(t, ResourceAdvancedSecurity().Schema, map[string]interface{}{
+		"project_id":       advancedSecurityProjectID,
+		"enable_on_create": true,
+	})
+	diags := resourceAdvancedSecurityCreateUpdate(context.Background(), d, clients)
+	require.NotEmpty(t, diags)
+	require.Contains(t, diags[0].Summary, "UpdateProjectEnablement() Failed")
+}
+
+func TestAdvancedSecurity_Delete_DisablesRepository(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	advSecClient := azdosdkmocks.NewMockAdvancedsecurityClient(ctrl)
+	clients := &client.AggregatedClient{AdvancedSecurityClient: advSecClient, Ctx: context.Background()}
+
+	advSecClient.EXPECT().UpdateRepositoryEnablement(clients.Ctx, gomock.Any()).DoAndReturn(
+		func(ctx context.Context, args advancedsecurity.UpdateRepositoryEnablementArgs) error {
+			require.Equal(t, advancedSecurityRepoID, *args.RepositoryId)
+			require.False(t, *args.Enablement.AdvSecEnabled)
+			require.False(t, *args.Enablement.BlockPushes)
+			return nil
+		}).Times(1)
+
+	d := schema.TestResourceDataRaw(t, ResourceAdvancedSecurity().Schema, nil)
+	d.SetId(advancedSecurityProjectID + "/" + advancedSecurityRepoID)
+	require.Empty(t, resourceAdvancedSecurityDelete(context.Background(), d, clients))
+	assert.Empty(t, d.Id())
+}
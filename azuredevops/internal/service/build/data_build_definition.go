@@ -153,6 +153,26 @@ func DataBuildDefinition() *schema.Resource {
 							Type:     schema.TypeBool,
 							Computed: true,
 						},
+						"tfvc_mapping": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"server_path": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"local_path": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
 					},
 				},
 			},
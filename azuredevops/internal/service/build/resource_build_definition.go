@@ -171,6 +171,7 @@ func ResourceBuildDefinition() *schema.Resource {
 								string(model.RepoTypeValues.Bitbucket),
 								string(model.RepoTypeValues.GitHubEnterprise),
 								string(model.RepoTypeValues.OtherGit),
+								string(model.RepoTypeValues.Tfvc),
 							}, false),
 						},
 						"yml_path": {
@@ -204,6 +205,35 @@ func ResourceBuildDefinition() *schema.Resource {
 							Optional: true,
 							Default:  true,
 						},
+						// The branch is mapped to the root of the sources directory if no mapping is configured
+						"tfvc_mapping": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"type": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Default:  string(model.TfvcMappingTypeValues.Map),
+										ValidateFunc: validation.StringInSlice([]string{
+											string(model.TfvcMappingTypeValues.Map),
+											string(model.TfvcMappingTypeValues.Cloak),
+										}, false),
+									},
+									"server_path": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotWhiteSpace,
+									},
+									"local_path": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Default:  "",
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -568,18 +598,24 @@ func resourceBuildDefinitionCreate(ctx context.Context, d *schema.ResourceData,
 
 				branchName = strings.TrimPrefix(branchName, "refs/heads/")
 
-				_, err := clients.PipelinesClient.RunPipeline(clients.Ctx, pipelines.RunPipelineArgs{
-					Project:    converter.String(projectID),
-					PipelineId: createdBuildDefinition.Id,
-					RunParameters: &pipelines.RunPipelineParameters{
-						Resources: &pipelines.RunResourcesParameters{
-							Repositories: &map[string]pipelines.RepositoryResourceParameters{
-								"self": {
-									RefName: converter.String("refs/heads/" + branchName),
-								},
+				runParameters := &pipelines.RunPipelineParameters{
+					Resources: &pipelines.RunResourcesParameters{
+						Repositories: &map[string]pipelines.RepositoryResourceParameters{
+							"self": {
+								RefName: converter.String("refs/heads/" + branchName),
 							},
 						},
 					},
+				}
+				// TFVC has no refs, the run uses the default branch of the definition
+				if repo["repo_type"].(string) == string(model.RepoTypeValues.Tfvc) {
+					runParameters = &pipelines.RunPipelineParameters{}
+				}
+
+				_, err := clients.PipelinesClient.RunPipeline(clients.Ctx, pipelines.RunPipelineArgs{
+					Project:       converter.String(projectID),
+					PipelineId:    createdBuildDefinition.Id,
+					RunParameters: runParameters,
 				})
 				if err != nil {
 					diags = append(diags, diag.Diagnostic{
@@ -885,7 +921,7 @@ func flattenRepository(buildDefinition *build.BuildDefinition) (interface{}, err
 		"repo_type":             *buildDefinition.Repository.Type,
 		"branch_name":           *buildDefinition.Repository.DefaultBranch,
 		"github_enterprise_url": githubEnterpriseUrl,
-		"url":                   *buildDefinition.Repository.Url,
+		"url":                   converter.ToString(buildDefinition.Repository.Url, ""),
 	}}
 
 	if buildDefinition.Repository != nil && buildDefinition.Repository.Properties != nil {
@@ -900,10 +936,35 @@ func flattenRepository(buildDefinition *build.BuildDefinition) (interface{}, err
 			}
 			repo[0]["report_build_status"] = reportBuildStatus
 		}
+
+		if tfvcMapping, ok := (*buildDefinition.Repository.Properties)["tfvcMapping"]; ok {
+			mappings, err := flattenTfvcMappings(tfvcMapping)
+			if err != nil {
+				return nil, err
+			}
+			repo[0]["tfvc_mapping"] = mappings
+		}
 	}
 	return repo, nil
 }
 
+func flattenTfvcMappings(tfvcMapping string) ([]interface{}, error) {
+	var workspace model.TfvcWorkspaceMappings
+	if err := json.Unmarshal([]byte(tfvcMapping), &workspace); err != nil {
+		return nil, fmt.Errorf("Unable to parse TFVC workspace mappings. Error: %+v", err)
+	}
+
+	mappings := make([]interface{}, 0, len(workspace.Mappings))
+	for _, mapping := range workspace.Mappings {
+		mappings = append(mappings, map[string]interface{}{
+			"type":        string(mapping.MappingType),
+			"server_path": mapping.ServerPath,
+			"local_path":  mapping.LocalPath,
+		})
+	}
+	return mappings, nil
+}
+
 func flattenBuildDefinitionBranchOrPathFilter(m []interface{}) []interface{} {
 	var include []string
 	var exclude []string
@@ -1453,6 +1514,8 @@ func expandBuildDefinition(d *schema.ResourceData, meta interface{}) (*build.Bui
 		repoAPIURL = fmt.Sprintf("%s/api/v3/repos/%s", githubEnterpriseURL, repoID)
 	case string(model.RepoTypeValues.OtherGit):
 		repoURL = repository["url"].(string)
+	case string(model.RepoTypeValues.Tfvc):
+		repoURL = repository["url"].(string)
 	}
 
 	if strings.EqualFold(repoType, string(model.RepoTypeValues.OtherGit)) {
@@ -1465,6 +1528,12 @@ func expandBuildDefinition(d *schema.ResourceData, meta interface{}) (*build.Bui
 		}
 	}
 
+	if strings.EqualFold(repoType, string(model.RepoTypeValues.Tfvc)) {
+		if !strings.HasPrefix(repository["branch_name"].(string), "$/") {
+			return nil, "", fmt.Errorf("`repository.branch_name` must be a server path starting with `$/` when `repo_type` is `%s`", repoType)
+		}
+	}
+
 	var buildTriggers []any
 
 	ciTriggers, err := expandBuildDefinitionTriggerList(
@@ -1576,7 +1645,21 @@ func expandBuildDefinition(d *schema.ResourceData, meta interface{}) (*build.Bui
 		(*buildDefinition.Repository.Properties)["fullName"] = "repository"
 		(*buildDefinition.Repository.Properties)["cloneUrl"] = repoURL
 		buildDefinition.Repository.Clean = converter.String("true")
+	}
+
+	// TFVC sources are downloaded into a workspace built from the mappings
+	if repoType == string(model.RepoTypeValues.Tfvc) {
+		tfvcMapping, err := expandTfvcMappings(repository)
+		if err != nil {
+			return nil, "", err
+		}
+		(*buildDefinition.Repository.Properties)["tfvcMapping"] = tfvcMapping
+		buildDefinition.Repository.RootFolder = converter.String(repoID)
+		buildDefinition.Repository.Clean = converter.String("true")
+	}
 
+	// other git and TFVC only support designer processes
+	if repoType == string(model.RepoTypeValues.OtherGit) || repoType == string(model.RepoTypeValues.Tfvc) {
 		jobs, err := expandBuildDefinitionJobs(d.Get("jobs").([]interface{}))
 		if err != nil {
 			return nil, "", fmt.Errorf("Expanding jobs: %+v", err)
@@ -1584,7 +1667,7 @@ func expandBuildDefinition(d *schema.ResourceData, meta interface{}) (*build.Bui
 
 		agentSpecification := d.Get("agent_specification").(string)
 		if len(agentSpecification) == 0 {
-			return nil, "", fmt.Errorf("Expanding jobs: `agent_specification` must be set when `repo_type` is `Git`")
+			return nil, "", fmt.Errorf("Expanding jobs: `agent_specification` must be set when `repo_type` is `%s`", repoType)
 		}
 
 		buildDefinition.Process = map[string]interface{}{
@@ -1601,6 +1684,35 @@ func expandBuildDefinition(d *schema.ResourceData, meta interface{}) (*build.Bui
 	return &buildDefinition, projectID, nil
 }
 
+// expandTfvcMappings returns the workspace mappings of a TFVC repository serialized the way the service stores
+// them. The branch is mapped to the root of the sources directory if no mapping is configured.
+func expandTfvcMappings(repository map[string]interface{}) (string, error) {
+	workspace := model.TfvcWorkspaceMappings{
+		Mappings: []model.TfvcWorkspaceMapping{},
+	}
+	for _, v := range repository["tfvc_mapping"].([]interface{}) {
+		mapping := v.(map[string]interface{})
+		workspace.Mappings = append(workspace.Mappings, model.TfvcWorkspaceMapping{
+			ServerPath:  mapping["server_path"].(string),
+			MappingType: model.TfvcMappingType(mapping["type"].(string)),
+			LocalPath:   mapping["local_path"].(string),
+		})
+	}
+	if len(workspace.Mappings) == 0 {
+		workspace.Mappings = append(workspace.Mappings, model.TfvcWorkspaceMapping{
+			ServerPath:  repository["branch_name"].(string),
+			MappingType: model.TfvcMappingTypeValues.Map,
+			LocalPath:   "\\",
+		})
+	}
+
+	tfvcMapping, err := json.Marshal(workspace)
+	if err != nil {
+		return "", fmt.Errorf("Unable to marshal TFVC workspace mappings. Error: %+v", err)
+	}
+	return string(tfvcMapping), nil
+}
+
 /**
  * certain types of build definitions require a service connection to run. This function
  * returns an error if a service connection was needed but not provided
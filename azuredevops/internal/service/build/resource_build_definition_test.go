@@ -9,10 +9,12 @@ import (
 	"errors"
 	"fmt"
 	"sort"
+	"strings"
 	"testing"
 
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/build"
 	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
@@ -273,7 +275,7 @@ func testBuildDefinitionGitHubEnterprise() build.BuildDefinition {
 
 // validates that all supported repo types are allowed by the schema
 func TestBuildDefinition_RepoTypeListIsCorrect(t *testing.T) {
-	expectedRepoTypes := []string{"GitHub", "TfsGit", "Bitbucket", "GitHubEnterprise"}
+	expectedRepoTypes := []string{"GitHub", "TfsGit", "Bitbucket", "GitHubEnterprise", "TfsVersionControl"}
 	repoSchema := ResourceBuildDefinition().Schema["repository"]
 	repoTypeSchema := repoSchema.Elem.(*schema.Resource).Schema["repo_type"]
 
@@ -351,6 +353,149 @@ func TestBuildDefinition_Expand_RepoUrl_GithubEnterprise(t *testing.T) {
 	require.Equal(t, testProjectID, projectID)
 }
 
+func testBuildDefinitionTfvcResourceData(t *testing.T, branchName string, mappings []interface{}) *schema.ResourceData {
+	return schema.TestResourceDataRaw(t, ResourceBuildDefinition().Schema, map[string]interface{}{
+		"project_id":          testProjectID,
+		"name":                "Name",
+		"agent_specification": "windows-latest",
+		"repository": []interface{}{
+			map[string]interface{}{
+				"repo_id":      "$/Project",
+				"repo_type":    "TfsVersionControl",
+				"branch_name":  branchName,
+				"tfvc_mapping": mappings,
+			},
+		},
+		"jobs": []interface{}{
+			map[string]interface{}{
+				"name":      "Agent job 1",
+				"ref_name":  "Job_1",
+				"condition": "succeeded()",
+				"target": []interface{}{
+					map[string]interface{}{
+						"type": "AgentJob",
+						"execution_options": []interface{}{
+							map[string]interface{}{
+								"type": "None",
+							},
+						},
+					},
+				},
+			},
+		},
+	})
+}
+
+// verifies that TFVC workspace mappings are expanded into the repository properties
+func TestBuildDefinition_Expand_Tfvc_Mappings(t *testing.T) {
+	resourceData := testBuildDefinitionTfvcResourceData(t, "$/Project/Main", []interface{}{
+		map[string]interface{}{
+			"server_path": "$/Project/Main",
+			"local_path":  "\\Main",
+		},
+		map[string]interface{}{
+			"type":        "cloak",
+			"server_path": "$/Project/Main/Docs",
+		},
+	})
+
+	buildDefinition, _, err := expandBuildDefinition(resourceData, &client.AggregatedClient{Ctx: context.Background()})
+	require.Nil(t, err)
+	require.Equal(t, "$/Project", *buildDefinition.Repository.RootFolder)
+	require.Equal(t, "$/Project/Main", *buildDefinition.Repository.DefaultBranch)
+	require.JSONEq(t,
+		`{"mappings":[{"serverPath":"$/Project/Main","mappingType":"map","localPath":"\\Main"},{"serverPath":"$/Project/Main/Docs","mappingType":"cloak","localPath":""}]}`,
+		(*buildDefinition.Repository.Properties)["tfvcMapping"])
+	require.IsType(t, map[string]interface{}{}, buildDefinition.Process)
+}
+
+// verifies that the TFVC branch is mapped to the sources directory if no mapping is configured
+func TestBuildDefinition_Expand_Tfvc_DefaultMapping(t *testing.T) {
+	resourceData := testBuildDefinitionTfvcResourceData(t, "$/Project/Main", nil)
+
+	buildDefinition, _, err := expandBuildDefinition(resourceData, &client.AggregatedClient{Ctx: context.Background()})
+	require.Nil(t, err)
+	require.JSONEq(t,
+		`{"mappings":[{"serverPath":"$/Project/Main","mappingType":"map","localPath":"\\"}]}`,
+		(*buildDefinition.Repository.Properties)["tfvcMapping"])
+}
+
+// verifies that the implicit default mapping survives a round trip without changing the configuration
+func TestBuildDefinition_Flatten_Tfvc_DefaultMapping(t *testing.T) {
+	require.True(t, ResourceBuildDefinition().Schema["repository"].Elem.(*schema.Resource).Schema["tfvc_mapping"].Computed)
+
+	resourceData := testBuildDefinitionTfvcResourceData(t, "$/Project/Main", nil)
+	buildDefinition, _, err := expandBuildDefinition(resourceData, &client.AggregatedClient{Ctx: context.Background()})
+	require.Nil(t, err)
+	buildDefinition.Repository.Url = converter.String("https://dev.azure.com/org/")
+	buildDefinition.Repository.Name = converter.String("Project")
+
+	flattened := schema.TestResourceDataRaw(t, ResourceBuildDefinition().Schema, nil)
+	require.Nil(t, flattenBuildDefinition(flattened, buildDefinition, testProjectID))
+	require.Equal(t, 1, flattened.Get("repository.0.tfvc_mapping.#"))
+	require.Equal(t, "$/Project/Main", flattened.Get("repository.0.tfvc_mapping.0.server_path"))
+	require.Equal(t, "\\", flattened.Get("repository.0.tfvc_mapping.0.local_path"))
+
+	// Planning the configuration without mappings against the flattened state keeps the default mapping
+	flattened.SetId("1")
+	config := testBuildDefinitionTfvcResourceData(t, "$/Project/Main", nil)
+	diff, err := ResourceBuildDefinition().Diff(context.Background(), flattened.State(), terraform.NewResourceConfigRaw(map[string]interface{}{
+		"project_id": testProjectID,
+		"name":       "Name",
+		"repository": []interface{}{
+			map[string]interface{}{
+				"repo_id":     config.Get("repository.0.repo_id"),
+				"repo_type":   config.Get("repository.0.repo_type"),
+				"branch_name": config.Get("repository.0.branch_name"),
+			},
+		},
+	}), nil)
+	require.Nil(t, err)
+	if diff != nil {
+		for key := range diff.Attributes {
+			require.False(t, strings.HasPrefix(key, "repository.0.tfvc_mapping"), key)
+		}
+	}
+
+	roundTrip, _, err := expandBuildDefinition(flattened, &client.AggregatedClient{Ctx: context.Background()})
+	require.Nil(t, err)
+	require.JSONEq(t,
+		(*buildDefinition.Repository.Properties)["tfvcMapping"],
+		(*roundTrip.Repository.Properties)["tfvcMapping"])
+}
+
+// verifies that the TFVC branch must be a server path
+func TestBuildDefinition_Expand_Tfvc_RequiresServerPathBranch(t *testing.T) {
+	resourceData := testBuildDefinitionTfvcResourceData(t, "master", nil)
+
+	_, _, err := expandBuildDefinition(resourceData, &client.AggregatedClient{Ctx: context.Background()})
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "`repository.branch_name` must be a server path")
+}
+
+// verifies that the TFVC workspace mappings are flattened from the repository properties
+func TestBuildDefinition_Flatten_Tfvc_Mappings(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, ResourceBuildDefinition().Schema, nil)
+	tfvcBuildDef := testBuildDefinitionBitbucket()
+	tfvcBuildDef.Repository = &build.BuildRepository{
+		Url:           converter.String("https://dev.azure.com/org/"),
+		Id:            converter.String("$/Project"),
+		Name:          converter.String("Project"),
+		DefaultBranch: converter.String("$/Project/Main"),
+		Type:          converter.String("TfsVersionControl"),
+		Properties: &map[string]string{
+			"tfvcMapping": `{"mappings":[{"serverPath":"$/Project/Main","mappingType":"map","localPath":"\\"},{"serverPath":"$/Project/Main/Docs","mappingType":"cloak","localPath":""}]}`,
+		},
+	}
+
+	require.Nil(t, flattenBuildDefinition(resourceData, &tfvcBuildDef, testProjectID))
+	require.Equal(t, "TfsVersionControl", resourceData.Get("repository.0.repo_type"))
+	require.Equal(t, 2, resourceData.Get("repository.0.tfvc_mapping.#"))
+	require.Equal(t, "\\", resourceData.Get("repository.0.tfvc_mapping.0.local_path"))
+	require.Equal(t, "cloak", resourceData.Get("repository.0.tfvc_mapping.1.type"))
+	require.Equal(t, "$/Project/Main/Docs", resourceData.Get("repository.0.tfvc_mapping.1.server_path"))
+}
+
 // verifies that a service connection is required for bitbucket repos
 func TestBuildDefinition_ValidatesServiceConnection_Bitbucket(t *testing.T) {
 	resourceData := schema.TestResourceDataRaw(t, ResourceBuildDefinition().Schema, nil)
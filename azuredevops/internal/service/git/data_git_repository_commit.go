@@ -0,0 +1,184 @@
+package git
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+)
+
+// DataGitRepositoryCommit schema and implementation for looking up a commit of a git repository by SHA, branch or tag
+func DataGitRepositoryCommit() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceGitRepositoryCommitRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"repository_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"commit_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				ExactlyOneOf: []string{"commit_id", "branch", "tag"},
+			},
+			"branch": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				ExactlyOneOf: []string{"commit_id", "branch", "tag"},
+			},
+			"tag": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				ExactlyOneOf: []string{"commit_id", "branch", "tag"},
+			},
+			"message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"author_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"author_email": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"author_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"committer_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"committer_email": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"committer_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"parent_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"tree_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceGitRepositoryCommitRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+	repoId := d.Get("repository_id").(string)
+
+	commitId, err := resolveGitCommitId(clients, d, repoId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	commit, err := clients.GitReposClient.GetCommit(clients.Ctx, git.GetCommitArgs{
+		RepositoryId: converter.String(repoId),
+		CommitId:     converter.String(commitId),
+	})
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			return diag.Errorf(" Commit %s not found in repository %s. Error: %+v", commitId, repoId, err)
+		}
+		return diag.Errorf(" Getting commit %s of repository %s. Error: %+v", commitId, repoId, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", repoId, commitId))
+	d.Set("commit_id", commitId)
+	d.Set("message", converter.ToString(commit.Comment, ""))
+	d.Set("tree_id", converter.ToString(commit.TreeId, ""))
+	d.Set("url", converter.ToString(commit.RemoteUrl, ""))
+
+	authorName, authorEmail, authorDate := flattenGitUserDate(commit.Author)
+	d.Set("author_name", authorName)
+	d.Set("author_email", authorEmail)
+	d.Set("author_date", authorDate)
+
+	committerName, committerEmail, committerDate := flattenGitUserDate(commit.Committer)
+	d.Set("committer_name", committerName)
+	d.Set("committer_email", committerEmail)
+	d.Set("committer_date", committerDate)
+
+	var parents []string
+	if commit.Parents != nil {
+		parents = *commit.Parents
+	}
+	if err := d.Set("parent_ids", parents); err != nil {
+		return diag.Errorf(" Setting parent IDs. Error: %+v", err)
+	}
+	return nil
+}
+
+// resolveGitCommitId returns the commit ID of the commit_id, branch or tag attribute
+func resolveGitCommitId(clients *client.AggregatedClient, d *schema.ResourceData, repoId string) (string, error) {
+	if v, ok := d.GetOk("branch"); ok {
+		branch := v.(string)
+		ref, err := checkRepositoryBranchExists(clients, repoId, branch)
+		if err != nil {
+			return "", fmt.Errorf("Getting branch %q: %w", branch, err)
+		}
+		if ref == nil || ref.ObjectId == nil {
+			return "", fmt.Errorf("Branch %q not found.", branch)
+		}
+		return *ref.ObjectId, nil
+	}
+
+	if v, ok := d.GetOk("tag"); ok {
+		tag := v.(string)
+		ref, err := getRepositoryTagRef(clients, repoId, tag)
+		if err != nil {
+			return "", fmt.Errorf("Getting tag %q: %w", tag, err)
+		}
+		if ref == nil || ref.ObjectId == nil {
+			return "", fmt.Errorf("Tag %q not found.", tag)
+		}
+		// Annotated tags point to a tag object, the peeled object ID is the ID of the tagged commit
+		if ref.PeeledObjectId != nil {
+			return *ref.PeeledObjectId, nil
+		}
+		return *ref.ObjectId, nil
+	}
+
+	return d.Get("commit_id").(string), nil
+}
+
+func flattenGitUserDate(user *git.GitUserDate) (string, string, string) {
+	if user == nil {
+		return "", "", ""
+	}
+	date := ""
+	if user.Date != nil {
+		date = user.Date.Time.Format(time.RFC3339)
+	}
+	return converter.ToString(user.Name, ""), converter.ToString(user.Email, ""), date
+}
@@ -0,0 +1,78 @@
+package git
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestDataGitRepositoryCommit_Read_AnnotatedTag(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{GitReposClient: reposClient, Ctx: context.Background()}
+
+	repoId := uuid.NewString()
+	date := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	gomock.InOrder(
+		reposClient.EXPECT().GetRefs(clients.Ctx, gomock.Any()).Return(&git.GetRefsResponseValue{
+			Value: []git.GitRef{{Name: converter.String("refs/tags/v1.0.0"), ObjectId: converter.String("2222"), PeeledObjectId: converter.String("1111")}},
+		}, nil).Times(1),
+		reposClient.EXPECT().GetCommit(clients.Ctx, git.GetCommitArgs{
+			RepositoryId: converter.String(repoId),
+			CommitId:     converter.String("1111"),
+		}).Return(&git.GitCommit{
+			CommitId: converter.String("1111"),
+			Comment:  converter.String("Release 1.0.0"),
+			Author: &git.GitUserDate{
+				Name:  converter.String("Jane Doe"),
+				Email: converter.String("jane@example.com"),
+				Date:  &azuredevops.Time{Time: date},
+			},
+			Parents: &[]string{"0000", "0001"},
+			TreeId:  converter.String("3333"),
+		}, nil).Times(1),
+	)
+
+	d := schema.TestResourceDataRaw(t, DataGitRepositoryCommit().Schema, map[string]interface{}{
+		"repository_id": repoId,
+		"tag":           "v1.0.0",
+	})
+	require.Empty(t, dataSourceGitRepositoryCommitRead(context.Background(), d, clients))
+	assert.Equal(t, "1111", d.Get("commit_id"))
+	assert.Equal(t, "Release 1.0.0", d.Get("message"))
+	assert.Equal(t, "Jane Doe", d.Get("author_name"))
+	assert.Equal(t, "2024-05-01T10:00:00Z", d.Get("author_date"))
+	assert.Equal(t, []interface{}{"0000", "0001"}, d.Get("parent_ids"))
+	assert.Equal(t, "3333", d.Get("tree_id"))
+}
+
+func TestDataGitRepositoryCommit_Read_BranchNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{GitReposClient: reposClient, Ctx: context.Background()}
+
+	reposClient.EXPECT().GetRefs(gomock.Any(), gomock.Any()).Return(&git.GetRefsResponseValue{}, nil).Times(1)
+
+	d := schema.TestResourceDataRaw(t, DataGitRepositoryCommit().Schema, map[string]interface{}{
+		"repository_id": uuid.NewString(),
+		"branch":        "main",
+	})
+	diags := dataSourceGitRepositoryCommitRead(context.Background(), d, clients)
+	require.NotEmpty(t, diags)
+	require.Contains(t, diags[0].Summary, `Branch "main" not found.`)
+}
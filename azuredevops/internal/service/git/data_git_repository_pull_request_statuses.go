@@ -0,0 +1,162 @@
+package git
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+)
+
+// DataGitRepositoryPullRequestStatuses schema and implementation for listing the statuses posted to the recent pull
+// requests of a git repository
+func DataGitRepositoryPullRequestStatuses() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceGitRepositoryPullRequestStatusesRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"repository_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			// The number of most recently created pull requests to inspect, regardless of their status
+			"pull_request_count": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      25,
+				ValidateFunc: validation.IntBetween(1, 100),
+			},
+			"statuses": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"genre": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"author_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"author_descriptor": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"author_display_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_posted_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGitRepositoryPullRequestStatusesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+
+	repoId := d.Get("repository_id").(string)
+	count := d.Get("pull_request_count").(int)
+
+	pullRequests, err := clients.GitReposClient.GetPullRequests(clients.Ctx, git.GetPullRequestsArgs{
+		RepositoryId: converter.String(repoId),
+		SearchCriteria: &git.GitPullRequestSearchCriteria{
+			Status: &git.PullRequestStatusValues.All,
+		},
+		Top: converter.Int(count),
+	})
+	if err != nil {
+		return diag.Errorf(" Listing pull requests of repository %s. Error: %+v", repoId, err)
+	}
+
+	// Statuses are identified by genre, name and author, only the most recent post of a status is kept
+	statuses := map[string]map[string]interface{}{}
+	if pullRequests != nil {
+		for _, pullRequest := range *pullRequests {
+			if pullRequest.PullRequestId == nil {
+				continue
+			}
+			values, err := clients.GitReposClient.GetPullRequestStatuses(clients.Ctx, git.GetPullRequestStatusesArgs{
+				RepositoryId:  converter.String(repoId),
+				PullRequestId: pullRequest.PullRequestId,
+			})
+			if err != nil {
+				return diag.Errorf(" Listing statuses of pull request %d. Error: %+v", *pullRequest.PullRequestId, err)
+			}
+			if values == nil {
+				continue
+			}
+			for _, value := range *values {
+				status := flattenGitPullRequestStatus(&value)
+				key := strings.ToLower(fmt.Sprintf("%s/%s/%s", status["genre"], status["name"], status["author_id"]))
+				if existing, ok := statuses[key]; ok && existing["last_posted_date"].(string) >= status["last_posted_date"].(string) {
+					continue
+				}
+				statuses[key] = status
+			}
+		}
+	}
+
+	keys := make([]string, 0, len(statuses))
+	for key := range statuses {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	result := make([]interface{}, 0, len(keys))
+	for _, key := range keys {
+		result = append(result, statuses[key])
+	}
+
+	d.SetId(fmt.Sprintf("%s/statuses:%d", repoId, count))
+	if err := d.Set("statuses", result); err != nil {
+		return diag.Errorf(" Setting statuses. Error: %+v", err)
+	}
+	return nil
+}
+
+func flattenGitPullRequestStatus(status *git.GitPullRequestStatus) map[string]interface{} {
+	result := map[string]interface{}{
+		"genre":               "",
+		"name":                "",
+		"author_id":           "",
+		"author_descriptor":   "",
+		"author_display_name": "",
+		"last_posted_date":    "",
+	}
+	if status.Context != nil {
+		result["genre"] = converter.ToString(status.Context.Genre, "")
+		result["name"] = converter.ToString(status.Context.Name, "")
+	}
+	if author := status.CreatedBy; author != nil {
+		result["author_id"] = converter.ToString(author.Id, "")
+		result["author_descriptor"] = converter.ToString(author.Descriptor, "")
+		result["author_display_name"] = converter.ToString(author.DisplayName, "")
+	}
+	// Statuses are updated by posting them again, the update date is the date of the most recent post
+	if status.UpdatedDate != nil {
+		result["last_posted_date"] = status.UpdatedDate.Time.UTC().Format(time.RFC3339)
+	} else if status.CreationDate != nil {
+		result["last_posted_date"] = status.CreationDate.Time.UTC().Format(time.RFC3339)
+	}
+	return result
+}
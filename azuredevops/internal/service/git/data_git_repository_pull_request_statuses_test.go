@@ -0,0 +1,110 @@
+package git
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/webapi"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func testPullRequestStatus(genre string, name string, authorId string, posted time.Time) git.GitPullRequestStatus {
+	return git.GitPullRequestStatus{
+		Context: &git.GitStatusContext{
+			Genre: converter.String(genre),
+			Name:  converter.String(name),
+		},
+		CreatedBy: &webapi.IdentityRef{
+			Id:          converter.String(authorId),
+			Descriptor:  converter.String("aadsp." + authorId),
+			DisplayName: converter.String("Pipeline " + authorId),
+		},
+		CreationDate: &azuredevops.Time{Time: posted},
+	}
+}
+
+func TestDataGitRepositoryPullRequestStatuses_Read_KeepsMostRecentPost(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{GitReposClient: reposClient, Ctx: context.Background()}
+
+	repoId := uuid.NewString()
+	authorId := uuid.NewString()
+	older := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	newer := older.Add(time.Hour)
+
+	reposClient.EXPECT().GetPullRequests(clients.Ctx, git.GetPullRequestsArgs{
+		RepositoryId: converter.String(repoId),
+		SearchCriteria: &git.GitPullRequestSearchCriteria{
+			Status: &git.PullRequestStatusValues.All,
+		},
+		Top: converter.Int(10),
+	}).Return(&[]git.GitPullRequest{
+		{PullRequestId: converter.Int(2)},
+		{PullRequestId: converter.Int(1)},
+	}, nil).Times(1)
+	reposClient.EXPECT().GetPullRequestStatuses(clients.Ctx, git.GetPullRequestStatusesArgs{
+		RepositoryId:  converter.String(repoId),
+		PullRequestId: converter.Int(2),
+	}).Return(&[]git.GitPullRequestStatus{
+		testPullRequestStatus("security", "scan", authorId, newer),
+	}, nil).Times(1)
+	reposClient.EXPECT().GetPullRequestStatuses(clients.Ctx, git.GetPullRequestStatusesArgs{
+		RepositoryId:  converter.String(repoId),
+		PullRequestId: converter.Int(1),
+	}).Return(&[]git.GitPullRequestStatus{
+		testPullRequestStatus("security", "scan", authorId, older),
+		testPullRequestStatus("build", "lint", authorId, older),
+	}, nil).Times(1)
+
+	d := schema.TestResourceDataRaw(t, DataGitRepositoryPullRequestStatuses().Schema, map[string]interface{}{
+		"repository_id":      repoId,
+		"pull_request_count": 10,
+	})
+	require.Empty(t, dataSourceGitRepositoryPullRequestStatusesRead(context.Background(), d, clients))
+
+	statuses := d.Get("statuses").([]interface{})
+	require.Len(t, statuses, 2)
+	lint := statuses[0].(map[string]interface{})
+	assert.Equal(t, "build", lint["genre"])
+	assert.Equal(t, "lint", lint["name"])
+	scan := statuses[1].(map[string]interface{})
+	assert.Equal(t, "security", scan["genre"])
+	assert.Equal(t, "scan", scan["name"])
+	assert.Equal(t, authorId, scan["author_id"])
+	assert.Equal(t, "aadsp."+authorId, scan["author_descriptor"])
+	assert.Equal(t, newer.Format(time.RFC3339), scan["last_posted_date"])
+}
+
+func TestDataGitRepositoryPullRequestStatuses_Read_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{GitReposClient: reposClient, Ctx: context.Background()}
+
+	reposClient.EXPECT().GetPullRequests(clients.Ctx, gomock.Any()).Return(&[]git.GitPullRequest{
+		{PullRequestId: converter.Int(1)},
+	}, nil).Times(1)
+	reposClient.EXPECT().GetPullRequestStatuses(clients.Ctx, gomock.Any()).Return(nil, errors.New("GetPullRequestStatuses() Failed")).Times(1)
+
+	d := schema.TestResourceDataRaw(t, DataGitRepositoryPullRequestStatuses().Schema, map[string]interface{}{
+		"repository_id": uuid.NewString(),
+	})
+	diags := dataSourceGitRepositoryPullRequestStatusesRead(context.Background(), d, clients)
+	require.NotEmpty(t, diags)
+	require.Contains(t, diags[0].Summary, "GetPullRequestStatuses() Failed")
+}
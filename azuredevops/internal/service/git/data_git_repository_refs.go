@@ -0,0 +1,165 @@
+package git
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+)
+
+const (
+	gitRefTypeAll    = "all"
+	gitRefTypeBranch = "branch"
+	gitRefTypeTag    = "tag"
+)
+
+// DataGitRepositoryRefs schema and implementation for listing the branches and tags of a git repository
+func DataGitRepositoryRefs() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceGitRepositoryRefsRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"repository_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      gitRefTypeAll,
+				ValidateFunc: validation.StringInSlice([]string{gitRefTypeAll, gitRefTypeBranch, gitRefTypeTag}, false),
+			},
+			// The prefix is matched against the short name of the refs, e.g. `releases/` or `v1.`
+			"prefix": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"refs": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"short_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"object_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"commit_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"is_locked": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGitRepositoryRefsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+
+	repoId := d.Get("repository_id").(string)
+	refType := d.Get("type").(string)
+	prefix := d.Get("prefix").(string)
+
+	var filters []string
+	if refType == gitRefTypeAll || refType == gitRefTypeBranch {
+		filters = append(filters, "heads/"+prefix)
+	}
+	if refType == gitRefTypeAll || refType == gitRefTypeTag {
+		filters = append(filters, "tags/"+prefix)
+	}
+
+	refs := make([]interface{}, 0)
+	for _, filter := range filters {
+		values, err := getAllGitRefs(clients, repoId, filter)
+		if err != nil {
+			return diag.Errorf(" Listing refs of repository %s matching %s. Error: %+v", repoId, filter, err)
+		}
+		for _, ref := range values {
+			refs = append(refs, flattenGitRef(&ref))
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s:%s", repoId, refType, prefix))
+	if err := d.Set("refs", refs); err != nil {
+		return diag.Errorf(" Setting refs. Error: %+v", err)
+	}
+	return nil
+}
+
+// getAllGitRefs returns all refs starting with the filter, following the continuation token
+func getAllGitRefs(clients *client.AggregatedClient, repoId string, filter string) ([]git.GitRef, error) {
+	var refs []git.GitRef
+	var token *string
+	for {
+		resp, err := clients.GitReposClient.GetRefs(clients.Ctx, git.GetRefsArgs{
+			RepositoryId:      converter.String(repoId),
+			Filter:            converter.String(filter),
+			PeelTags:          converter.Bool(true),
+			ContinuationToken: token,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if resp == nil {
+			return refs, nil
+		}
+		refs = append(refs, resp.Value...)
+		if resp.ContinuationToken == "" {
+			return refs, nil
+		}
+		token = converter.String(resp.ContinuationToken)
+	}
+}
+
+func flattenGitRef(ref *git.GitRef) map[string]interface{} {
+	name := converter.ToString(ref.Name, "")
+	refType := gitRefTypeBranch
+	shortName := shortBranchName(name)
+	if strings.HasPrefix(name, REF_TAG_PREFIX) {
+		refType = gitRefTypeTag
+		shortName = shortTagName(name)
+	}
+
+	// The peeled object ID is only set for annotated tags, it is the ID of the tagged commit
+	commitId := converter.ToString(ref.ObjectId, "")
+	if ref.PeeledObjectId != nil {
+		commitId = *ref.PeeledObjectId
+	}
+
+	return map[string]interface{}{
+		"name":       name,
+		"short_name": shortName,
+		"type":       refType,
+		"object_id":  converter.ToString(ref.ObjectId, ""),
+		"commit_id":  commitId,
+		"is_locked":  converter.ToBool(ref.IsLocked, false),
+	}
+}
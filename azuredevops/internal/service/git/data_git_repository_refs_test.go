@@ -0,0 +1,78 @@
+package git
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestDataGitRepositoryRefs_Read_TagsWithPrefix(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{GitReposClient: reposClient, Ctx: context.Background()}
+
+	repoId := uuid.NewString()
+	gomock.InOrder(
+		reposClient.EXPECT().GetRefs(clients.Ctx, git.GetRefsArgs{
+			RepositoryId: converter.String(repoId),
+			Filter:       converter.String("tags/v1."),
+			PeelTags:     converter.Bool(true),
+		}).Return(&git.GetRefsResponseValue{
+			Value:             []git.GitRef{{Name: converter.String("refs/tags/v1.0.0"), ObjectId: converter.String("1111")}},
+			ContinuationToken: "next",
+		}, nil).Times(1),
+		reposClient.EXPECT().GetRefs(clients.Ctx, git.GetRefsArgs{
+			RepositoryId:      converter.String(repoId),
+			Filter:            converter.String("tags/v1."),
+			PeelTags:          converter.Bool(true),
+			ContinuationToken: converter.String("next"),
+		}).Return(&git.GetRefsResponseValue{
+			Value: []git.GitRef{{Name: converter.String("refs/tags/v1.1.0"), ObjectId: converter.String("2222"), PeeledObjectId: converter.String("3333")}},
+		}, nil).Times(1),
+	)
+
+	d := schema.TestResourceDataRaw(t, DataGitRepositoryRefs().Schema, map[string]interface{}{
+		"repository_id": repoId,
+		"type":          "tag",
+		"prefix":        "v1.",
+	})
+	require.Empty(t, dataSourceGitRepositoryRefsRead(context.Background(), d, clients))
+
+	refs := d.Get("refs").([]interface{})
+	require.Len(t, refs, 2)
+	annotated := refs[1].(map[string]interface{})
+	assert.Equal(t, "refs/tags/v1.1.0", annotated["name"])
+	assert.Equal(t, "v1.1.0", annotated["short_name"])
+	assert.Equal(t, "tag", annotated["type"])
+	assert.Equal(t, "2222", annotated["object_id"])
+	assert.Equal(t, "3333", annotated["commit_id"])
+}
+
+func TestDataGitRepositoryRefs_Read_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{GitReposClient: reposClient, Ctx: context.Background()}
+
+	reposClient.EXPECT().GetRefs(clients.Ctx, gomock.Any()).Return(nil, errors.New("GetRefs() Failed")).Times(1)
+
+	d := schema.TestResourceDataRaw(t, DataGitRepositoryRefs().Schema, map[string]interface{}{
+		"repository_id": uuid.NewString(),
+	})
+	diags := dataSourceGitRepositoryRefsRead(context.Background(), d, clients)
+	require.NotEmpty(t, diags)
+	require.Contains(t, diags[0].Summary, "GetRefs() Failed")
+}
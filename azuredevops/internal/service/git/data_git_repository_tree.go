@@ -0,0 +1,172 @@
+package git
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+)
+
+// DataGitRepositoryTree schema and implementation for listing the items of a git repository path
+func DataGitRepositoryTree() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceGitRepositoryTreeRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"repository_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"path": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "/",
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			// The default branch of the repository is used if none of branch, tag and commit ID is specified
+			"branch": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  validation.StringIsNotWhiteSpace,
+				ConflictsWith: []string{"tag", "commit_id"},
+			},
+			"tag": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  validation.StringIsNotWhiteSpace,
+				ConflictsWith: []string{"branch", "commit_id"},
+			},
+			"commit_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  validation.StringIsNotWhiteSpace,
+				ConflictsWith: []string{"branch", "tag"},
+			},
+			"recursive": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"items": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"path": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"object_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"is_folder": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGitRepositoryTreeRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+
+	repoId := d.Get("repository_id").(string)
+	scopePath := d.Get("path").(string)
+	if !strings.HasPrefix(scopePath, "/") {
+		scopePath = "/" + scopePath
+	}
+
+	recursion := git.VersionControlRecursionTypeValues.OneLevel
+	if d.Get("recursive").(bool) {
+		recursion = git.VersionControlRecursionTypeValues.Full
+	}
+
+	vDescriptor := expandGitVersionDescriptor(d)
+	items, err := clients.GitReposClient.GetItems(clients.Ctx, git.GetItemsArgs{
+		RepositoryId:      converter.String(repoId),
+		ScopePath:         converter.String(scopePath),
+		RecursionLevel:    &recursion,
+		VersionDescriptor: vDescriptor,
+	})
+	if err != nil {
+		return diag.Errorf(" Listing items of repository %s at %s. Error: %+v", repoId, scopePath, err)
+	}
+
+	results := make([]interface{}, 0)
+	if items != nil {
+		for _, item := range *items {
+			itemPath := converter.ToString(item.Path, "")
+			// The item of the path itself is part of the response
+			if strings.TrimSuffix(itemPath, "/") == strings.TrimSuffix(scopePath, "/") {
+				continue
+			}
+			objectType := ""
+			if item.GitObjectType != nil {
+				objectType = string(*item.GitObjectType)
+			}
+			results = append(results, map[string]interface{}{
+				"path":      itemPath,
+				"name":      path.Base(itemPath),
+				"type":      objectType,
+				"object_id": converter.ToString(item.ObjectId, ""),
+				"is_folder": converter.ToBool(item.IsFolder, false),
+			})
+		}
+	}
+
+	version := "default"
+	if vDescriptor != nil {
+		version = fmt.Sprintf("%s:%s", string(*vDescriptor.VersionType), *vDescriptor.Version)
+	}
+	d.SetId(fmt.Sprintf("%s%s:%s", repoId, scopePath, version))
+	if err := d.Set("items", results); err != nil {
+		return diag.Errorf(" Setting items. Error: %+v", err)
+	}
+	return nil
+}
+
+// expandGitVersionDescriptor returns the version of the branch, tag or commit_id attribute, or nil if none is set
+func expandGitVersionDescriptor(d *schema.ResourceData) *git.GitVersionDescriptor {
+	if v, ok := d.GetOk("branch"); ok {
+		return &git.GitVersionDescriptor{
+			VersionType: &git.GitVersionTypeValues.Branch,
+			Version:     converter.String(shortBranchName(v.(string))),
+		}
+	}
+	if v, ok := d.GetOk("tag"); ok {
+		return &git.GitVersionDescriptor{
+			VersionType: &git.GitVersionTypeValues.Tag,
+			Version:     converter.String(shortTagName(v.(string))),
+		}
+	}
+	if v, ok := d.GetOk("commit_id"); ok {
+		return &git.GitVersionDescriptor{
+			VersionType: &git.GitVersionTypeValues.Commit,
+			Version:     converter.String(v.(string)),
+		}
+	}
+	return nil
+}
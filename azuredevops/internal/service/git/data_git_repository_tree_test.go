@@ -0,0 +1,56 @@
+package git
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestDataGitRepositoryTree_Read_Recursive(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{GitReposClient: reposClient, Ctx: context.Background()}
+
+	repoId := uuid.NewString()
+	reposClient.EXPECT().GetItems(clients.Ctx, gomock.Any()).DoAndReturn(
+		func(ctx context.Context, args git.GetItemsArgs) (*[]git.GitItem, error) {
+			require.Equal(t, "/src", *args.ScopePath)
+			require.Equal(t, git.VersionControlRecursionTypeValues.Full, *args.RecursionLevel)
+			require.Equal(t, git.GitVersionTypeValues.Tag, *args.VersionDescriptor.VersionType)
+			require.Equal(t, "v1.0.0", *args.VersionDescriptor.Version)
+			return &[]git.GitItem{
+				{Path: converter.String("/src"), IsFolder: converter.Bool(true), GitObjectType: &git.GitObjectTypeValues.Tree},
+				{Path: converter.String("/src/lib"), IsFolder: converter.Bool(true), GitObjectType: &git.GitObjectTypeValues.Tree, ObjectId: converter.String("1111")},
+				{Path: converter.String("/src/lib/main.go"), GitObjectType: &git.GitObjectTypeValues.Blob, ObjectId: converter.String("2222")},
+			}, nil
+		}).Times(1)
+
+	d := schema.TestResourceDataRaw(t, DataGitRepositoryTree().Schema, map[string]interface{}{
+		"repository_id": repoId,
+		"path":          "src",
+		"tag":           "refs/tags/v1.0.0",
+		"recursive":     true,
+	})
+	require.Empty(t, dataSourceGitRepositoryTreeRead(context.Background(), d, clients))
+	assert.Equal(t, repoId+"/src:tag:v1.0.0", d.Id())
+
+	items := d.Get("items").([]interface{})
+	require.Len(t, items, 2)
+	file := items[1].(map[string]interface{})
+	assert.Equal(t, "/src/lib/main.go", file["path"])
+	assert.Equal(t, "main.go", file["name"])
+	assert.Equal(t, "blob", file["type"])
+	assert.Equal(t, "2222", file["object_id"])
+	assert.Equal(t, false, file["is_folder"])
+}
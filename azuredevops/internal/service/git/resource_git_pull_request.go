@@ -0,0 +1,754 @@
+package git
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/webapi"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+)
+
+// The topic branches generated for pull requests are created below this folder
+const pullRequestTopicBranchPrefix = "refs/heads/terraform/"
+
+// ResourceGitPullRequest schema and implementation for pull requests, optionally pushing file changes to the source branch
+func ResourceGitPullRequest() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceGitPullRequestCreate,
+		ReadContext:   resourceGitPullRequestRead,
+		UpdateContext: resourceGitPullRequestUpdate,
+		DeleteContext: resourceGitPullRequestDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: importGitPullRequest,
+		},
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+			if !d.NewValueKnown("file") || !d.NewValueKnown("directory") {
+				return d.SetNewComputed("file_object_ids")
+			}
+			contents, err := expandGitRepositoryFiles(d.Get("file").(map[string]interface{}), d.Get("directory").([]interface{}))
+			if err != nil {
+				return err
+			}
+			objectIDs := flattenGitRepositoryFileObjectIDs(contents)
+			old, _ := d.GetChange("file_object_ids")
+			if gitRepositoryFileObjectIDsEqual(old.(map[string]interface{}), objectIDs) {
+				return nil
+			}
+			if err := d.SetNew("file_object_ids", objectIDs); err != nil {
+				return err
+			}
+			// Changes can only be pushed to the source branch of an active pull request, a new pull request
+			// is opened for the changes once the pull request has been completed or abandoned.
+			if d.Id() != "" && d.Get("status").(string) != string(git.PullRequestStatusValues.Active) {
+				return d.ForceNew("file_object_ids")
+			}
+			return nil
+		},
+		Schema: map[string]*schema.Schema{
+			"repository_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"target_branch": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     validation.StringIsNotWhiteSpace,
+				DiffSuppressFunc: suppressBranchPrefix,
+			},
+
+			// A topic branch below `refs/heads/terraform/` is generated if no source branch is configured
+			"source_branch": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				ValidateFunc:     validation.StringIsNotWhiteSpace,
+				DiffSuppressFunc: suppressBranchPrefix,
+			},
+
+			// Only a generated topic branch is deleted together with the pull request
+			"source_branch_generated": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"title": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"is_draft": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"reviewer": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsUUID,
+						},
+						"required": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+
+			"work_item_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeInt,
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+			},
+
+			"auto_complete": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"merge_strategy": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  string(git.GitPullRequestMergeStrategyValues.NoFastForward),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(git.GitPullRequestMergeStrategyValues.NoFastForward),
+					string(git.GitPullRequestMergeStrategyValues.Squash),
+					string(git.GitPullRequestMergeStrategyValues.Rebase),
+					string(git.GitPullRequestMergeStrategyValues.RebaseMerge),
+				}, false),
+			},
+
+			"merge_commit_message": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+
+			"delete_source_branch": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"transition_work_items": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			// Waits until the pull request has been completed, either by auto-complete or by a user
+			"wait_for_completion": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			// The file paths relative to the root of the repository and their content, pushed to the source branch
+			"file": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"directory": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"source": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+						"pattern": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "*",
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+						"target_path": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "",
+						},
+					},
+				},
+			},
+
+			"commit_message": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+
+			"author_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"author_email": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"file_object_ids": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"commit_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"pull_request_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"merge_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"merge_commit_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceGitPullRequestCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+
+	repoID := d.Get("repository_id").(string)
+	targetBranch := withPrefix(REF_BRANCH_PREFIX, d.Get("target_branch").(string))
+	sourceBranch := pullRequestTopicBranchPrefix + strings.Split(uuid.NewString(), "-")[0]
+	v, sourceBranchConfigured := d.GetOk("source_branch")
+	if sourceBranchConfigured {
+		sourceBranch = withPrefix(REF_BRANCH_PREFIX, v.(string))
+	}
+
+	contents, err := expandGitRepositoryFiles(d.Get("file").(map[string]interface{}), d.Get("directory").([]interface{}))
+	if err != nil {
+		return diag.Errorf(" Expanding pull request files. Error: %+v", err)
+	}
+
+	if err := createPullRequestSourceBranch(clients, repoID, sourceBranch, targetBranch); err != nil {
+		return diag.Errorf(" Creating source branch %s. Error: %+v", sourceBranch, err)
+	}
+	d.Set("source_branch", sourceBranch)
+	d.Set("source_branch_generated", !sourceBranchConfigured)
+
+	if len(contents) > 0 {
+		existing, err := getGitRepositoryFileObjectIDs(clients, repoID, sourceBranch)
+		if err != nil {
+			return deleteFailedPullRequestTopicBranch(clients, repoID, sourceBranch, !sourceBranchConfigured,
+				diag.Errorf(" Getting files of repository %s, branch %s. Error: %+v", repoID, sourceBranch, err))
+		}
+		current := map[string]string{}
+		for filePath := range contents {
+			if objectID, ok := existing[filePath]; ok {
+				current[filePath] = objectID
+			}
+		}
+
+		commitID, err := pushGitRepositoryFiles(clients, d, sourceBranch, current, contents, d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return deleteFailedPullRequestTopicBranch(clients, repoID, sourceBranch, !sourceBranchConfigured,
+				diag.Errorf(" Pushing pull request files, repository ID: %s, branch: %s. Error: %+v", repoID, sourceBranch, err))
+		}
+		d.Set("commit_id", commitID)
+		d.Set("file_object_ids", flattenGitRepositoryFileObjectIDs(contents))
+	}
+
+	var workItemRefs []webapi.ResourceRef
+	for _, id := range d.Get("work_item_ids").(*schema.Set).List() {
+		workItemRefs = append(workItemRefs, webapi.ResourceRef{Id: converter.String(strconv.Itoa(id.(int)))})
+	}
+
+	pullRequest, err := clients.GitReposClient.CreatePullRequest(clients.Ctx, git.CreatePullRequestArgs{
+		RepositoryId: &repoID,
+		GitPullRequestToCreate: &git.GitPullRequest{
+			SourceRefName: &sourceBranch,
+			TargetRefName: &targetBranch,
+			Title:         converter.String(d.Get("title").(string)),
+			Description:   converter.String(d.Get("description").(string)),
+			IsDraft:       converter.Bool(d.Get("is_draft").(bool)),
+			Reviewers:     expandPullRequestReviewers(d.Get("reviewer").(*schema.Set)),
+			WorkItemRefs:  &workItemRefs,
+		},
+	})
+	if err != nil {
+		return deleteFailedPullRequestTopicBranch(clients, repoID, sourceBranch, !sourceBranchConfigured,
+			diag.Errorf(" Creating pull request from %s to %s. Error: %+v", sourceBranch, targetBranch, err))
+	}
+	d.SetId(strconv.Itoa(*pullRequest.PullRequestId))
+
+	if d.Get("auto_complete").(bool) {
+		if err := updatePullRequestAutoComplete(clients, d, pullRequest); err != nil {
+			return diag.Errorf(" Setting auto-complete of pull request %s. Error: %+v", d.Id(), err)
+		}
+	}
+	if d.Get("wait_for_completion").(bool) {
+		if err := waitForPullRequestCompletion(clients, repoID, *pullRequest.PullRequestId, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.Errorf(" Waiting for completion of pull request %s. Error: %+v", d.Id(), err)
+		}
+	}
+	return resourceGitPullRequestRead(clients.Ctx, d, m)
+}
+
+func resourceGitPullRequestRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+
+	pullRequestID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.Errorf(" Parsing pull request ID %s. Error: %+v", d.Id(), err)
+	}
+
+	pullRequest, err := clients.GitReposClient.GetPullRequest(clients.Ctx, git.GetPullRequestArgs{
+		RepositoryId:  converter.String(d.Get("repository_id").(string)),
+		PullRequestId: &pullRequestID,
+	})
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf(" Getting pull request %d. Error: %+v", pullRequestID, err)
+	}
+
+	d.Set("pull_request_id", pullRequestID)
+	d.Set("source_branch", converter.ToString(pullRequest.SourceRefName, ""))
+	d.Set("target_branch", converter.ToString(pullRequest.TargetRefName, ""))
+	d.Set("title", converter.ToString(pullRequest.Title, ""))
+	d.Set("description", converter.ToString(pullRequest.Description, ""))
+	d.Set("is_draft", converter.ToBool(pullRequest.IsDraft, false))
+	d.Set("reviewer", flattenPullRequestReviewers(d.Get("reviewer").(*schema.Set), pullRequest.Reviewers))
+
+	if pullRequest.Status != nil {
+		d.Set("status", string(*pullRequest.Status))
+	}
+	if pullRequest.MergeStatus != nil {
+		d.Set("merge_status", string(*pullRequest.MergeStatus))
+	}
+	if pullRequest.LastMergeCommit != nil {
+		d.Set("merge_commit_id", converter.ToString(pullRequest.LastMergeCommit.CommitId, ""))
+	}
+
+	// The completion options are kept if the pull request has been completed, auto-complete is
+	// cleared by the service once the pull request is merged.
+	if pullRequest.Status != nil && *pullRequest.Status == git.PullRequestStatusValues.Active {
+		d.Set("auto_complete", pullRequest.AutoCompleteSetBy != nil && pullRequest.AutoCompleteSetBy.Id != nil &&
+			*pullRequest.AutoCompleteSetBy.Id != uuid.Nil.String())
+	}
+	if options := pullRequest.CompletionOptions; options != nil {
+		if options.MergeStrategy != nil {
+			d.Set("merge_strategy", string(*options.MergeStrategy))
+		}
+		d.Set("merge_commit_message", converter.ToString(options.MergeCommitMessage, ""))
+		d.Set("delete_source_branch", converter.ToBool(options.DeleteSourceBranch, false))
+		d.Set("transition_work_items", converter.ToBool(options.TransitionWorkItems, false))
+	}
+	return nil
+}
+
+func resourceGitPullRequestUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+
+	repoID := d.Get("repository_id").(string)
+	pullRequestID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.Errorf(" Parsing pull request ID %s. Error: %+v", d.Id(), err)
+	}
+
+	if d.HasChange("file_object_ids") {
+		contents, err := expandGitRepositoryFiles(d.Get("file").(map[string]interface{}), d.Get("directory").([]interface{}))
+		if err != nil {
+			return diag.Errorf(" Expanding pull request files. Error: %+v", err)
+		}
+
+		old, _ := d.GetChange("file_object_ids")
+		current := map[string]string{}
+		for filePath, objectID := range old.(map[string]interface{}) {
+			current[filePath] = objectID.(string)
+		}
+
+		sourceBranch := d.Get("source_branch").(string)
+		commitID, err := pushGitRepositoryFiles(clients, d, sourceBranch, current, contents, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.Errorf(" Pushing pull request files, repository ID: %s, branch: %s. Error: %+v", repoID, sourceBranch, err)
+		}
+		if commitID != "" {
+			d.Set("commit_id", commitID)
+		}
+		d.Set("file_object_ids", flattenGitRepositoryFileObjectIDs(contents))
+	}
+
+	if d.HasChanges("title", "description", "is_draft") {
+		_, err := clients.GitReposClient.UpdatePullRequest(clients.Ctx, git.UpdatePullRequestArgs{
+			RepositoryId:  &repoID,
+			PullRequestId: &pullRequestID,
+			GitPullRequestToUpdate: &git.GitPullRequest{
+				Title:       converter.String(d.Get("title").(string)),
+				Description: converter.String(d.Get("description").(string)),
+				IsDraft:     converter.Bool(d.Get("is_draft").(bool)),
+			},
+		})
+		if err != nil {
+			return diag.Errorf(" Updating pull request %d. Error: %+v", pullRequestID, err)
+		}
+	}
+
+	if d.HasChange("reviewer") {
+		if err := updatePullRequestReviewers(clients, d, repoID, pullRequestID); err != nil {
+			return diag.Errorf(" Updating reviewers of pull request %d. Error: %+v", pullRequestID, err)
+		}
+	}
+
+	if d.HasChanges("auto_complete", "merge_strategy", "merge_commit_message", "delete_source_branch", "transition_work_items") {
+		pullRequest, err := clients.GitReposClient.GetPullRequest(clients.Ctx, git.GetPullRequestArgs{
+			RepositoryId:  &repoID,
+			PullRequestId: &pullRequestID,
+		})
+		if err != nil {
+			return diag.Errorf(" Getting pull request %d. Error: %+v", pullRequestID, err)
+		}
+		if err := updatePullRequestAutoComplete(clients, d, pullRequest); err != nil {
+			return diag.Errorf(" Setting auto-complete of pull request %d. Error: %+v", pullRequestID, err)
+		}
+	}
+
+	if d.Get("wait_for_completion").(bool) {
+		if err := waitForPullRequestCompletion(clients, repoID, pullRequestID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf(" Waiting for completion of pull request %d. Error: %+v", pullRequestID, err)
+		}
+	}
+	return resourceGitPullRequestRead(clients.Ctx, d, m)
+}
+
+func resourceGitPullRequestDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+
+	pullRequestID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.Errorf(" Parsing pull request ID %s. Error: %+v", d.Id(), err)
+	}
+
+	repoID := d.Get("repository_id").(string)
+	pullRequest, err := clients.GitReposClient.GetPullRequest(clients.Ctx, git.GetPullRequestArgs{
+		RepositoryId:  &repoID,
+		PullRequestId: &pullRequestID,
+	})
+	if err != nil && !utils.ResponseWasNotFound(err) {
+		return diag.Errorf(" Getting pull request %d. Error: %+v", pullRequestID, err)
+	}
+
+	// Completed pull requests are part of the history of the target branch and are kept
+	if err == nil && pullRequest.Status != nil && *pullRequest.Status == git.PullRequestStatusValues.Active {
+		_, err = clients.GitReposClient.UpdatePullRequest(clients.Ctx, git.UpdatePullRequestArgs{
+			RepositoryId:  &repoID,
+			PullRequestId: &pullRequestID,
+			GitPullRequestToUpdate: &git.GitPullRequest{
+				Status: &git.PullRequestStatusValues.Abandoned,
+			},
+		})
+		if err != nil {
+			return diag.Errorf(" Abandoning pull request %d. Error: %+v", pullRequestID, err)
+		}
+	}
+
+	// A user supplied source branch is kept, the generated topic branch is not used once the pull request is closed
+	if d.Get("source_branch_generated").(bool) {
+		sourceBranch := d.Get("source_branch").(string)
+		if err := deletePullRequestTopicBranch(clients, repoID, sourceBranch); err != nil {
+			return diag.Errorf(" Deleting source branch %s. Error: %+v", sourceBranch, err)
+		}
+	}
+	return nil
+}
+
+func importGitPullRequest(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf(" Unexpected format of ID (%s), expected repositoryId/pullRequestId", d.Id())
+	}
+	if _, err := uuid.Parse(parts[0]); err != nil {
+		return nil, fmt.Errorf(" Repository ID (%s) is not a valid UUID. Error: %+v", parts[0], err)
+	}
+	if _, err := strconv.Atoi(parts[1]); err != nil {
+		return nil, fmt.Errorf(" Pull request ID (%s) is not a valid integer. Error: %+v", parts[1], err)
+	}
+
+	d.Set("repository_id", parts[0])
+	d.SetId(parts[1])
+	return []*schema.ResourceData{d}, nil
+}
+
+// createPullRequestSourceBranch creates the source branch from the head of the target branch, an existing
+// source branch is used as it is.
+func createPullRequestSourceBranch(clients *client.AggregatedClient, repoID string, sourceBranch string, targetBranch string) error {
+	source, err := checkRepositoryBranchExists(clients, repoID, sourceBranch)
+	if err != nil {
+		return err
+	}
+	if source != nil {
+		return nil
+	}
+
+	target, err := checkRepositoryBranchExists(clients, repoID, targetBranch)
+	if err != nil {
+		return err
+	}
+	if target == nil || target.ObjectId == nil {
+		return fmt.Errorf("target branch %s not found", targetBranch)
+	}
+
+	return updateRefs(clients, git.UpdateRefsArgs{
+		RefUpdates: &[]git.GitRefUpdate{{
+			Name:        &sourceBranch,
+			NewObjectId: target.ObjectId,
+			OldObjectId: converter.String("0000000000000000000000000000000000000000"),
+		}},
+		RepositoryId: &repoID,
+	})
+}
+
+// deletePullRequestTopicBranch deletes the topic branch generated for the pull request if it still exists
+func deletePullRequestTopicBranch(clients *client.AggregatedClient, repoID string, sourceBranch string) error {
+	if !strings.HasPrefix(sourceBranch, pullRequestTopicBranchPrefix) {
+		return nil
+	}
+	branch, err := checkRepositoryBranchExists(clients, repoID, sourceBranch)
+	if err != nil {
+		return err
+	}
+	if branch == nil || branch.ObjectId == nil {
+		return nil
+	}
+
+	return updateRefs(clients, git.UpdateRefsArgs{
+		RefUpdates: &[]git.GitRefUpdate{{
+			Name:        &sourceBranch,
+			NewObjectId: converter.String("0000000000000000000000000000000000000000"),
+			OldObjectId: branch.ObjectId,
+		}},
+		RepositoryId: &repoID,
+	})
+}
+
+// deleteFailedPullRequestTopicBranch deletes the generated topic branch of a pull request that could not be created, so
+// that a retry does not leave another branch behind. A user supplied source branch is kept.
+func deleteFailedPullRequestTopicBranch(clients *client.AggregatedClient, repoID string, sourceBranch string, generated bool, diags diag.Diagnostics) diag.Diagnostics {
+	if !generated {
+		return diags
+	}
+	if err := deletePullRequestTopicBranch(clients, repoID, sourceBranch); err != nil {
+		return append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("The generated source branch %s could not be deleted", sourceBranch),
+			Detail:   fmt.Sprintf(" Deleting source branch %s. Error: %+v", sourceBranch, err),
+		})
+	}
+	return diags
+}
+
+// updatePullRequestAutoComplete sets or clears auto-complete on behalf of the identity that created the pull request
+func updatePullRequestAutoComplete(clients *client.AggregatedClient, d *schema.ResourceData, pullRequest *git.GitPullRequest) error {
+	autoCompleteSetBy := uuid.Nil.String()
+	if d.Get("auto_complete").(bool) {
+		if pullRequest.CreatedBy == nil || pullRequest.CreatedBy.Id == nil {
+			return fmt.Errorf("the creator of pull request %d is unknown", *pullRequest.PullRequestId)
+		}
+		autoCompleteSetBy = *pullRequest.CreatedBy.Id
+	}
+
+	mergeStrategy := git.GitPullRequestMergeStrategy(d.Get("merge_strategy").(string))
+	options := &git.GitPullRequestCompletionOptions{
+		MergeStrategy:       &mergeStrategy,
+		DeleteSourceBranch:  converter.Bool(d.Get("delete_source_branch").(bool)),
+		TransitionWorkItems: converter.Bool(d.Get("transition_work_items").(bool)),
+	}
+	if v, ok := d.GetOk("merge_commit_message"); ok {
+		options.MergeCommitMessage = converter.String(v.(string))
+	}
+
+	_, err := clients.GitReposClient.UpdatePullRequest(clients.Ctx, git.UpdatePullRequestArgs{
+		RepositoryId:  converter.String(d.Get("repository_id").(string)),
+		PullRequestId: pullRequest.PullRequestId,
+		GitPullRequestToUpdate: &git.GitPullRequest{
+			AutoCompleteSetBy: &webapi.IdentityRef{Id: &autoCompleteSetBy},
+			CompletionOptions: options,
+		},
+	})
+	return err
+}
+
+func updatePullRequestReviewers(clients *client.AggregatedClient, d *schema.ResourceData, repoID string, pullRequestID int) error {
+	oldReviewers, newReviewers := d.GetChange("reviewer")
+
+	// Reviewers whose `required` flag changed are in both sets, they are updated in place
+	keep := map[string]bool{}
+	for _, raw := range newReviewers.(*schema.Set).List() {
+		reviewer := raw.(map[string]interface{})
+		id := reviewer["id"].(string)
+		keep[strings.ToLower(id)] = true
+		_, err := clients.GitReposClient.CreatePullRequestReviewer(clients.Ctx, git.CreatePullRequestReviewerArgs{
+			RepositoryId:  &repoID,
+			PullRequestId: &pullRequestID,
+			ReviewerId:    &id,
+			Reviewer: &git.IdentityRefWithVote{
+				Id:         &id,
+				IsRequired: converter.Bool(reviewer["required"].(bool)),
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("adding reviewer %s: %+v", id, err)
+		}
+	}
+
+	for _, raw := range oldReviewers.(*schema.Set).List() {
+		id := raw.(map[string]interface{})["id"].(string)
+		if keep[strings.ToLower(id)] {
+			continue
+		}
+		err := clients.GitReposClient.DeletePullRequestReviewer(clients.Ctx, git.DeletePullRequestReviewerArgs{
+			RepositoryId:  &repoID,
+			PullRequestId: &pullRequestID,
+			ReviewerId:    &id,
+		})
+		if err != nil && !utils.ResponseWasNotFound(err) {
+			return fmt.Errorf("removing reviewer %s: %+v", id, err)
+		}
+	}
+	return nil
+}
+
+// waitForPullRequestCompletion waits until the pull request has been merged, failing early if the merge is not possible
+func waitForPullRequestCompletion(clients *client.AggregatedClient, repoID string, pullRequestID int, timeout time.Duration) error {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{string(git.PullRequestStatusValues.Active)},
+		Target:  []string{string(git.PullRequestStatusValues.Completed)},
+		Refresh: func() (interface{}, string, error) {
+			pullRequest, err := clients.GitReposClient.GetPullRequest(clients.Ctx, git.GetPullRequestArgs{
+				RepositoryId:  &repoID,
+				PullRequestId: &pullRequestID,
+			})
+			if err != nil {
+				return nil, "", err
+			}
+			if pullRequest.Status == nil {
+				return nil, "", fmt.Errorf("pull request %d has no status", pullRequestID)
+			}
+			if pullRequest.MergeStatus != nil {
+				switch *pullRequest.MergeStatus {
+				case git.PullRequestAsyncStatusValues.Conflicts,
+					git.PullRequestAsyncStatusValues.Failure,
+					git.PullRequestAsyncStatusValues.RejectedByPolicy:
+					return nil, "", fmt.Errorf("pull request %d cannot be merged, merge status: %s", pullRequestID, *pullRequest.MergeStatus)
+				}
+			}
+			return pullRequest, string(*pullRequest.Status), nil
+		},
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      5 * time.Second,
+	}
+	_, err := stateConf.WaitForStateContext(clients.Ctx)
+	return err
+}
+
+func expandPullRequestReviewers(reviewers *schema.Set) *[]git.IdentityRefWithVote {
+	result := []git.IdentityRefWithVote{}
+	for _, raw := range reviewers.List() {
+		reviewer := raw.(map[string]interface{})
+		result = append(result, git.IdentityRefWithVote{
+			Id:         converter.String(reviewer["id"].(string)),
+			IsRequired: converter.Bool(reviewer["required"].(bool)),
+		})
+	}
+	return &result
+}
+
+// flattenPullRequestReviewers returns the reviewers of the pull request that are managed by the resource. Reviewers
+// added by branch policies or by other users are ignored.
+func flattenPullRequestReviewers(configured *schema.Set, reviewers *[]git.IdentityRefWithVote) []interface{} {
+	managed := map[string]bool{}
+	for _, raw := range configured.List() {
+		managed[strings.ToLower(raw.(map[string]interface{})["id"].(string))] = true
+	}
+
+	var result []interface{}
+	if reviewers == nil {
+		return result
+	}
+	for _, reviewer := range *reviewers {
+		if reviewer.Id == nil {
+			continue
+		}
+		if !managed[strings.ToLower(*reviewer.Id)] {
+			continue
+		}
+		result = append(result, map[string]interface{}{
+			"id":       *reviewer.Id,
+			"required": converter.ToBool(reviewer.IsRequired, false),
+		})
+	}
+	return result
+}
+
+func suppressBranchPrefix(k, old, new string, d *schema.ResourceData) bool {
+	return withPrefix(REF_BRANCH_PREFIX, old) == withPrefix(REF_BRANCH_PREFIX, new)
+}
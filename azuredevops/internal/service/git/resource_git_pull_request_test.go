@@ -0,0 +1,323 @@
+package git
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/webapi"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var (
+	gitPullRequestRepoID    = uuid.NewString()
+	gitPullRequestCreatorID = uuid.NewString()
+	gitPullRequestReviewer  = uuid.NewString()
+)
+
+func TestGitPullRequest_Create_PushesFilesToTopicBranch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{GitReposClient: reposClient, Ctx: context.Background()}
+
+	var sourceBranch string
+	gomock.InOrder(
+		// The generated topic branch does not exist yet
+		reposClient.EXPECT().GetRefs(clients.Ctx, gomock.Any()).Return(&git.GetRefsResponseValue{}, nil).Times(1),
+		reposClient.EXPECT().GetRefs(clients.Ctx, gomock.Any()).Return(&git.GetRefsResponseValue{
+			Value: []git.GitRef{{Name: converter.String("refs/heads/main"), ObjectId: converter.String("1111")}},
+		}, nil).Times(1),
+		reposClient.EXPECT().UpdateRefs(clients.Ctx, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, args git.UpdateRefsArgs) (*[]git.GitRefUpdateResult, error) {
+				update := (*args.RefUpdates)[0]
+				sourceBranch = *update.Name
+				require.True(t, strings.HasPrefix(sourceBranch, "refs/heads/terraform/"))
+				require.Equal(t, "1111", *update.NewObjectId)
+				return &[]git.GitRefUpdateResult{{Success: converter.Bool(true)}}, nil
+			}).Times(1),
+		reposClient.EXPECT().GetItems(clients.Ctx, gomock.Any()).Return(&[]git.GitItem{
+			{Path: converter.String("/a.txt"), ObjectId: converter.String(getGitBlobObjectID([]byte("old")))},
+		}, nil).Times(1),
+		reposClient.EXPECT().GetRefs(clients.Ctx, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, args git.GetRefsArgs) (*git.GetRefsResponseValue, error) {
+				return &git.GetRefsResponseValue{Value: []git.GitRef{{Name: &sourceBranch, ObjectId: converter.String("1111")}}}, nil
+			}).Times(1),
+		reposClient.EXPECT().CreatePush(clients.Ctx, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, args git.CreatePushArgs) (*git.GitPush, error) {
+				require.Equal(t, sourceBranch, *(*args.Push.RefUpdates)[0].Name)
+				change := (*(*args.Push.Commits)[0].Changes)[0].(git.GitChange)
+				require.Equal(t, git.VersionControlChangeTypeValues.Edit, *change.ChangeType)
+				return &git.GitPush{Commits: &[]git.GitCommitRef{{CommitId: converter.String("2222")}}}, nil
+			}).Times(1),
+		reposClient.EXPECT().CreatePullRequest(clients.Ctx, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, args git.CreatePullRequestArgs) (*git.GitPullRequest, error) {
+				pr := args.GitPullRequestToCreate
+				require.Equal(t, sourceBranch, *pr.SourceRefName)
+				require.Equal(t, "refs/heads/main", *pr.TargetRefName)
+				require.Equal(t, "Update a.txt", *pr.Title)
+				require.Equal(t, gitPullRequestReviewer, *(*pr.Reviewers)[0].Id)
+				require.True(t, *(*pr.Reviewers)[0].IsRequired)
+				require.Equal(t, "42", *(*pr.WorkItemRefs)[0].Id)
+				return &git.GitPullRequest{
+					PullRequestId: converter.Int(7),
+					CreatedBy:     &webapi.IdentityRef{Id: converter.String(gitPullRequestCreatorID)},
+				}, nil
+			}).Times(1),
+		reposClient.EXPECT().UpdatePullRequest(clients.Ctx, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, args git.UpdatePullRequestArgs) (*git.GitPullRequest, error) {
+				pr := args.GitPullRequestToUpdate
+				require.Equal(t, 7, *args.PullRequestId)
+				require.Equal(t, gitPullRequestCreatorID, *pr.AutoCompleteSetBy.Id)
+				require.Equal(t, git.GitPullRequestMergeStrategyValues.Squash, *pr.CompletionOptions.MergeStrategy)
+				require.True(t, *pr.CompletionOptions.DeleteSourceBranch)
+				return &git.GitPullRequest{}, nil
+			}).Times(1),
+		reposClient.EXPECT().GetPullRequest(clients.Ctx, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, args git.GetPullRequestArgs) (*git.GitPullRequest, error) {
+				mergeStrategy := git.GitPullRequestMergeStrategyValues.Squash
+				return &git.GitPullRequest{
+					PullRequestId:     converter.Int(7),
+					SourceRefName:     &sourceBranch,
+					TargetRefName:     converter.String("refs/heads/main"),
+					Title:             converter.String("Update a.txt"),
+					Status:            &git.PullRequestStatusValues.Active,
+					MergeStatus:       &git.PullRequestAsyncStatusValues.Queued,
+					AutoCompleteSetBy: &webapi.IdentityRef{Id: converter.String(gitPullRequestCreatorID)},
+					CompletionOptions: &git.GitPullRequestCompletionOptions{
+						MergeStrategy:      &mergeStrategy,
+						DeleteSourceBranch: converter.Bool(true),
+					},
+					Reviewers: &[]git.IdentityRefWithVote{
+						{Id: converter.String(gitPullRequestReviewer), IsRequired: converter.Bool(true)},
+						// Added by a branch policy
+						{Id: converter.String(uuid.NewString()), IsRequired: converter.Bool(true)},
+					},
+				}, nil
+			}).Times(1),
+	)
+
+	d := schema.TestResourceDataRaw(t, ResourceGitPullRequest().Schema, map[string]interface{}{
+		"repository_id":        gitPullRequestRepoID,
+		"target_branch":        "main",
+		"title":                "Update a.txt",
+		"auto_complete":        true,
+		"merge_strategy":       "squash",
+		"delete_source_branch": true,
+		"work_item_ids":        []interface{}{42},
+		"file":                 map[string]interface{}{"a.txt": "new"},
+		"reviewer": []interface{}{
+			map[string]interface{}{"id": gitPullRequestReviewer, "required": true},
+		},
+	})
+	diags := resourceGitPullRequestCreate(context.Background(), d, clients)
+	require.Empty(t, diags)
+	assert.Equal(t, "7", d.Id())
+	assert.Equal(t, sourceBranch, d.Get("source_branch"))
+	assert.True(t, d.Get("source_branch_generated").(bool))
+	assert.Equal(t, "2222", d.Get("commit_id"))
+	assert.Equal(t, "active", d.Get("status"))
+	assert.True(t, d.Get("auto_complete").(bool))
+	assert.Equal(t, 1, d.Get("reviewer").(*schema.Set).Len())
+}
+
+func TestGitPullRequest_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{GitReposClient: reposClient, Ctx: context.Background()}
+
+	// The configured source branch already exists and is used as it is
+	reposClient.EXPECT().GetRefs(clients.Ctx, gomock.Any()).Return(&git.GetRefsResponseValue{
+		Value: []git.GitRef{{Name: converter.String("refs/heads/feature"), ObjectId: converter.String("1111")}},
+	}, nil).Times(1)
+	reposClient.EXPECT().CreatePullRequest(clients.Ctx, gomock.Any()).Return(nil, errors.New("CreatePullRequest() Failed")).Times(1)
+	// The configured source branch is not deleted
+	reposClient.EXPECT().UpdateRefs(gomock.Any(), gomock.Any()).Times(0)
+
+	d := schema.TestResourceDataRaw(t, ResourceGitPullRequest().Schema, map[string]interface{}{
+		"repository_id": gitPullRequestRepoID,
+		"source_branch": "feature",
+		"target_branch": "refs/heads/main",
+		"title":         "Feature",
+	})
+	diags := resourceGitPullRequestCreate(context.Background(), d, clients)
+	require.NotEmpty(t, diags)
+	require.Contains(t, diags[0].Summary, "CreatePullRequest() Failed")
+}
+
+func TestGitPullRequest_Create_DeletesGeneratedTopicBranchOnError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{GitReposClient: reposClient, Ctx: context.Background()}
+
+	var sourceBranch string
+	gomock.InOrder(
+		reposClient.EXPECT().GetRefs(clients.Ctx, gomock.Any()).Return(&git.GetRefsResponseValue{}, nil).Times(1),
+		reposClient.EXPECT().GetRefs(clients.Ctx, gomock.Any()).Return(&git.GetRefsResponseValue{
+			Value: []git.GitRef{{Name: converter.String("refs/heads/main"), ObjectId: converter.String("1111")}},
+		}, nil).Times(1),
+		reposClient.EXPECT().UpdateRefs(clients.Ctx, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, args git.UpdateRefsArgs) (*[]git.GitRefUpdateResult, error) {
+				sourceBranch = *(*args.RefUpdates)[0].Name
+				return &[]git.GitRefUpdateResult{{Success: converter.Bool(true)}}, nil
+			}).Times(1),
+		reposClient.EXPECT().CreatePullRequest(clients.Ctx, gomock.Any()).Return(nil, errors.New("CreatePullRequest() Failed")).Times(1),
+		reposClient.EXPECT().GetRefs(clients.Ctx, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, args git.GetRefsArgs) (*git.GetRefsResponseValue, error) {
+				return &git.GetRefsResponseValue{Value: []git.GitRef{{Name: &sourceBranch, ObjectId: converter.String("1111")}}}, nil
+			}).Times(1),
+		reposClient.EXPECT().UpdateRefs(clients.Ctx, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, args git.UpdateRefsArgs) (*[]git.GitRefUpdateResult, error) {
+				update := (*args.RefUpdates)[0]
+				require.Equal(t, sourceBranch, *update.Name)
+				require.Equal(t, "1111", *update.OldObjectId)
+				require.Equal(t, "0000000000000000000000000000000000000000", *update.NewObjectId)
+				return &[]git.GitRefUpdateResult{{Success: converter.Bool(true)}}, nil
+			}).Times(1),
+	)
+
+	d := schema.TestResourceDataRaw(t, ResourceGitPullRequest().Schema, map[string]interface{}{
+		"repository_id": gitPullRequestRepoID,
+		"target_branch": "main",
+		"title":         "Feature",
+	})
+	diags := resourceGitPullRequestCreate(context.Background(), d, clients)
+	require.Len(t, diags, 1)
+	require.Contains(t, diags[0].Summary, "CreatePullRequest() Failed")
+	require.Empty(t, d.Id())
+}
+
+func TestGitPullRequest_Delete_AbandonsActivePullRequest(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{GitReposClient: reposClient, Ctx: context.Background()}
+
+	reposClient.EXPECT().GetPullRequest(clients.Ctx, gomock.Any()).Return(&git.GitPullRequest{
+		PullRequestId: converter.Int(7),
+		Status:        &git.PullRequestStatusValues.Active,
+	}, nil).Times(1)
+	reposClient.EXPECT().UpdatePullRequest(clients.Ctx, gomock.Any()).DoAndReturn(
+		func(ctx context.Context, args git.UpdatePullRequestArgs) (*git.GitPullRequest, error) {
+			require.Equal(t, git.PullRequestStatusValues.Abandoned, *args.GitPullRequestToUpdate.Status)
+			return &git.GitPullRequest{}, nil
+		}).Times(1)
+
+	d := schema.TestResourceDataRaw(t, ResourceGitPullRequest().Schema, map[string]interface{}{
+		"repository_id": gitPullRequestRepoID,
+	})
+	d.SetId("7")
+	require.Empty(t, resourceGitPullRequestDelete(context.Background(), d, clients))
+}
+
+func TestGitPullRequest_Delete_KeepsCompletedPullRequest(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{GitReposClient: reposClient, Ctx: context.Background()}
+
+	reposClient.EXPECT().GetPullRequest(clients.Ctx, gomock.Any()).Return(&git.GitPullRequest{
+		PullRequestId: converter.Int(7),
+		Status:        &git.PullRequestStatusValues.Completed,
+	}, nil).Times(1)
+
+	d := schema.TestResourceDataRaw(t, ResourceGitPullRequest().Schema, map[string]interface{}{
+		"repository_id": gitPullRequestRepoID,
+	})
+	d.SetId("7")
+	require.Empty(t, resourceGitPullRequestDelete(context.Background(), d, clients))
+}
+
+func TestGitPullRequest_Delete_DeletesGeneratedTopicBranch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{GitReposClient: reposClient, Ctx: context.Background()}
+
+	sourceBranch := pullRequestTopicBranchPrefix + "1a2b3c4d"
+	gomock.InOrder(
+		reposClient.EXPECT().GetPullRequest(clients.Ctx, gomock.Any()).Return(&git.GitPullRequest{
+			PullRequestId: converter.Int(7),
+			Status:        &git.PullRequestStatusValues.Active,
+		}, nil).Times(1),
+		reposClient.EXPECT().UpdatePullRequest(clients.Ctx, gomock.Any()).Return(&git.GitPullRequest{}, nil).Times(1),
+		reposClient.EXPECT().GetRefs(clients.Ctx, gomock.Any()).Return(&git.GetRefsResponseValue{
+			Value: []git.GitRef{{Name: converter.String(sourceBranch), ObjectId: converter.String("3333")}},
+		}, nil).Times(1),
+		reposClient.EXPECT().UpdateRefs(clients.Ctx, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, args git.UpdateRefsArgs) (*[]git.GitRefUpdateResult, error) {
+				update := (*args.RefUpdates)[0]
+				require.Equal(t, sourceBranch, *update.Name)
+				require.Equal(t, "3333", *update.OldObjectId)
+				require.Equal(t, "0000000000000000000000000000000000000000", *update.NewObjectId)
+				return &[]git.GitRefUpdateResult{{Success: converter.Bool(true)}}, nil
+			}).Times(1),
+	)
+
+	d := schema.TestResourceDataRaw(t, ResourceGitPullRequest().Schema, map[string]interface{}{
+		"repository_id": gitPullRequestRepoID,
+		"source_branch": sourceBranch,
+	})
+	d.Set("source_branch_generated", true)
+	d.SetId("7")
+	require.Empty(t, resourceGitPullRequestDelete(context.Background(), d, clients))
+}
+
+func TestGitPullRequest_Delete_KeepsConfiguredSourceBranch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{GitReposClient: reposClient, Ctx: context.Background()}
+
+	reposClient.EXPECT().GetPullRequest(clients.Ctx, gomock.Any()).Return(&git.GitPullRequest{
+		PullRequestId: converter.Int(7),
+		Status:        &git.PullRequestStatusValues.Active,
+	}, nil).Times(1)
+	reposClient.EXPECT().UpdatePullRequest(clients.Ctx, gomock.Any()).Return(&git.GitPullRequest{}, nil).Times(1)
+	reposClient.EXPECT().UpdateRefs(gomock.Any(), gomock.Any()).Times(0)
+
+	// A configured branch below the topic branch prefix is kept as well
+	d := schema.TestResourceDataRaw(t, ResourceGitPullRequest().Schema, map[string]interface{}{
+		"repository_id": gitPullRequestRepoID,
+		"source_branch": pullRequestTopicBranchPrefix + "feature",
+	})
+	d.Set("source_branch_generated", false)
+	d.SetId("7")
+	require.Empty(t, resourceGitPullRequestDelete(context.Background(), d, clients))
+}
+
+func TestGitPullRequest_WaitForCompletion_FailsOnConflicts(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{GitReposClient: reposClient, Ctx: context.Background()}
+
+	reposClient.EXPECT().GetPullRequest(clients.Ctx, gomock.Any()).Return(&git.GitPullRequest{
+		PullRequestId: converter.Int(7),
+		Status:        &git.PullRequestStatusValues.Active,
+		MergeStatus:   &git.PullRequestAsyncStatusValues.Conflicts,
+	}, nil).Times(1)
+
+	err := waitForPullRequestCompletion(clients, gitPullRequestRepoID, 7, time.Minute)
+	require.ErrorContains(t, err, "merge status: conflicts")
+}
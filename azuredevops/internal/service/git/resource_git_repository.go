@@ -663,36 +663,11 @@ func initializeRepository(clients *client.AggregatedClient, initialization *repo
 				importRequest.Parameters.ServiceEndpointId = converter.UUID(initialization.serviceConnectionID)
 				importRequest.Parameters.DeleteServiceEndpointAfterImportIsDone = converter.Bool(false)
 			} else if initialization.userName != "" || initialization.password != "" {
-				seName := fmt.Sprintf("Repository Import (%s)", uuid.New().String())
-				se, err := clients.ServiceEndpointClient.CreateServiceEndpoint(
-					clients.Ctx,
-					serviceendpoint.CreateServiceEndpointArgs{
-						Endpoint: &serviceendpoint.ServiceEndpoint{
-							Authorization: &serviceendpoint.EndpointAuthorization{
-								Parameters: &map[string]string{
-									"username": initialization.userName,
-									"password": initialization.password,
-								},
-								Scheme: converter.String("UsernamePassword"),
-							},
-							Name:  &seName,
-							Type:  converter.String("git"),
-							Url:   &initialization.sourceURL,
-							Owner: converter.String("library"),
-							ServiceEndpointProjectReferences: &[]serviceendpoint.ServiceEndpointProjectReference{
-								{
-									ProjectReference: &serviceendpoint.ProjectReference{
-										Id: converter.ToPtr(uuid.MustParse(projectId)),
-									},
-									Name: &seName,
-								},
-							},
-						},
-					})
+				seID, err := createImportServiceEndpoint(clients, projectId, initialization.sourceURL, initialization.userName, initialization.password)
 				if err != nil {
 					return err
 				}
-				importRequest.Parameters.ServiceEndpointId = se.Id
+				importRequest.Parameters.ServiceEndpointId = seID
 				importRequest.Parameters.DeleteServiceEndpointAfterImportIsDone = converter.Bool(true)
 			}
 
@@ -735,3 +710,37 @@ func initializeRepository(clients *client.AggregatedClient, initialization *repo
 	}
 	return nil
 }
+
+// createImportServiceEndpoint creates a service endpoint that holds the credentials of an import source
+func createImportServiceEndpoint(clients *client.AggregatedClient, projectId string, sourceURL string, userName string, password string) (*uuid.UUID, error) {
+	seName := fmt.Sprintf("Repository Import (%s)", uuid.New().String())
+	se, err := clients.ServiceEndpointClient.CreateServiceEndpoint(
+		clients.Ctx,
+		serviceendpoint.CreateServiceEndpointArgs{
+			Endpoint: &serviceendpoint.ServiceEndpoint{
+				Authorization: &serviceendpoint.EndpointAuthorization{
+					Parameters: &map[string]string{
+						"username": userName,
+						"password": password,
+					},
+					Scheme: converter.String("UsernamePassword"),
+				},
+				Name:  &seName,
+				Type:  converter.String("git"),
+				Url:   &sourceURL,
+				Owner: converter.String("library"),
+				ServiceEndpointProjectReferences: &[]serviceendpoint.ServiceEndpointProjectReference{
+					{
+						ProjectReference: &serviceendpoint.ProjectReference{
+							Id: converter.ToPtr(uuid.MustParse(projectId)),
+						},
+						Name: &seName,
+					},
+				},
+			},
+		})
+	if err != nil {
+		return nil, err
+	}
+	return se.Id, nil
+}
@@ -0,0 +1,133 @@
+package git
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
+)
+
+// ResourceGitRepositoryBranchLock schema to manage the lock of a git repository branch
+func ResourceGitRepositoryBranchLock() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceGitRepositoryBranchLockCreate,
+		ReadContext:   resourceGitRepositoryBranchLockRead,
+		DeleteContext: resourceGitRepositoryBranchLockDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"repository_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"branch": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"locked_by": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceGitRepositoryBranchLockCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+	repoId := d.Get("repository_id").(string)
+
+	branchName := d.Get("branch").(string)
+	if strings.HasPrefix(branchName, REF_BRANCH_PREFIX) {
+		return diag.Errorf("Branch name must be in short format without refs/heads/ prefix, got: %q", branchName)
+	}
+
+	if err := updateBranchLock(clients, repoId, branchName, true); err != nil {
+		return diag.FromErr(fmt.Errorf("Locking branch %q: %w", branchName, err))
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", repoId, branchName))
+	return resourceGitRepositoryBranchLockRead(ctx, d, m)
+}
+
+func resourceGitRepositoryBranchLockRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+
+	repoId, branchName, err := tfhelper.ParseGitRepoBranchID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ref, err := checkRepositoryBranchExists(clients, repoId, branchName)
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("Reading branch %q: %w", branchName, err))
+	}
+	// A branch that has been unlocked outside of Terraform is locked again on the next apply
+	if ref == nil || !converter.ToBool(ref.IsLocked, false) {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("repository_id", repoId)
+	d.Set("branch", branchName)
+	if ref.IsLockedBy != nil {
+		d.Set("locked_by", converter.ToString(ref.IsLockedBy.Id, ""))
+	}
+	return nil
+}
+
+func resourceGitRepositoryBranchLockDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+
+	repoId, branchName, err := tfhelper.ParseGitRepoBranchID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := updateBranchLock(clients, repoId, branchName, false); err != nil {
+		if utils.ResponseWasNotFound(err) {
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("Unlocking branch %q: %w", branchName, err))
+	}
+	return nil
+}
+
+func updateBranchLock(clients *client.AggregatedClient, repoId string, branchName string, locked bool) error {
+	ref, err := clients.GitReposClient.UpdateRef(clients.Ctx, git.UpdateRefArgs{
+		NewRefInfo: &git.GitRefUpdate{
+			IsLocked: converter.Bool(locked),
+		},
+		RepositoryId: converter.String(repoId),
+		Filter:       converter.String("heads/" + branchName),
+	})
+	if err != nil {
+		return err
+	}
+	if ref != nil && converter.ToBool(ref.IsLocked, false) != locked {
+		return fmt.Errorf("The lock of branch %q has not been updated", branchName)
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+package git
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/webapi"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var gitRepositoryBranchLockRepoID = uuid.NewString()
+
+func TestGitRepositoryBranchLock_Create(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{GitReposClient: reposClient, Ctx: context.Background()}
+
+	lockedBy := uuid.NewString()
+	reposClient.EXPECT().UpdateRef(clients.Ctx, git.UpdateRefArgs{
+		NewRefInfo:   &git.GitRefUpdate{IsLocked: converter.Bool(true)},
+		RepositoryId: converter.String(gitRepositoryBranchLockRepoID),
+		Filter:       converter.String("heads/releases/1.0"),
+	}).Return(&git.GitRef{IsLocked: converter.Bool(true)}, nil).Times(1)
+	reposClient.EXPECT().GetRefs(clients.Ctx, gomock.Any()).Return(&git.GetRefsResponseValue{
+		Value: []git.GitRef{{
+			Name:       converter.String("refs/heads/releases/1.0"),
+			IsLocked:   converter.Bool(true),
+			IsLockedBy: &webapi.IdentityRef{Id: &lockedBy},
+		}},
+	}, nil).Times(1)
+
+	d := schema.TestResourceDataRaw(t, ResourceGitRepositoryBranchLock().Schema, map[string]interface{}{
+		"repository_id": gitRepositoryBranchLockRepoID,
+		"branch":        "releases/1.0",
+	})
+	diags := resourceGitRepositoryBranchLockCreate(context.Background(), d, clients)
+	require.Empty(t, diags)
+	assert.Equal(t, gitRepositoryBranchLockRepoID+":releases/1.0", d.Id())
+	assert.Equal(t, lockedBy, d.Get("locked_by"))
+}
+
+func TestGitRepositoryBranchLock_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{GitReposClient: reposClient, Ctx: context.Background()}
+
+	reposClient.EXPECT().UpdateRef(clients.Ctx, gomock.Any()).Return(nil, errors.New("UpdateRef() Failed")).Times(1)
+
+	d := schema.TestResourceDataRaw(t, ResourceGitRepositoryBranchLock().Schema, map[string]interface{}{
+		"repository_id": gitRepositoryBranchLockRepoID,
+		"branch":        "main",
+	})
+	diags := resourceGitRepositoryBranchLockCreate(context.Background(), d, clients)
+	require.NotEmpty(t, diags)
+	require.Contains(t, diags[0].Summary, "UpdateRef() Failed")
+}
+
+func TestGitRepositoryBranchLock_Read_Unlocked_ClearsID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{GitReposClient: reposClient, Ctx: context.Background()}
+
+	reposClient.EXPECT().GetRefs(clients.Ctx, gomock.Any()).Return(&git.GetRefsResponseValue{
+		Value: []git.GitRef{{Name: converter.String("refs/heads/main"), IsLocked: converter.Bool(false)}},
+	}, nil).Times(1)
+
+	d := schema.TestResourceDataRaw(t, ResourceGitRepositoryBranchLock().Schema, nil)
+	d.SetId(gitRepositoryBranchLockRepoID + ":main")
+	require.Empty(t, resourceGitRepositoryBranchLockRead(context.Background(), d, clients))
+	assert.Empty(t, d.Id())
+}
+
+func TestGitRepositoryBranchLock_Delete_Unlocks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{GitReposClient: reposClient, Ctx: context.Background()}
+
+	reposClient.EXPECT().UpdateRef(clients.Ctx, git.UpdateRefArgs{
+		NewRefInfo:   &git.GitRefUpdate{IsLocked: converter.Bool(false)},
+		RepositoryId: converter.String(gitRepositoryBranchLockRepoID),
+		Filter:       converter.String("heads/main"),
+	}).Return(&git.GitRef{IsLocked: converter.Bool(false)}, nil).Times(1)
+
+	d := schema.TestResourceDataRaw(t, ResourceGitRepositoryBranchLock().Schema, nil)
+	d.SetId(gitRepositoryBranchLockRepoID + ":main")
+	require.Empty(t, resourceGitRepositoryBranchLockDelete(context.Background(), d, clients))
+}
@@ -0,0 +1,498 @@
+package git
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+)
+
+// ResourceGitRepositoryFiles schema and implementation for managing multiple files of a repository in a single commit
+func ResourceGitRepositoryFiles() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceGitRepositoryFilesCreate,
+		ReadContext:   resourceGitRepositoryFilesRead,
+		UpdateContext: resourceGitRepositoryFilesUpdate,
+		DeleteContext: resourceGitRepositoryFilesDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+			if !d.NewValueKnown("file") || !d.NewValueKnown("directory") {
+				return d.SetNewComputed("file_object_ids")
+			}
+			contents, err := expandGitRepositoryFiles(d.Get("file").(map[string]interface{}), d.Get("directory").([]interface{}))
+			if err != nil {
+				return err
+			}
+			// The object IDs are compared rather than the content, so changes made outside of Terraform
+			// and changes of the files in the local directories both show up as a diff.
+			objectIDs := flattenGitRepositoryFileObjectIDs(contents)
+			old, _ := d.GetChange("file_object_ids")
+			if !gitRepositoryFileObjectIDsEqual(old.(map[string]interface{}), objectIDs) {
+				return d.SetNew("file_object_ids", objectIDs)
+			}
+			return nil
+		},
+		Schema: map[string]*schema.Schema{
+			"repository_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"branch": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "refs/heads/master",
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+
+			// The file paths relative to the root of the repository and their content
+			"file": {
+				Type:         schema.TypeMap,
+				Optional:     true,
+				AtLeastOneOf: []string{"file", "directory"},
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"directory": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				AtLeastOneOf: []string{"file", "directory"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"source": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+						// Matched against the file names, e.g. `*.yml`
+						"pattern": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "*",
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+						"target_path": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "",
+						},
+					},
+				},
+			},
+
+			"commit_message": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+
+			"author_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"author_email": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"overwrite_on_create": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			// The git object IDs of the managed files, keyed by path
+			"file_object_ids": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"commit_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceGitRepositoryFilesCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+
+	repoID := d.Get("repository_id").(string)
+	branch := d.Get("branch").(string)
+
+	contents, err := expandGitRepositoryFiles(d.Get("file").(map[string]interface{}), d.Get("directory").([]interface{}))
+	if err != nil {
+		return diag.Errorf(" Expanding repository files. Error: %+v", err)
+	}
+
+	existing, err := getGitRepositoryFileObjectIDs(clients, repoID, branch)
+	if err != nil {
+		return diag.Errorf(" Getting files of repository %s, branch %s. Error: %+v", repoID, branch, err)
+	}
+
+	current := map[string]string{}
+	for filePath := range contents {
+		objectID, ok := existing[filePath]
+		if !ok {
+			continue
+		}
+		if !d.Get("overwrite_on_create").(bool) {
+			return diag.Errorf(" Refusing to overwrite existing file %s. Configure `overwrite_on_create` to `true` to override.", filePath)
+		}
+		current[filePath] = objectID
+	}
+
+	commitID, err := pushGitRepositoryFiles(clients, d, branch, current, contents, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.Errorf(" Creating repository files, repository ID: %s, branch: %s. Error: %+v", repoID, branch, err)
+	}
+	d.Set("commit_id", commitID)
+
+	d.SetId(fmt.Sprintf("%s:%s", repoID, branch))
+	// The planned object IDs are unknown if the content of a file is only known after apply
+	d.Set("file_object_ids", flattenGitRepositoryFileObjectIDs(contents))
+	return resourceGitRepositoryFilesRead(clients.Ctx, d, m)
+}
+
+func resourceGitRepositoryFilesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+
+	repoID := d.Get("repository_id").(string)
+	branch := d.Get("branch").(string)
+
+	ref, err := checkRepositoryBranchExists(clients, repoID, branch)
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf(" Getting branch %s of repository %s. Error: %+v", branch, repoID, err)
+	}
+	if ref == nil {
+		d.SetId("")
+		return nil
+	}
+
+	existing, err := getGitRepositoryFileObjectIDs(clients, repoID, branch)
+	if err != nil {
+		return diag.Errorf(" Getting files of repository %s, branch %s. Error: %+v", repoID, branch, err)
+	}
+
+	// Only the files managed by this resource are tracked, files that have been deleted outside of
+	// Terraform are dropped so that they are added again.
+	objectIDs := map[string]interface{}{}
+	for filePath := range d.Get("file_object_ids").(map[string]interface{}) {
+		if objectID, ok := existing[filePath]; ok {
+			objectIDs[filePath] = objectID
+		}
+	}
+	d.Set("file_object_ids", objectIDs)
+	return nil
+}
+
+func resourceGitRepositoryFilesUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+
+	contents, err := expandGitRepositoryFiles(d.Get("file").(map[string]interface{}), d.Get("directory").([]interface{}))
+	if err != nil {
+		return diag.Errorf(" Expanding repository files. Error: %+v", err)
+	}
+
+	old, _ := d.GetChange("file_object_ids")
+	current := map[string]string{}
+	for filePath, objectID := range old.(map[string]interface{}) {
+		current[filePath] = objectID.(string)
+	}
+
+	commitID, err := pushGitRepositoryFiles(clients, d, d.Get("branch").(string), current, contents, d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return diag.Errorf(" Updating repository files, repository ID: %s, branch: %s. Error: %+v", d.Get("repository_id").(string), d.Get("branch").(string), err)
+	}
+	if commitID != "" {
+		d.Set("commit_id", commitID)
+	}
+	d.Set("file_object_ids", flattenGitRepositoryFileObjectIDs(contents))
+	return resourceGitRepositoryFilesRead(clients.Ctx, d, m)
+}
+
+func resourceGitRepositoryFilesDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+
+	current := map[string]string{}
+	for filePath, objectID := range d.Get("file_object_ids").(map[string]interface{}) {
+		current[filePath] = objectID.(string)
+	}
+
+	if _, err := pushGitRepositoryFiles(clients, d, d.Get("branch").(string), current, map[string][]byte{}, d.Timeout(schema.TimeoutDelete)); err != nil {
+		if utils.ResponseWasNotFound(err) {
+			return nil
+		}
+		return diag.Errorf(" Deleting repository files, repository ID: %s, branch: %s. Error: %+v", d.Get("repository_id").(string), d.Get("branch").(string), err)
+	}
+	return nil
+}
+
+// pushGitRepositoryFiles pushes a single commit that turns the current files into the desired ones. Files that
+// are not desired anymore are deleted. The push is retried with the latest branch head if the branch has been
+// updated by another client in the meantime. The ID of the pushed commit is returned, it is empty if there
+// was nothing to push.
+func pushGitRepositoryFiles(clients *client.AggregatedClient, d *schema.ResourceData, branch string, current map[string]string, desired map[string][]byte, timeout time.Duration) (string, error) {
+	changes := getGitRepositoryFileChanges(current, desired)
+	if len(changes) == 0 {
+		return "", nil
+	}
+
+	repoID := d.Get("repository_id").(string)
+
+	message := d.Get("commit_message").(string)
+	if message == "" {
+		message = fmt.Sprintf("Update %d files", len(changes))
+	}
+
+	commit := git.GitCommitRef{
+		Comment: &message,
+		Changes: &changes,
+	}
+	if name, ok := d.GetOk("author_name"); ok {
+		commit.Author = &git.GitUserDate{
+			Name:  converter.String(name.(string)),
+			Email: converter.String(d.Get("author_email").(string)),
+		}
+	}
+
+	var commitID string
+	err := retry.RetryContext(clients.Ctx, timeout, func() *retry.RetryError {
+		// The head of the branch is used as the old object ID of the ref update. The service rejects the
+		// push if the branch has moved since, in which case the push is retried on top of the new head.
+		ref, err := checkRepositoryBranchExists(clients, repoID, branch)
+		if err != nil {
+			return retry.NonRetryableError(err)
+		}
+		if ref == nil || ref.ObjectId == nil {
+			return retry.NonRetryableError(fmt.Errorf("branch %s not found", branch))
+		}
+
+		push, err := clients.GitReposClient.CreatePush(clients.Ctx, git.CreatePushArgs{
+			RepositoryId: &repoID,
+			Push: &git.GitPush{
+				RefUpdates: &[]git.GitRefUpdate{
+					{
+						Name:        converter.String(withPrefix(REF_BRANCH_PREFIX, branch)),
+						OldObjectId: ref.ObjectId,
+					},
+				},
+				Commits: &[]git.GitCommitRef{commit},
+			},
+		})
+		if err != nil {
+			if utils.ResponseContainsStatusMessage(err, "has already been updated by another client") {
+				return retry.RetryableError(err)
+			}
+			return retry.NonRetryableError(err)
+		}
+
+		if push != nil && push.Commits != nil && len(*push.Commits) > 0 && (*push.Commits)[0].CommitId != nil {
+			commitID = *(*push.Commits)[0].CommitId
+		}
+		return nil
+	})
+	return commitID, err
+}
+
+// getGitRepositoryFileChanges returns the changes of a commit that turns the current files into the desired ones.
+// The changes are sorted by path so that the commits are reproducible.
+func getGitRepositoryFileChanges(current map[string]string, desired map[string][]byte) []interface{} {
+	var paths []string
+	for filePath := range desired {
+		paths = append(paths, filePath)
+	}
+	for filePath := range current {
+		if _, ok := desired[filePath]; !ok {
+			paths = append(paths, filePath)
+		}
+	}
+	sort.Strings(paths)
+
+	var changes []interface{}
+	for _, filePath := range paths {
+		item := git.GitItem{Path: converter.String("/" + filePath)}
+
+		content, isDesired := desired[filePath]
+		objectID, isCurrent := current[filePath]
+		switch {
+		case !isDesired:
+			changes = append(changes, git.GitChange{
+				ChangeType: &git.VersionControlChangeTypeValues.Delete,
+				Item:       item,
+			})
+		case !isCurrent || objectID != getGitBlobObjectID(content):
+			changeType := git.VersionControlChangeTypeValues.Add
+			if isCurrent {
+				changeType = git.VersionControlChangeTypeValues.Edit
+			}
+			changes = append(changes, git.GitChange{
+				ChangeType: &changeType,
+				Item:       item,
+				NewContent: &git.ItemContent{
+					Content:     converter.String(base64.StdEncoding.EncodeToString(content)),
+					ContentType: &git.ItemContentTypeValues.Base64Encoded,
+				},
+			})
+		}
+	}
+	return changes
+}
+
+// getGitRepositoryFileObjectIDs returns the object IDs of all files of a branch, keyed by their path without the leading slash
+func getGitRepositoryFileObjectIDs(clients *client.AggregatedClient, repoID string, branch string) (map[string]string, error) {
+	items, err := clients.GitReposClient.GetItems(clients.Ctx, git.GetItemsArgs{
+		RepositoryId:   &repoID,
+		ScopePath:      converter.String("/"),
+		RecursionLevel: &git.VersionControlRecursionTypeValues.Full,
+		VersionDescriptor: &git.GitVersionDescriptor{
+			Version:     converter.String(shortBranchName(branch)),
+			VersionType: &git.GitVersionTypeValues.Branch,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	objectIDs := map[string]string{}
+	if items == nil {
+		return objectIDs, nil
+	}
+	for _, item := range *items {
+		if item.Path == nil || item.ObjectId == nil || converter.ToBool(item.IsFolder, false) {
+			continue
+		}
+		objectIDs[strings.TrimPrefix(*item.Path, "/")] = *item.ObjectId
+	}
+	return objectIDs, nil
+}
+
+// expandGitRepositoryFiles returns the content of the configured files keyed by their path without the leading slash
+func expandGitRepositoryFiles(files map[string]interface{}, directories []interface{}) (map[string][]byte, error) {
+	contents := map[string][]byte{}
+	for filePath, content := range files {
+		normalized := normalizeGitRepositoryFilePath(filePath)
+		if normalized == "" {
+			return nil, fmt.Errorf("invalid file path %q", filePath)
+		}
+		if _, ok := contents[normalized]; ok {
+			return nil, fmt.Errorf("file %q is specified more than once", normalized)
+		}
+		contents[normalized] = []byte(content.(string))
+	}
+
+	for _, raw := range directories {
+		if raw == nil {
+			continue
+		}
+		directory := raw.(map[string]interface{})
+		source := directory["source"].(string)
+		pattern := directory["pattern"].(string)
+		if _, err := filepath.Match(pattern, ""); err != nil {
+			return nil, fmt.Errorf("invalid pattern %q: %+v", pattern, err)
+		}
+
+		err := filepath.WalkDir(source, func(filePath string, entry fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if entry.IsDir() {
+				return nil
+			}
+			if matched, _ := filepath.Match(pattern, entry.Name()); !matched {
+				return nil
+			}
+
+			relativePath, err := filepath.Rel(source, filePath)
+			if err != nil {
+				return err
+			}
+			normalized := normalizeGitRepositoryFilePath(path.Join(directory["target_path"].(string), filepath.ToSlash(relativePath)))
+			if _, ok := contents[normalized]; ok {
+				return fmt.Errorf("file %q is specified more than once", normalized)
+			}
+
+			content, err := os.ReadFile(filePath)
+			if err != nil {
+				return err
+			}
+			contents[normalized] = content
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("reading directory %q: %+v", source, err)
+		}
+	}
+	return contents, nil
+}
+
+func normalizeGitRepositoryFilePath(filePath string) string {
+	normalized := path.Clean("/" + strings.ReplaceAll(filePath, "\\", "/"))
+	return strings.TrimPrefix(normalized, "/")
+}
+
+func flattenGitRepositoryFileObjectIDs(contents map[string][]byte) map[string]interface{} {
+	objectIDs := map[string]interface{}{}
+	for filePath, content := range contents {
+		objectIDs[filePath] = getGitBlobObjectID(content)
+	}
+	return objectIDs
+}
+
+func gitRepositoryFileObjectIDsEqual(a map[string]interface{}, b map[string]interface{}) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for filePath, objectID := range a {
+		if b[filePath] != objectID {
+			return false
+		}
+	}
+	return true
+}
+
+// getGitBlobObjectID returns the ID git assigns to a blob with the given content
+func getGitBlobObjectID(content []byte) string {
+	h := sha1.New()
+	fmt.Fprintf(h, "blob %d\x00", len(content))
+	h.Write(content)
+	return hex.EncodeToString(h.Sum(nil))
+}
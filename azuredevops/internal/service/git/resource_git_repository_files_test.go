@@ -0,0 +1,186 @@
+package git
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var gitRepositoryFilesRepoID = uuid.NewString()
+
+func TestGitRepositoryFiles_GetGitBlobObjectID(t *testing.T) {
+	// The values match `git hash-object`
+	require.Equal(t, "e69de29bb2d1d6434b8b29ae775ad8c2e48c5391", getGitBlobObjectID([]byte{}))
+	require.Equal(t, "ce013625030ba8dba906f756967f9e9ca394464a", getGitBlobObjectID([]byte("hello\n")))
+}
+
+func TestGitRepositoryFiles_ExpandFiles(t *testing.T) {
+	source := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(source, "nested"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(source, "a.yml"), []byte("a"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(source, "nested", "b.yml"), []byte("b"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(source, "nested", "c.txt"), []byte("c"), 0o600))
+
+	contents, err := expandGitRepositoryFiles(
+		map[string]interface{}{"/README.md": "readme"},
+		[]interface{}{
+			map[string]interface{}{"source": source, "pattern": "*.yml", "target_path": "pipelines"},
+		})
+	require.NoError(t, err)
+	require.Equal(t, map[string][]byte{
+		"README.md":              []byte("readme"),
+		"pipelines/a.yml":        []byte("a"),
+		"pipelines/nested/b.yml": []byte("b"),
+	}, contents)
+
+	_, err = expandGitRepositoryFiles(
+		map[string]interface{}{"pipelines/a.yml": "a"},
+		[]interface{}{
+			map[string]interface{}{"source": source, "pattern": "*.yml", "target_path": "pipelines"},
+		})
+	require.ErrorContains(t, err, "specified more than once")
+}
+
+func TestGitRepositoryFiles_GetChanges(t *testing.T) {
+	current := map[string]string{
+		"unchanged.txt": getGitBlobObjectID([]byte("same")),
+		"changed.txt":   getGitBlobObjectID([]byte("old")),
+		"removed.txt":   getGitBlobObjectID([]byte("removed")),
+	}
+	desired := map[string][]byte{
+		"unchanged.txt": []byte("same"),
+		"changed.txt":   []byte("new"),
+		"added.txt":     []byte("added"),
+	}
+
+	changes := getGitRepositoryFileChanges(current, desired)
+	require.Len(t, changes, 3)
+
+	expected := []struct {
+		path       string
+		changeType git.VersionControlChangeType
+	}{
+		{"/added.txt", git.VersionControlChangeTypeValues.Add},
+		{"/changed.txt", git.VersionControlChangeTypeValues.Edit},
+		{"/removed.txt", git.VersionControlChangeTypeValues.Delete},
+	}
+	for i, e := range expected {
+		change := changes[i].(git.GitChange)
+		assert.Equal(t, e.path, *change.Item.(git.GitItem).Path)
+		assert.Equal(t, e.changeType, *change.ChangeType)
+	}
+	assert.Equal(t, base64.StdEncoding.EncodeToString([]byte("new")), *changes[1].(git.GitChange).NewContent.Content)
+	assert.Nil(t, changes[2].(git.GitChange).NewContent)
+}
+
+func TestGitRepositoryFiles_Create_RetriesOnStaleObjectID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{GitReposClient: reposClient, Ctx: context.Background()}
+
+	branchRef := func(objectID string) *git.GetRefsResponseValue {
+		return &git.GetRefsResponseValue{Value: []git.GitRef{{Name: converter.String("refs/heads/master"), ObjectId: converter.String(objectID)}}}
+	}
+	items := &[]git.GitItem{
+		{Path: converter.String("/"), IsFolder: converter.Bool(true), ObjectId: converter.String("tree")},
+		{Path: converter.String("/other.txt"), ObjectId: converter.String(getGitBlobObjectID([]byte("other")))},
+	}
+	code := 409
+	conflict := azuredevops.WrappedError{StatusCode: &code, Message: converter.String("TF401028: The reference 'refs/heads/master' has already been updated by another client, so you cannot update it.")}
+
+	gomock.InOrder(
+		reposClient.EXPECT().GetItems(clients.Ctx, gomock.Any()).Return(items, nil).Times(1),
+		reposClient.EXPECT().GetRefs(clients.Ctx, gomock.Any()).Return(branchRef("1111"), nil).Times(1),
+		reposClient.EXPECT().CreatePush(clients.Ctx, gomock.Any()).Return(nil, conflict).Times(1),
+		reposClient.EXPECT().GetRefs(clients.Ctx, gomock.Any()).Return(branchRef("2222"), nil).Times(1),
+		reposClient.EXPECT().CreatePush(clients.Ctx, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, args git.CreatePushArgs) (*git.GitPush, error) {
+				require.Equal(t, "2222", *(*args.Push.RefUpdates)[0].OldObjectId)
+				require.Equal(t, "refs/heads/master", *(*args.Push.RefUpdates)[0].Name)
+				commits := *args.Push.Commits
+				require.Len(t, commits, 1)
+				require.Len(t, *commits[0].Changes, 2)
+				require.Equal(t, "Update 2 files", *commits[0].Comment)
+				return &git.GitPush{Commits: &[]git.GitCommitRef{{CommitId: converter.String("3333")}}}, nil
+			}).Times(1),
+		reposClient.EXPECT().GetRefs(clients.Ctx, gomock.Any()).Return(branchRef("3333"), nil).Times(1),
+		reposClient.EXPECT().GetItems(clients.Ctx, gomock.Any()).Return(&[]git.GitItem{
+			{Path: converter.String("/a.txt"), ObjectId: converter.String(getGitBlobObjectID([]byte("a")))},
+			{Path: converter.String("/b/c.txt"), ObjectId: converter.String(getGitBlobObjectID([]byte("c")))},
+			{Path: converter.String("/other.txt"), ObjectId: converter.String(getGitBlobObjectID([]byte("other")))},
+		}, nil).Times(1),
+	)
+
+	d := schema.TestResourceDataRaw(t, ResourceGitRepositoryFiles().Schema, map[string]interface{}{
+		"repository_id": gitRepositoryFilesRepoID,
+		"file": map[string]interface{}{
+			"a.txt":   "a",
+			"b/c.txt": "c",
+		},
+	})
+	diags := resourceGitRepositoryFilesCreate(context.Background(), d, clients)
+	require.Empty(t, diags)
+	assert.Equal(t, gitRepositoryFilesRepoID+":refs/heads/master", d.Id())
+	assert.Equal(t, "3333", d.Get("commit_id"))
+	objectIDs := d.Get("file_object_ids").(map[string]interface{})
+	assert.Len(t, objectIDs, 2)
+	assert.Equal(t, getGitBlobObjectID([]byte("c")), objectIDs["b/c.txt"])
+}
+
+func TestGitRepositoryFiles_Create_RefusesToOverwrite(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{GitReposClient: reposClient, Ctx: context.Background()}
+
+	reposClient.EXPECT().GetItems(clients.Ctx, gomock.Any()).Return(&[]git.GitItem{
+		{Path: converter.String("/a.txt"), ObjectId: converter.String("1111")},
+	}, nil).Times(1)
+
+	d := schema.TestResourceDataRaw(t, ResourceGitRepositoryFiles().Schema, map[string]interface{}{
+		"repository_id": gitRepositoryFilesRepoID,
+		"file":          map[string]interface{}{"a.txt": "a"},
+	})
+	diags := resourceGitRepositoryFilesCreate(context.Background(), d, clients)
+	require.NotEmpty(t, diags)
+	require.Contains(t, diags[0].Summary, "Refusing to overwrite existing file a.txt")
+}
+
+func TestGitRepositoryFiles_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{GitReposClient: reposClient, Ctx: context.Background()}
+
+	reposClient.EXPECT().GetItems(clients.Ctx, gomock.Any()).Return(&[]git.GitItem{}, nil).Times(1)
+	reposClient.EXPECT().GetRefs(clients.Ctx, gomock.Any()).Return(&git.GetRefsResponseValue{
+		Value: []git.GitRef{{Name: converter.String("refs/heads/master"), ObjectId: converter.String("1111")}},
+	}, nil).Times(1)
+	reposClient.EXPECT().CreatePush(clients.Ctx, gomock.Any()).Return(nil, errors.New("CreatePush() Failed")).Times(1)
+
+	d := schema.TestResourceDataRaw(t, ResourceGitRepositoryFiles().Schema, map[string]interface{}{
+		"repository_id": gitRepositoryFilesRepoID,
+		"file":          map[string]interface{}{"a.txt": "a"},
+	})
+	diags := resourceGitRepositoryFilesCreate(context.Background(), d, clients)
+	require.NotEmpty(t, diags)
+	require.Contains(t, diags[0].Summary, "CreatePush() Failed")
+}
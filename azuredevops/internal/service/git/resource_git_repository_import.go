@@ -0,0 +1,268 @@
+package git
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+)
+
+// ResourceGitRepositoryImport schema and implementation for importing a Git or TFVC source into an existing, empty repository
+func ResourceGitRepositoryImport() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceGitRepositoryImportCreate,
+		ReadContext:   resourceGitRepositoryImportRead,
+		DeleteContext: resourceGitRepositoryImportDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"repository_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"source_url": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+				ExactlyOneOf: []string{"source_url", "tfvc_path"},
+			},
+
+			"service_connection_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ValidateFunc:  validation.IsUUID,
+				RequiredWith:  []string{"source_url"},
+				ConflictsWith: []string{"username", "password"},
+			},
+
+			"username": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ValidateFunc:  validation.StringIsNotWhiteSpace,
+				RequiredWith:  []string{"source_url", "password"},
+				ConflictsWith: []string{"service_connection_id"},
+			},
+
+			"password": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				Sensitive:     true,
+				ValidateFunc:  validation.StringIsNotWhiteSpace,
+				RequiredWith:  []string{"source_url"},
+				ConflictsWith: []string{"service_connection_id"},
+			},
+
+			// A TFVC path of the same organization, e.g. `$/Project/Main`
+			"tfvc_path": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^\$/`), "must be a TFVC path starting with `$/`"),
+				ExactlyOneOf: []string{"source_url", "tfvc_path"},
+			},
+
+			// The history of the last days is imported, only the latest version is imported if not set
+			"tfvc_history_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(1, 180),
+				RequiredWith: []string{"tfvc_path"},
+			},
+
+			// Arbitrary values that force a new import when changed, e.g. after the repository has been emptied
+			"triggers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceGitRepositoryImportCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+
+	projectID := d.Get("project_id").(string)
+	repoID := d.Get("repository_id").(string)
+
+	// The service only imports into empty repositories, failing early gives a better error than the import request
+	refs, err := clients.GitReposClient.GetRefs(clients.Ctx, git.GetRefsArgs{
+		RepositoryId: &repoID,
+		Project:      &projectID,
+		Top:          converter.Int(1),
+	})
+	if err != nil {
+		return diag.Errorf(" Getting refs of repository %s. Error: %+v", repoID, err)
+	}
+	if refs != nil && len(refs.Value) > 0 {
+		return diag.Errorf(" Repository %s is not empty, only empty repositories can be imported into.", repoID)
+	}
+
+	parameters := &git.GitImportRequestParameters{}
+	if v, ok := d.GetOk("tfvc_path"); ok {
+		parameters.TfvcSource = &git.GitImportTfvcSource{
+			Path:          converter.String(v.(string)),
+			ImportHistory: converter.Bool(false),
+		}
+		if days, ok := d.GetOk("tfvc_history_days"); ok {
+			parameters.TfvcSource.ImportHistory = converter.Bool(true)
+			parameters.TfvcSource.ImportHistoryDurationInDays = converter.Int(days.(int))
+		}
+	} else {
+		sourceURL := d.Get("source_url").(string)
+		parameters.GitSource = &git.GitImportGitSource{
+			Url: &sourceURL,
+		}
+		if v, ok := d.GetOk("service_connection_id"); ok {
+			parameters.ServiceEndpointId = converter.UUID(v.(string))
+			parameters.DeleteServiceEndpointAfterImportIsDone = converter.Bool(false)
+		} else if password, ok := d.GetOk("password"); ok {
+			seID, err := createImportServiceEndpoint(clients, projectID, sourceURL, d.Get("username").(string), password.(string))
+			if err != nil {
+				return diag.Errorf(" Creating service connection for the import of %s. Error: %+v", sourceURL, err)
+			}
+			parameters.ServiceEndpointId = seID
+			parameters.DeleteServiceEndpointAfterImportIsDone = converter.Bool(true)
+		}
+	}
+
+	importRequest, err := clients.GitReposClient.CreateImportRequest(clients.Ctx, git.CreateImportRequestArgs{
+		ImportRequest: &git.GitImportRequest{
+			Parameters: parameters,
+		},
+		Project:      &projectID,
+		RepositoryId: &repoID,
+	})
+	if err != nil {
+		return diag.Errorf(" Creating import request for repository %s. Error: %+v", repoID, err)
+	}
+	d.SetId(strconv.Itoa(*importRequest.ImportRequestId))
+
+	if err := waitForGitRepositoryImport(clients, projectID, repoID, *importRequest.ImportRequestId, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf(" Importing repository %s. Error: %+v", repoID, err)
+	}
+	return resourceGitRepositoryImportRead(clients.Ctx, d, m)
+}
+
+func resourceGitRepositoryImportRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+
+	importRequestID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.Errorf(" Parsing import request ID %s. Error: %+v", d.Id(), err)
+	}
+
+	importRequest, err := clients.GitReposClient.GetImportRequest(clients.Ctx, git.GetImportRequestArgs{
+		Project:         converter.String(d.Get("project_id").(string)),
+		RepositoryId:    converter.String(d.Get("repository_id").(string)),
+		ImportRequestId: &importRequestID,
+	})
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf(" Getting import request %d. Error: %+v", importRequestID, err)
+	}
+
+	if importRequest.Status != nil {
+		d.Set("status", string(*importRequest.Status))
+	}
+	return nil
+}
+
+func resourceGitRepositoryImportDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// An import cannot be undone, the imported content stays in the repository
+	d.SetId("")
+	return nil
+}
+
+// waitForGitRepositoryImport waits until the import request has completed and reports the failed step otherwise
+func waitForGitRepositoryImport(clients *client.AggregatedClient, projectID string, repoID string, importRequestID int, timeout time.Duration) error {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{
+			string(git.GitAsyncOperationStatusValues.Queued),
+			string(git.GitAsyncOperationStatusValues.InProgress),
+		},
+		Target: []string{string(git.GitAsyncOperationStatusValues.Completed)},
+		Refresh: func() (interface{}, string, error) {
+			importRequest, err := clients.GitReposClient.GetImportRequest(clients.Ctx, git.GetImportRequestArgs{
+				Project:         &projectID,
+				RepositoryId:    &repoID,
+				ImportRequestId: &importRequestID,
+			})
+			if err != nil {
+				return nil, "", err
+			}
+			if importRequest.Status == nil {
+				return nil, "", fmt.Errorf("import request %d has no status", importRequestID)
+			}
+			switch *importRequest.Status {
+			case git.GitAsyncOperationStatusValues.Failed, git.GitAsyncOperationStatusValues.Abandoned:
+				return nil, "", fmt.Errorf("import request %d %s%s", importRequestID, *importRequest.Status, flattenGitImportStatusDetail(importRequest.DetailedStatus))
+			}
+			return importRequest, string(*importRequest.Status), nil
+		},
+		Timeout:    timeout,
+		MinTimeout: 5 * time.Second,
+	}
+	_, err := stateConf.WaitForStateContext(clients.Ctx)
+	return err
+}
+
+// flattenGitImportStatusDetail describes the step an import failed at
+func flattenGitImportStatusDetail(detail *git.GitImportStatusDetail) string {
+	if detail == nil {
+		return ""
+	}
+
+	var sb strings.Builder
+	if detail.CurrentStep != nil && detail.AllSteps != nil {
+		// The current step is 1-based
+		step := *detail.CurrentStep
+		if step >= 1 && step <= len(*detail.AllSteps) {
+			fmt.Fprintf(&sb, " at step %d of %d (%s)", step, len(*detail.AllSteps), (*detail.AllSteps)[step-1])
+		}
+	}
+	if detail.ErrorMessage != nil && *detail.ErrorMessage != "" {
+		fmt.Fprintf(&sb, ": %s", *detail.ErrorMessage)
+	}
+	return sb.String()
+}
@@ -0,0 +1,100 @@
+package git
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var (
+	gitRepositoryImportProjectID = uuid.NewString()
+	gitRepositoryImportRepoID    = uuid.NewString()
+)
+
+func TestGitRepositoryImport_Create_TfvcSource(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{GitReposClient: reposClient, Ctx: context.Background()}
+
+	reposClient.EXPECT().GetRefs(clients.Ctx, gomock.Any()).Return(&git.GetRefsResponseValue{}, nil).Times(1)
+	reposClient.EXPECT().CreateImportRequest(clients.Ctx, gomock.Any()).DoAndReturn(
+		func(ctx context.Context, args git.CreateImportRequestArgs) (*git.GitImportRequest, error) {
+			require.Equal(t, gitRepositoryImportRepoID, *args.RepositoryId)
+			parameters := args.ImportRequest.Parameters
+			require.Nil(t, parameters.GitSource)
+			require.Nil(t, parameters.ServiceEndpointId)
+			require.Equal(t, "$/Project/Main", *parameters.TfvcSource.Path)
+			require.True(t, *parameters.TfvcSource.ImportHistory)
+			require.Equal(t, 30, *parameters.TfvcSource.ImportHistoryDurationInDays)
+			return &git.GitImportRequest{ImportRequestId: converter.Int(3)}, nil
+		}).Times(1)
+	reposClient.EXPECT().GetImportRequest(clients.Ctx, gomock.Any()).Return(&git.GitImportRequest{
+		ImportRequestId: converter.Int(3),
+		Status:          &git.GitAsyncOperationStatusValues.Completed,
+	}, nil).Times(2)
+
+	d := schema.TestResourceDataRaw(t, ResourceGitRepositoryImport().Schema, map[string]interface{}{
+		"project_id":        gitRepositoryImportProjectID,
+		"repository_id":     gitRepositoryImportRepoID,
+		"tfvc_path":         "$/Project/Main",
+		"tfvc_history_days": 30,
+	})
+	diags := resourceGitRepositoryImportCreate(context.Background(), d, clients)
+	require.Empty(t, diags)
+	assert.Equal(t, "3", d.Id())
+	assert.Equal(t, "completed", d.Get("status"))
+}
+
+func TestGitRepositoryImport_Create_RefusesNonEmptyRepository(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{GitReposClient: reposClient, Ctx: context.Background()}
+
+	reposClient.EXPECT().GetRefs(clients.Ctx, gomock.Any()).Return(&git.GetRefsResponseValue{
+		Value: []git.GitRef{{Name: converter.String("refs/heads/main")}},
+	}, nil).Times(1)
+
+	d := schema.TestResourceDataRaw(t, ResourceGitRepositoryImport().Schema, map[string]interface{}{
+		"project_id":    gitRepositoryImportProjectID,
+		"repository_id": gitRepositoryImportRepoID,
+		"source_url":    "https://github.com/microsoft/terraform-provider-azuredevops.git",
+	})
+	diags := resourceGitRepositoryImportCreate(context.Background(), d, clients)
+	require.NotEmpty(t, diags)
+	require.Contains(t, diags[0].Summary, "is not empty")
+}
+
+func TestGitRepositoryImport_Wait_ReportsFailedStep(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{GitReposClient: reposClient, Ctx: context.Background()}
+
+	reposClient.EXPECT().GetImportRequest(clients.Ctx, gomock.Any()).Return(&git.GitImportRequest{
+		ImportRequestId: converter.Int(3),
+		Status:          &git.GitAsyncOperationStatusValues.Failed,
+		DetailedStatus: &git.GitImportStatusDetail{
+			AllSteps:     &[]string{"Processing request", "Cloning source", "Pushing"},
+			CurrentStep:  converter.Int(2),
+			ErrorMessage: converter.String("Authentication failed"),
+		},
+	}, nil).Times(1)
+
+	err := waitForGitRepositoryImport(clients, gitRepositoryImportProjectID, gitRepositoryImportRepoID, 3, time.Minute)
+	require.ErrorContains(t, err, "import request 3 failed at step 2 of 3 (Cloning source): Authentication failed")
+}
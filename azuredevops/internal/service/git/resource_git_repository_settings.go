@@ -0,0 +1,380 @@
+package git
+
+import (
+	"context"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/policy"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/sdk/policyextras"
+)
+
+// gitRepositorySettingsPolicyType is the ID of the GitRepositorySettingsPolicyName policy type which stores the
+// settings of a repository, or the settings of all repositories of a project if it has no repository scope
+var gitRepositorySettingsPolicyType = uuid.MustParse("0517f88d-4ec5-4343-9d26-9930ebd53069")
+
+const (
+	settingsAllowForks                    = "AllowForks"
+	settingsAutoCreateBranchOnPullRequest = "AutoCreateBranchOnPullRequest"
+	settingsGvfsOnly                      = "GvfsOnly"
+	settingsStrictVoteMode                = "StrictVoteMode"
+	settingsCreatePRsFor                  = "CreatePRsFor"
+	settingsDefaultBranchName             = "DefaultBranchName"
+)
+
+// managedGitRepositorySettings the settings managed by the resource, all other settings of a configuration are kept
+var managedGitRepositorySettings = []string{
+	settingsAllowForks,
+	settingsAutoCreateBranchOnPullRequest,
+	settingsGvfsOnly,
+	settingsStrictVoteMode,
+	settingsCreatePRsFor,
+	settingsDefaultBranchName,
+}
+
+// ResourceGitRepositorySettings schema and implementation for the settings of a git repository, or of all git repositories of a project
+func ResourceGitRepositorySettings() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceGitRepositorySettingsCreate,
+		ReadContext:   resourceGitRepositorySettingsRead,
+		UpdateContext: resourceGitRepositorySettingsUpdate,
+		DeleteContext: resourceGitRepositorySettingsDelete,
+		Importer:      tfhelper.ImportProjectQualifiedResourceInteger(),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			// The settings apply to all repositories of the project if no repository is specified
+			"repository_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"allow_forks": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"auto_create_branch_on_pull_request": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"gvfs_exclusive": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"strict_vote_mode": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"create_pull_requests_for": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "all",
+				ValidateFunc: validation.StringInSlice([]string{"all", "default", "none"}, false),
+			},
+
+			// The default branch name of new repositories can only be configured for the whole project
+			"default_branch_name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  validation.StringIsNotWhiteSpace,
+				ConflictsWith: []string{"repository_id"},
+			},
+		},
+	}
+}
+
+func resourceGitRepositorySettingsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+	projectID := d.Get("project_id").(string)
+	repoID := d.Get("repository_id").(string)
+
+	// There is only one settings configuration per scope which the service creates once a setting has been changed
+	existing, err := getGitRepositorySettingsConfiguration(clients, projectID, repoID)
+	if err != nil {
+		return diag.Errorf(" Looking up repository settings of project %s. Error: %+v", projectID, err)
+	}
+
+	if existing != nil {
+		policyConfig := expandGitRepositorySettings(d, existing.Settings)
+		policyConfig.Id = existing.Id
+		if _, err := clients.PolicyClient.UpdatePolicyConfiguration(clients.Ctx, policy.UpdatePolicyConfigurationArgs{
+			ConfigurationId: existing.Id,
+			Configuration:   policyConfig,
+			Project:         &projectID,
+		}); err != nil {
+			return diag.Errorf(" Updating repository settings. Error: %+v", err)
+		}
+		d.SetId(strconv.Itoa(*existing.Id))
+		return resourceGitRepositorySettingsRead(clients.Ctx, d, m)
+	}
+
+	created, err := clients.PolicyClient.CreatePolicyConfiguration(clients.Ctx, policy.CreatePolicyConfigurationArgs{
+		Configuration: expandGitRepositorySettings(d, nil),
+		Project:       &projectID,
+	})
+	if err != nil {
+		return diag.Errorf(" Creating repository settings. Error: %+v", err)
+	}
+
+	d.SetId(strconv.Itoa(*created.Id))
+	return resourceGitRepositorySettingsRead(clients.Ctx, d, m)
+}
+
+func resourceGitRepositorySettingsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+	projectID := d.Get("project_id").(string)
+
+	configID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.Errorf(" Parsing repository settings ID %s. Error: %+v", d.Id(), err)
+	}
+
+	policyConfig, err := clients.PolicyClient.GetPolicyConfiguration(clients.Ctx, policy.GetPolicyConfigurationArgs{
+		Project:         &projectID,
+		ConfigurationId: &configID,
+	})
+	if utils.ResponseWasNotFound(err) || (policyConfig != nil && converter.ToBool(policyConfig.IsDeleted, false)) {
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.Errorf(" Reading repository settings %d of project %s. Error: %+v", configID, projectID, err)
+	}
+
+	settings, ok := policyConfig.Settings.(map[string]interface{})
+	if !ok {
+		return diag.Errorf(" Unexpected settings of repository settings %d: %+v", configID, policyConfig.Settings)
+	}
+
+	d.Set("project_id", projectID)
+	d.Set("repository_id", flattenGitRepositorySettingsScope(settings))
+	d.Set("allow_forks", settingsBool(settings, settingsAllowForks, true))
+	d.Set("auto_create_branch_on_pull_request", settingsBool(settings, settingsAutoCreateBranchOnPullRequest, false))
+	d.Set("gvfs_exclusive", settingsBool(settings, settingsGvfsOnly, false))
+	d.Set("strict_vote_mode", settingsBool(settings, settingsStrictVoteMode, false))
+	if v, ok := settings[settingsCreatePRsFor].(string); ok && v != "" {
+		d.Set("create_pull_requests_for", v)
+	} else {
+		d.Set("create_pull_requests_for", "all")
+	}
+	if v, ok := settings[settingsDefaultBranchName].(string); ok {
+		d.Set("default_branch_name", v)
+	} else {
+		d.Set("default_branch_name", "")
+	}
+	return nil
+}
+
+func resourceGitRepositorySettingsUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+	projectID := d.Get("project_id").(string)
+
+	configID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.Errorf(" Parsing repository settings ID %s. Error: %+v", d.Id(), err)
+	}
+
+	// The configuration may contain settings that are not managed by the resource which must be kept
+	current, err := clients.PolicyClient.GetPolicyConfiguration(clients.Ctx, policy.GetPolicyConfigurationArgs{
+		Project:         &projectID,
+		ConfigurationId: &configID,
+	})
+	if err != nil {
+		return diag.Errorf(" Reading repository settings %d of project %s. Error: %+v", configID, projectID, err)
+	}
+
+	policyConfig := expandGitRepositorySettings(d, current.Settings)
+	policyConfig.Id = &configID
+	if _, err := clients.PolicyClient.UpdatePolicyConfiguration(clients.Ctx, policy.UpdatePolicyConfigurationArgs{
+		ConfigurationId: &configID,
+		Configuration:   policyConfig,
+		Project:         &projectID,
+	}); err != nil {
+		return diag.Errorf(" Updating repository settings %d. Error: %+v", configID, err)
+	}
+	return resourceGitRepositorySettingsRead(clients.Ctx, d, m)
+}
+
+func resourceGitRepositorySettingsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+	projectID := d.Get("project_id").(string)
+
+	configID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.Errorf(" Parsing repository settings ID %s. Error: %+v", d.Id(), err)
+	}
+
+	current, err := clients.PolicyClient.GetPolicyConfiguration(clients.Ctx, policy.GetPolicyConfigurationArgs{
+		Project:         &projectID,
+		ConfigurationId: &configID,
+	})
+	if utils.ResponseWasNotFound(err) || (current != nil && converter.ToBool(current.IsDeleted, false)) {
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.Errorf(" Reading repository settings %d of project %s. Error: %+v", configID, projectID, err)
+	}
+
+	// Only the managed settings are reset. The configuration may have been adopted on create, so it is only deleted
+	// if it contains no other settings.
+	settings := copyGitRepositorySettings(current.Settings)
+	for _, key := range managedGitRepositorySettings {
+		delete(settings, key)
+	}
+	if hasUnmanagedGitRepositorySettings(settings) {
+		current.Settings = settings
+		if _, err := clients.PolicyClient.UpdatePolicyConfiguration(clients.Ctx, policy.UpdatePolicyConfigurationArgs{
+			ConfigurationId: &configID,
+			Configuration:   current,
+			Project:         &projectID,
+		}); err != nil {
+			return diag.Errorf(" Resetting repository settings %d. Error: %+v", configID, err)
+		}
+		d.SetId("")
+		return nil
+	}
+
+	// Without a configuration the repositories fall back to the service defaults
+	if err := clients.PolicyClient.DeletePolicyConfiguration(clients.Ctx, policy.DeletePolicyConfigurationArgs{
+		ConfigurationId: &configID,
+		Project:         &projectID,
+	}); err != nil && !utils.ResponseWasNotFound(err) {
+		return diag.Errorf(" Deleting repository settings %d. Error: %+v", configID, err)
+	}
+	d.SetId("")
+	return nil
+}
+
+// getGitRepositorySettingsConfiguration returns the settings configuration of a repository, or of the project if
+// no repository ID is given. It returns nil if the settings have never been changed.
+func getGitRepositorySettingsConfiguration(clients *client.AggregatedClient, projectID string, repoID string) (*policy.PolicyConfiguration, error) {
+	configs, err := policyextras.ListPolicyConfigurations(clients.Ctx, clients.PolicyClientExtras, policyextras.GetPolicyConfigurationsArgs{
+		Project:    &projectID,
+		PolicyType: &gitRepositorySettingsPolicyType,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, config := range configs {
+		if converter.ToBool(config.IsDeleted, false) {
+			continue
+		}
+		settings, ok := config.Settings.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if flattenGitRepositorySettingsScope(settings) == repoID {
+			return &config, nil
+		}
+	}
+	return nil, nil
+}
+
+// expandGitRepositorySettings merges the managed settings into the current settings of the configuration
+func expandGitRepositorySettings(d *schema.ResourceData, current interface{}) *policy.PolicyConfiguration {
+	var scopeRepoID interface{}
+	if v, ok := d.GetOk("repository_id"); ok {
+		scopeRepoID = v.(string)
+	}
+
+	settings := copyGitRepositorySettings(current)
+	settings["scope"] = []map[string]interface{}{
+		{
+			"repositoryId": scopeRepoID,
+		},
+	}
+	settings[settingsAllowForks] = d.Get("allow_forks").(bool)
+	settings[settingsAutoCreateBranchOnPullRequest] = d.Get("auto_create_branch_on_pull_request").(bool)
+	settings[settingsGvfsOnly] = d.Get("gvfs_exclusive").(bool)
+	settings[settingsStrictVoteMode] = d.Get("strict_vote_mode").(bool)
+	settings[settingsCreatePRsFor] = d.Get("create_pull_requests_for").(string)
+	if v, ok := d.GetOk("default_branch_name"); ok {
+		settings[settingsDefaultBranchName] = v.(string)
+	} else {
+		delete(settings, settingsDefaultBranchName)
+	}
+
+	return &policy.PolicyConfiguration{
+		IsEnabled:  converter.Bool(true),
+		IsBlocking: converter.Bool(false),
+		Type: &policy.PolicyTypeRef{
+			Id: &gitRepositorySettingsPolicyType,
+		},
+		Settings: settings,
+	}
+}
+
+// copyGitRepositorySettings returns a copy of the settings of a configuration, or an empty map if there are none
+func copyGitRepositorySettings(settings interface{}) map[string]interface{} {
+	result := map[string]interface{}{}
+	if current, ok := settings.(map[string]interface{}); ok {
+		for key, value := range current {
+			result[key] = value
+		}
+	}
+	return result
+}
+
+// hasUnmanagedGitRepositorySettings returns true if the settings contain anything besides the managed settings and the scope
+func hasUnmanagedGitRepositorySettings(settings map[string]interface{}) bool {
+	for key := range settings {
+		if key != "scope" && !slices.Contains(managedGitRepositorySettings, key) {
+			return true
+		}
+	}
+	return false
+}
+
+// flattenGitRepositorySettingsScope returns the repository ID of the settings, or an empty string for project wide settings
+func flattenGitRepositorySettingsScope(settings map[string]interface{}) string {
+	scopes, ok := settings["scope"].([]interface{})
+	if !ok || len(scopes) == 0 {
+		return ""
+	}
+	scope, ok := scopes[0].(map[string]interface{})
+	if !ok {
+		return ""
+	}
+	if repoID, ok := scope["repositoryId"].(string); ok {
+		return repoID
+	}
+	return ""
+}
+
+func settingsBool(settings map[string]interface{}, key string, defaultValue bool) bool {
+	if v, ok := settings[key].(bool); ok {
+		return v
+	}
+	return defaultValue
+}
@@ -0,0 +1,241 @@
+package git
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/policy"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var (
+	gitRepositorySettingsProjectID = uuid.NewString()
+	gitRepositorySettingsRepoID    = uuid.NewString()
+)
+
+func gitRepositorySettingsConfig(id int, repoID interface{}, settings map[string]interface{}) policy.PolicyConfiguration {
+	settings["scope"] = []interface{}{
+		map[string]interface{}{"repositoryId": repoID},
+	}
+	return policy.PolicyConfiguration{
+		Id:        converter.Int(id),
+		IsDeleted: converter.Bool(false),
+		Type:      &policy.PolicyTypeRef{Id: &gitRepositorySettingsPolicyType},
+		Settings:  settings,
+	}
+}
+
+func TestGitRepositorySettings_Create_UpdatesExistingConfiguration(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	policyClient := azdosdkmocks.NewMockPolicyClient(ctrl)
+	policyExtrasClient := azdosdkmocks.NewMockPolicyextrasClient(ctrl)
+	clients := &client.AggregatedClient{PolicyClient: policyClient, PolicyClientExtras: policyExtrasClient, Ctx: context.Background()}
+
+	projectSettings := gitRepositorySettingsConfig(1, nil, map[string]interface{}{settingsDefaultBranchName: "main"})
+	repoSettings := gitRepositorySettingsConfig(2, gitRepositorySettingsRepoID, map[string]interface{}{settingsAllowForks: true})
+	gomock.InOrder(
+		policyExtrasClient.EXPECT().GetPolicyConfigurations(clients.Ctx, gomock.Any()).Return(&policy.GetPolicyConfigurationsResponseValue{
+			Value: []policy.PolicyConfiguration{projectSettings, repoSettings},
+		}, nil).Times(1),
+		policyClient.EXPECT().UpdatePolicyConfiguration(clients.Ctx, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, args policy.UpdatePolicyConfigurationArgs) (*policy.PolicyConfiguration, error) {
+				require.Equal(t, 2, *args.ConfigurationId)
+				settings := args.Configuration.Settings.(map[string]interface{})
+				require.Equal(t, false, settings[settingsAllowForks])
+				require.Equal(t, true, settings[settingsStrictVoteMode])
+				require.NotContains(t, settings, settingsDefaultBranchName)
+				return args.Configuration, nil
+			}).Times(1),
+		policyClient.EXPECT().GetPolicyConfiguration(clients.Ctx, gomock.Any()).Return(&repoSettings, nil).Times(1),
+	)
+
+	d := schema.TestResourceDataRaw(t, ResourceGitRepositorySettings().Schema, map[string]interface{}{
+		"project_id":       gitRepositorySettingsProjectID,
+		"repository_id":    gitRepositorySettingsRepoID,
+		"allow_forks":      false,
+		"strict_vote_mode": true,
+	})
+	diags := resourceGitRepositorySettingsCreate(context.Background(), d, clients)
+	require.Empty(t, diags)
+	assert.Equal(t, "2", d.Id())
+	assert.Equal(t, gitRepositorySettingsRepoID, d.Get("repository_id"))
+}
+
+func TestGitRepositorySettings_Create_ProjectDefaultBranchName(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	policyClient := azdosdkmocks.NewMockPolicyClient(ctrl)
+	policyExtrasClient := azdosdkmocks.NewMockPolicyextrasClient(ctrl)
+	clients := &client.AggregatedClient{PolicyClient: policyClient, PolicyClientExtras: policyExtrasClient, Ctx: context.Background()}
+
+	created := gitRepositorySettingsConfig(3, nil, map[string]interface{}{
+		settingsAllowForks:        true,
+		settingsDefaultBranchName: "develop",
+	})
+	gomock.InOrder(
+		policyExtrasClient.EXPECT().GetPolicyConfigurations(clients.Ctx, gomock.Any()).Return(&policy.GetPolicyConfigurationsResponseValue{}, nil).Times(1),
+		policyClient.EXPECT().CreatePolicyConfiguration(clients.Ctx, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, args policy.CreatePolicyConfigurationArgs) (*policy.PolicyConfiguration, error) {
+				require.Equal(t, gitRepositorySettingsProjectID, *args.Project)
+				require.Equal(t, gitRepositorySettingsPolicyType, *args.Configuration.Type.Id)
+				settings := args.Configuration.Settings.(map[string]interface{})
+				require.Equal(t, "develop", settings[settingsDefaultBranchName])
+				require.Nil(t, settings["scope"].([]map[string]interface{})[0]["repositoryId"])
+				return &created, nil
+			}).Times(1),
+		policyClient.EXPECT().GetPolicyConfiguration(clients.Ctx, gomock.Any()).Return(&created, nil).Times(1),
+	)
+
+	d := schema.TestResourceDataRaw(t, ResourceGitRepositorySettings().Schema, map[string]interface{}{
+		"project_id":          gitRepositorySettingsProjectID,
+		"default_branch_name": "develop",
+	})
+	diags := resourceGitRepositorySettingsCreate(context.Background(), d, clients)
+	require.Empty(t, diags)
+	assert.Equal(t, "3", d.Id())
+	assert.Equal(t, "", d.Get("repository_id"))
+	assert.Equal(t, "develop", d.Get("default_branch_name"))
+}
+
+func TestGitRepositorySettings_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	policyExtrasClient := azdosdkmocks.NewMockPolicyextrasClient(ctrl)
+	clients := &client.AggregatedClient{PolicyClientExtras: policyExtrasClient, Ctx: context.Background()}
+
+	policyExtrasClient.EXPECT().GetPolicyConfigurations(clients.Ctx, gomock.Any()).Return(nil, errors.New("GetPolicyConfigurations() Failed")).Times(1)
+
+	d := schema.TestResourceDataRaw(t, ResourceGitRepositorySettings().Schema, map[string]interface{}{
+		"project_id": gitRepositorySettingsProjectID,
+	})
+	diags := resourceGitRepositorySettingsCreate(context.Background(), d, clients)
+	require.NotEmpty(t, diags)
+	require.Contains(t, diags[0].Summary, "GetPolicyConfigurations() Failed")
+}
+
+func TestGitRepositorySettings_Read_Deleted_ClearsID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	policyClient := azdosdkmocks.NewMockPolicyClient(ctrl)
+	clients := &client.AggregatedClient{PolicyClient: policyClient, Ctx: context.Background()}
+
+	deleted := gitRepositorySettingsConfig(2, gitRepositorySettingsRepoID, map[string]interface{}{})
+	deleted.IsDeleted = converter.Bool(true)
+	policyClient.EXPECT().GetPolicyConfiguration(clients.Ctx, gomock.Any()).Return(&deleted, nil).Times(1)
+
+	d := schema.TestResourceDataRaw(t, ResourceGitRepositorySettings().Schema, map[string]interface{}{
+		"project_id": gitRepositorySettingsProjectID,
+	})
+	d.SetId("2")
+	require.Empty(t, resourceGitRepositorySettingsRead(context.Background(), d, clients))
+	assert.Empty(t, d.Id())
+}
+
+func TestGitRepositorySettings_Update_KeepsUnmanagedSettings(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	policyClient := azdosdkmocks.NewMockPolicyClient(ctrl)
+	clients := &client.AggregatedClient{PolicyClient: policyClient, Ctx: context.Background()}
+
+	current := gitRepositorySettingsConfig(2, gitRepositorySettingsRepoID, map[string]interface{}{
+		settingsAllowForks:        true,
+		settingsDefaultBranchName: "main",
+		"CustomSetting":           "value",
+	})
+	gomock.InOrder(
+		policyClient.EXPECT().GetPolicyConfiguration(clients.Ctx, gomock.Any()).Return(&current, nil).Times(1),
+		policyClient.EXPECT().UpdatePolicyConfiguration(clients.Ctx, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, args policy.UpdatePolicyConfigurationArgs) (*policy.PolicyConfiguration, error) {
+				settings := args.Configuration.Settings.(map[string]interface{})
+				require.Equal(t, "value", settings["CustomSetting"])
+				require.Equal(t, false, settings[settingsAllowForks])
+				require.NotContains(t, settings, settingsDefaultBranchName)
+				return args.Configuration, nil
+			}).Times(1),
+		policyClient.EXPECT().GetPolicyConfiguration(clients.Ctx, gomock.Any()).Return(&current, nil).Times(1),
+	)
+
+	d := schema.TestResourceDataRaw(t, ResourceGitRepositorySettings().Schema, map[string]interface{}{
+		"project_id":    gitRepositorySettingsProjectID,
+		"repository_id": gitRepositorySettingsRepoID,
+		"allow_forks":   false,
+	})
+	d.SetId("2")
+	require.Empty(t, resourceGitRepositorySettingsUpdate(context.Background(), d, clients))
+	// The settings of the current configuration are not modified
+	require.Equal(t, "main", current.Settings.(map[string]interface{})[settingsDefaultBranchName])
+}
+
+func TestGitRepositorySettings_Delete_ResetsManagedSettingsOnly(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	policyClient := azdosdkmocks.NewMockPolicyClient(ctrl)
+	clients := &client.AggregatedClient{PolicyClient: policyClient, Ctx: context.Background()}
+
+	current := gitRepositorySettingsConfig(2, gitRepositorySettingsRepoID, map[string]interface{}{
+		settingsAllowForks: false,
+		"CustomSetting":    "value",
+	})
+	gomock.InOrder(
+		policyClient.EXPECT().GetPolicyConfiguration(clients.Ctx, gomock.Any()).Return(&current, nil).Times(1),
+		policyClient.EXPECT().UpdatePolicyConfiguration(clients.Ctx, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, args policy.UpdatePolicyConfigurationArgs) (*policy.PolicyConfiguration, error) {
+				require.Equal(t, 2, *args.ConfigurationId)
+				settings := args.Configuration.Settings.(map[string]interface{})
+				require.Equal(t, "value", settings["CustomSetting"])
+				require.NotContains(t, settings, settingsAllowForks)
+				require.Contains(t, settings, "scope")
+				return args.Configuration, nil
+			}).Times(1),
+	)
+	policyClient.EXPECT().DeletePolicyConfiguration(gomock.Any(), gomock.Any()).Times(0)
+
+	d := schema.TestResourceDataRaw(t, ResourceGitRepositorySettings().Schema, map[string]interface{}{
+		"project_id": gitRepositorySettingsProjectID,
+	})
+	d.SetId("2")
+	require.Empty(t, resourceGitRepositorySettingsDelete(context.Background(), d, clients))
+	assert.Empty(t, d.Id())
+}
+
+func TestGitRepositorySettings_Delete_DeletesManagedConfiguration(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	policyClient := azdosdkmocks.NewMockPolicyClient(ctrl)
+	clients := &client.AggregatedClient{PolicyClient: policyClient, Ctx: context.Background()}
+
+	current := gitRepositorySettingsConfig(2, gitRepositorySettingsRepoID, map[string]interface{}{
+		settingsAllowForks:     false,
+		settingsStrictVoteMode: true,
+	})
+	gomock.InOrder(
+		policyClient.EXPECT().GetPolicyConfiguration(clients.Ctx, gomock.Any()).Return(&current, nil).Times(1),
+		policyClient.EXPECT().DeletePolicyConfiguration(clients.Ctx, policy.DeletePolicyConfigurationArgs{
+			ConfigurationId: converter.Int(2),
+			Project:         converter.String(gitRepositorySettingsProjectID),
+		}).Return(nil).Times(1),
+	)
+
+	d := schema.TestResourceDataRaw(t, ResourceGitRepositorySettings().Schema, map[string]interface{}{
+		"project_id": gitRepositorySettingsProjectID,
+	})
+	d.SetId("2")
+	require.Empty(t, resourceGitRepositorySettingsDelete(context.Background(), d, clients))
+	assert.Empty(t, d.Id())
+}
@@ -0,0 +1,275 @@
+package git
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
+)
+
+// ResourceGitRepositoryTag schema to manage the lifecycle of a lightweight or annotated git repository tag
+func ResourceGitRepositoryTag() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceGitRepositoryTagCreate,
+		ReadContext:   resourceGitRepositoryTagRead,
+		DeleteContext: resourceGitRepositoryTagDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"repository_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"ref_branch": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				ExactlyOneOf: []string{"ref_branch", "ref_commit_id"},
+			},
+			"ref_commit_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				ExactlyOneOf: []string{"ref_branch", "ref_commit_id"},
+			},
+			// An annotated tag is created if a message is specified, otherwise a lightweight tag
+			"message": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			// The tagger defaults to the authenticated user
+			"tagger_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				RequiredWith: []string{"message", "tagger_email"},
+			},
+			"tagger_email": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				RequiredWith: []string{"message", "tagger_name"},
+			},
+			"commit_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"object_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceGitRepositoryTagCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+	repoId := d.Get("repository_id").(string)
+
+	tagName := d.Get("name").(string)
+	if strings.HasPrefix(tagName, REF_TAG_PREFIX) {
+		return diag.Errorf("Tag name must be in short format without refs/tags/ prefix, got: %q", tagName)
+	}
+
+	var commitId string
+	if v, ok := d.GetOk("ref_commit_id"); ok {
+		commitId = v.(string)
+	} else {
+		branch := d.Get("ref_branch").(string)
+		ref, err := checkRepositoryBranchExists(clients, repoId, branch)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("Getting branch %q: %w", branch, err))
+		}
+		if ref == nil || ref.ObjectId == nil {
+			return diag.FromErr(fmt.Errorf("Branch %q not found.", branch))
+		}
+		commitId = *ref.ObjectId
+	}
+
+	if message, ok := d.GetOk("message"); ok {
+		// Annotated tags are created through their own endpoint which requires the project of the repository
+		repo, err := clients.GitReposClient.GetRepository(clients.Ctx, git.GetRepositoryArgs{
+			RepositoryId: converter.String(repoId),
+		})
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("Getting repository %q: %w", repoId, err))
+		}
+
+		tag := &git.GitAnnotatedTag{
+			Name:    converter.String(tagName),
+			Message: converter.String(message.(string)),
+			TaggedObject: &git.GitObject{
+				ObjectId:   converter.String(commitId),
+				ObjectType: &git.GitObjectTypeValues.Commit,
+			},
+		}
+		if name, ok := d.GetOk("tagger_name"); ok {
+			tag.TaggedBy = &git.GitUserDate{
+				Name:  converter.String(name.(string)),
+				Email: converter.String(d.Get("tagger_email").(string)),
+				Date:  &azuredevops.Time{Time: time.Now()},
+			}
+		}
+
+		if _, err := clients.GitReposClient.CreateAnnotatedTag(clients.Ctx, git.CreateAnnotatedTagArgs{
+			TagObject:    tag,
+			Project:      converter.String(repo.Project.Id.String()),
+			RepositoryId: converter.String(repoId),
+		}); err != nil {
+			return diag.FromErr(fmt.Errorf("Creating annotated tag %q: %w", tagName, err))
+		}
+	} else {
+		if err := updateRefs(clients, git.UpdateRefsArgs{
+			RefUpdates: &[]git.GitRefUpdate{{
+				Name:        converter.String(REF_TAG_PREFIX + tagName),
+				NewObjectId: converter.String(commitId),
+				OldObjectId: converter.String("0000000000000000000000000000000000000000"),
+			}},
+			RepositoryId: converter.String(repoId),
+		}); err != nil {
+			return diag.FromErr(fmt.Errorf("Creating tag %q: %+v", tagName, err))
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", repoId, tagName))
+	return resourceGitRepositoryTagRead(ctx, d, m)
+}
+
+func resourceGitRepositoryTagRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+
+	repoId, tagName, err := tfhelper.ParseGitRepoBranchID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ref, err := getRepositoryTagRef(clients, repoId, tagName)
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("Reading tag %q: %w", tagName, err))
+	}
+	if ref == nil {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", tagName)
+	d.Set("repository_id", repoId)
+	d.Set("object_id", converter.ToString(ref.ObjectId, ""))
+
+	// The peeled object ID is only returned for annotated tags, it is the ID of the tagged commit
+	if ref.PeeledObjectId == nil {
+		d.Set("commit_id", converter.ToString(ref.ObjectId, ""))
+		d.Set("message", "")
+		return nil
+	}
+	d.Set("commit_id", *ref.PeeledObjectId)
+
+	repo, err := clients.GitReposClient.GetRepository(clients.Ctx, git.GetRepositoryArgs{
+		RepositoryId: converter.String(repoId),
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("Getting repository %q: %w", repoId, err))
+	}
+	tag, err := clients.GitReposClient.GetAnnotatedTag(clients.Ctx, git.GetAnnotatedTagArgs{
+		Project:      converter.String(repo.Project.Id.String()),
+		RepositoryId: converter.String(repoId),
+		ObjectId:     ref.ObjectId,
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("Reading annotated tag %q: %w", tagName, err))
+	}
+	d.Set("message", strings.TrimSuffix(converter.ToString(tag.Message, ""), "\n"))
+	if tag.TaggedBy != nil {
+		d.Set("tagger_name", converter.ToString(tag.TaggedBy.Name, ""))
+		d.Set("tagger_email", converter.ToString(tag.TaggedBy.Email, ""))
+	}
+	return nil
+}
+
+func resourceGitRepositoryTagDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+
+	repoId, tagName, err := tfhelper.ParseGitRepoBranchID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ref, err := getRepositoryTagRef(clients, repoId, tagName)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("Getting tag %q: %w", tagName, err))
+	}
+	if ref == nil {
+		return nil
+	}
+
+	if err := updateRefs(clients, git.UpdateRefsArgs{
+		RefUpdates: &[]git.GitRefUpdate{{
+			Name:        converter.String(REF_TAG_PREFIX + tagName),
+			OldObjectId: ref.ObjectId,
+			NewObjectId: converter.String("0000000000000000000000000000000000000000"),
+		}},
+		RepositoryId: converter.String(repoId),
+	}); err != nil {
+		return diag.FromErr(fmt.Errorf("Deleting tag %q: %w", tagName, err))
+	}
+
+	return nil
+}
+
+// getRepositoryTagRef returns the ref of a tag, or nil if the tag does not exist
+func getRepositoryTagRef(clients *client.AggregatedClient, repoId string, tagName string) (*git.GitRef, error) {
+	resp, err := clients.GitReposClient.GetRefs(clients.Ctx, git.GetRefsArgs{
+		RepositoryId: converter.String(repoId),
+		Filter:       converter.String("tags/" + shortTagName(tagName)),
+		PeelTags:     converter.Bool(true),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp == nil {
+		return nil, nil
+	}
+	for _, ref := range resp.Value {
+		// The filter is a prefix match
+		if ref.Name != nil && *ref.Name == withPrefix(REF_TAG_PREFIX, tagName) {
+			return &ref, nil
+		}
+	}
+	return nil, nil
+}
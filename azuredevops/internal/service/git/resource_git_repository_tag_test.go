@@ -0,0 +1,146 @@
+package git
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/core"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var gitRepositoryTagRepoID = uuid.NewString()
+
+func TestGitRepositoryTag_Create_LightweightFromBranch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{GitReposClient: reposClient, Ctx: context.Background()}
+
+	gomock.InOrder(
+		reposClient.EXPECT().GetRefs(clients.Ctx, gomock.Any()).Return(&git.GetRefsResponseValue{
+			Value: []git.GitRef{{Name: converter.String("refs/heads/main"), ObjectId: converter.String("1111")}},
+		}, nil).Times(1),
+		reposClient.EXPECT().UpdateRefs(clients.Ctx, git.UpdateRefsArgs{
+			RefUpdates: &[]git.GitRefUpdate{{
+				Name:        converter.String("refs/tags/v1.0.0"),
+				NewObjectId: converter.String("1111"),
+				OldObjectId: converter.String("0000000000000000000000000000000000000000"),
+			}},
+			RepositoryId: converter.String(gitRepositoryTagRepoID),
+		}).Return(&[]git.GitRefUpdateResult{{Success: converter.Bool(true)}}, nil).Times(1),
+		reposClient.EXPECT().GetRefs(clients.Ctx, gomock.Any()).Return(&git.GetRefsResponseValue{
+			Value: []git.GitRef{
+				{Name: converter.String("refs/tags/v1.0.0"), ObjectId: converter.String("1111")},
+				// The filter is a prefix match
+				{Name: converter.String("refs/tags/v1.0.0-rc1"), ObjectId: converter.String("0000")},
+			},
+		}, nil).Times(1),
+	)
+
+	d := schema.TestResourceDataRaw(t, ResourceGitRepositoryTag().Schema, map[string]interface{}{
+		"repository_id": gitRepositoryTagRepoID,
+		"name":          "v1.0.0",
+		"ref_branch":    "main",
+	})
+	diags := resourceGitRepositoryTagCreate(context.Background(), d, clients)
+	require.Empty(t, diags)
+	assert.Equal(t, gitRepositoryTagRepoID+":v1.0.0", d.Id())
+	assert.Equal(t, "1111", d.Get("commit_id"))
+	assert.Equal(t, "1111", d.Get("object_id"))
+}
+
+func TestGitRepositoryTag_Create_Annotated(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{GitReposClient: reposClient, Ctx: context.Background()}
+
+	projectID := uuid.New()
+	repository := &git.GitRepository{Project: &core.TeamProjectReference{Id: &projectID}}
+	gomock.InOrder(
+		reposClient.EXPECT().GetRepository(clients.Ctx, gomock.Any()).Return(repository, nil).Times(1),
+		reposClient.EXPECT().CreateAnnotatedTag(clients.Ctx, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, args git.CreateAnnotatedTagArgs) (*git.GitAnnotatedTag, error) {
+				require.Equal(t, projectID.String(), *args.Project)
+				require.Equal(t, "v1.0.0", *args.TagObject.Name)
+				require.Equal(t, "Release 1.0.0", *args.TagObject.Message)
+				require.Equal(t, "1111", *args.TagObject.TaggedObject.ObjectId)
+				require.Equal(t, "Release Bot", *args.TagObject.TaggedBy.Name)
+				return &git.GitAnnotatedTag{ObjectId: converter.String("2222")}, nil
+			}).Times(1),
+		reposClient.EXPECT().GetRefs(clients.Ctx, gomock.Any()).Return(&git.GetRefsResponseValue{
+			Value: []git.GitRef{{Name: converter.String("refs/tags/v1.0.0"), ObjectId: converter.String("2222"), PeeledObjectId: converter.String("1111")}},
+		}, nil).Times(1),
+		reposClient.EXPECT().GetRepository(clients.Ctx, gomock.Any()).Return(repository, nil).Times(1),
+		reposClient.EXPECT().GetAnnotatedTag(clients.Ctx, gomock.Any()).Return(&git.GitAnnotatedTag{
+			Message:  converter.String("Release 1.0.0\n"),
+			TaggedBy: &git.GitUserDate{Name: converter.String("Release Bot"), Email: converter.String("bot@example.com")},
+		}, nil).Times(1),
+	)
+
+	d := schema.TestResourceDataRaw(t, ResourceGitRepositoryTag().Schema, map[string]interface{}{
+		"repository_id": gitRepositoryTagRepoID,
+		"name":          "v1.0.0",
+		"ref_commit_id": "1111",
+		"message":       "Release 1.0.0",
+		"tagger_name":   "Release Bot",
+		"tagger_email":  "bot@example.com",
+	})
+	diags := resourceGitRepositoryTagCreate(context.Background(), d, clients)
+	require.Empty(t, diags)
+	assert.Equal(t, "1111", d.Get("commit_id"))
+	assert.Equal(t, "2222", d.Get("object_id"))
+	assert.Equal(t, "Release 1.0.0", d.Get("message"))
+}
+
+func TestGitRepositoryTag_Delete_UpdatesRefs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{GitReposClient: reposClient, Ctx: context.Background()}
+
+	reposClient.EXPECT().GetRefs(clients.Ctx, gomock.Any()).Return(&git.GetRefsResponseValue{
+		Value: []git.GitRef{{Name: converter.String("refs/tags/v1.0.0"), ObjectId: converter.String("2222")}},
+	}, nil).Times(1)
+	reposClient.EXPECT().UpdateRefs(clients.Ctx, git.UpdateRefsArgs{
+		RefUpdates: &[]git.GitRefUpdate{{
+			Name:        converter.String("refs/tags/v1.0.0"),
+			OldObjectId: converter.String("2222"),
+			NewObjectId: converter.String("0000000000000000000000000000000000000000"),
+		}},
+		RepositoryId: converter.String(gitRepositoryTagRepoID),
+	}).Return(nil, errors.New("UpdateRefs() Failed")).Times(1)
+
+	d := schema.TestResourceDataRaw(t, ResourceGitRepositoryTag().Schema, nil)
+	d.SetId(gitRepositoryTagRepoID + ":v1.0.0")
+	diags := resourceGitRepositoryTagDelete(context.Background(), d, clients)
+	require.NotEmpty(t, diags)
+	require.Contains(t, diags[0].Summary, "UpdateRefs() Failed")
+}
+
+func TestGitRepositoryTag_Read_NotFound_ClearsID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{GitReposClient: reposClient, Ctx: context.Background()}
+
+	reposClient.EXPECT().GetRefs(clients.Ctx, gomock.Any()).Return(&git.GetRefsResponseValue{}, nil).Times(1)
+
+	d := schema.TestResourceDataRaw(t, ResourceGitRepositoryTag().Schema, nil)
+	d.SetId(gitRepositoryTagRepoID + ":v1.0.0")
+	require.Empty(t, resourceGitRepositoryTagRead(context.Background(), d, clients))
+	assert.Empty(t, d.Id())
+}
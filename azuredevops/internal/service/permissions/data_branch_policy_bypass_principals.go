@@ -0,0 +1,224 @@
+package permissions
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/identity"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/security"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	securityhelper "github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/service/permissions/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+)
+
+// DataBranchPolicyBypassPrincipals schema and implementation for the principals allowed to bypass the policies of a branch
+func DataBranchPolicyBypassPrincipals() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceBranchPolicyBypassPrincipalsRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				ValidateFunc: validation.IsUUID,
+				Required:     true,
+			},
+			"repository_id": {
+				Type:         schema.TypeString,
+				ValidateFunc: validation.IsUUID,
+				Required:     true,
+			},
+			"branch_name": {
+				Type:         schema.TypeString,
+				ValidateFunc: validateBypassBranchName,
+				Required:     true,
+			},
+			"principals": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"descriptor": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"display_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"bypass_pull_request_policies": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"bypass_push_policies": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceBranchPolicyBypassPrincipalsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+
+	sn, err := securityhelper.NewSecurityNamespace(d, clients, securityhelper.SecurityNamespaceIDValues.GitRepositories, createGitToken)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	actions, err := sn.GetActionDefinitions()
+	if err != nil {
+		return diag.Errorf(" Reading Git repository security namespace. Error: %+v", err)
+	}
+	bits := map[securityhelper.ActionName]int{}
+	for _, name := range []securityhelper.ActionName{bypassPullRequestPolicyPermission, bypassPushPolicyPermission} {
+		action, ok := (*actions)[string(name)]
+		if !ok || action.Bit == nil {
+			return diag.Errorf(" Git repository security namespace does not define the permission %s", name)
+		}
+		bits[name] = *action.Bit
+	}
+
+	acls, err := getGitTokenHierarchyACLs(clients, sn.GetToken())
+	if err != nil {
+		return diag.Errorf(" Reading access control lists of token %s. Error: %+v", sn.GetToken(), err)
+	}
+	effective := evaluateBypassPermissions(acls, bits)
+
+	descriptors := make([]string, 0, len(effective))
+	for descriptor := range effective {
+		descriptors = append(descriptors, descriptor)
+	}
+	sort.Strings(descriptors)
+
+	identities, err := readIdentitiesByDescriptors(clients, descriptors)
+	if err != nil {
+		return diag.Errorf(" Reading identities with branch policy bypass permissions. Error: %+v", err)
+	}
+
+	principals := make([]interface{}, 0, len(descriptors))
+	for _, descriptor := range descriptors {
+		id, ok := identities[strings.ToLower(descriptor)]
+		if !ok || id.SubjectDescriptor == nil {
+			continue
+		}
+		displayName := converter.ToString(id.CustomDisplayName, "")
+		if displayName == "" {
+			displayName = converter.ToString(id.ProviderDisplayName, "")
+		}
+		principals = append(principals, map[string]interface{}{
+			"descriptor":                   *id.SubjectDescriptor,
+			"display_name":                 displayName,
+			"bypass_pull_request_policies": effective[descriptor][bypassPullRequestPolicyPermission],
+			"bypass_push_policies":         effective[descriptor][bypassPushPolicyPermission],
+		})
+	}
+
+	d.SetId("branchPolicyBypass#" + sn.GetToken())
+	if err := d.Set("principals", principals); err != nil {
+		return diag.Errorf(" Setting principals. Error: %+v", err)
+	}
+	return nil
+}
+
+// getGitTokenHierarchyACLs returns the access control lists of a token and all its parent tokens, starting with the
+// top most token
+func getGitTokenHierarchyACLs(clients *client.AggregatedClient, token string) ([]security.AccessControlList, error) {
+	namespaceID := uuid.UUID(securityhelper.SecurityNamespaceIDValues.GitRepositories)
+	segments := strings.Split(token, "/")
+
+	var acls []security.AccessControlList
+	for i := range segments {
+		parentToken := strings.Join(segments[:i+1], "/")
+		result, err := clients.SecurityClient.QueryAccessControlLists(clients.Ctx, security.QueryAccessControlListsArgs{
+			SecurityNamespaceId: &namespaceID,
+			Token:               converter.String(parentToken),
+		})
+		if err != nil {
+			return nil, err
+		}
+		if result != nil {
+			acls = append(acls, *result...)
+		}
+	}
+	return acls, nil
+}
+
+// evaluateBypassPermissions evaluates the explicit permissions of each descriptor along the token hierarchy, an explicit
+// permission on a token overrides the permission inherited from its parents. Only descriptors with at least one effective
+// bypass permission are returned.
+func evaluateBypassPermissions(acls []security.AccessControlList, bits map[securityhelper.ActionName]int) map[string]map[securityhelper.ActionName]bool {
+	states := map[string]map[securityhelper.ActionName]bool{}
+	for _, acl := range acls {
+		if acl.AcesDictionary == nil {
+			continue
+		}
+		inheritPermissions := acl.InheritPermissions == nil || *acl.InheritPermissions
+		if !inheritPermissions {
+			states = map[string]map[securityhelper.ActionName]bool{}
+		}
+		for _, ace := range *acl.AcesDictionary {
+			if ace.Descriptor == nil {
+				continue
+			}
+			state, ok := states[*ace.Descriptor]
+			if !ok {
+				state = map[securityhelper.ActionName]bool{}
+				states[*ace.Descriptor] = state
+			}
+			for name, bit := range bits {
+				switch {
+				case ace.Deny != nil && *ace.Deny&bit != 0:
+					state[name] = false
+				case ace.Allow != nil && *ace.Allow&bit != 0:
+					state[name] = true
+				}
+			}
+		}
+	}
+
+	effective := map[string]map[securityhelper.ActionName]bool{}
+	for descriptor, state := range states {
+		for _, allowed := range state {
+			if allowed {
+				effective[descriptor] = state
+				break
+			}
+		}
+	}
+	return effective
+}
+
+// readIdentitiesByDescriptors returns the identities of the descriptors keyed by the lower case descriptor
+func readIdentitiesByDescriptors(clients *client.AggregatedClient, descriptors []string) (map[string]identity.Identity, error) {
+	identities := map[string]identity.Identity{}
+	if len(descriptors) == 0 {
+		return identities, nil
+	}
+
+	list, err := clients.IdentityClient.ReadIdentities(clients.Ctx, identity.ReadIdentitiesArgs{
+		Descriptors: converter.String(strings.Join(descriptors, ",")),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if list == nil {
+		return identities, nil
+	}
+	for _, id := range *list {
+		if id.Descriptor == nil {
+			continue
+		}
+		identities[strings.ToLower(*id.Descriptor)] = id
+	}
+	return identities, nil
+}
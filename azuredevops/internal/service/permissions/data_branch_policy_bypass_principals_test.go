@@ -0,0 +1,116 @@
+//go:build (all || permissions || data_branch_policy_bypass_principals) && (!exclude_permissions || !exclude_data_branch_policy_bypass_principals)
+// +build all permissions data_branch_policy_bypass_principals
+// +build !exclude_permissions !exclude_data_branch_policy_bypass_principals
+
+package permissions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/security"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	securityhelper "github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/service/permissions/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+var bypassBits = map[securityhelper.ActionName]int{
+	bypassPullRequestPolicyPermission: 32768,
+	bypassPushPolicyPermission:        128,
+}
+
+func bypassACL(token string, inherit bool, aces map[string][2]int) security.AccessControlList {
+	dictionary := map[string]security.AccessControlEntry{}
+	for descriptor, ace := range aces {
+		dictionary[descriptor] = security.AccessControlEntry{
+			Descriptor: converter.String(descriptor),
+			Allow:      converter.Int(ace[0]),
+			Deny:       converter.Int(ace[1]),
+		}
+	}
+	return security.AccessControlList{
+		Token:              converter.String(token),
+		InheritPermissions: converter.Bool(inherit),
+		AcesDictionary:     &dictionary,
+	}
+}
+
+func TestDataBranchPolicyBypassPrincipals_EvaluateInheritance(t *testing.T) {
+	acls := []security.AccessControlList{
+		bypassACL(gitTokenRepository, true, map[string][2]int{
+			"repoAdmins": {32768 | 128, 0},
+			"developers": {32768, 0},
+			"readers":    {1, 0},
+		}),
+		bypassACL(gitTokenBranch, true, map[string][2]int{
+			"developers": {0, 32768},
+			"releasers":  {128, 0},
+		}),
+	}
+
+	effective := evaluateBypassPermissions(acls, bypassBits)
+	assert.Len(t, effective, 2)
+	assert.Equal(t, map[securityhelper.ActionName]bool{
+		bypassPullRequestPolicyPermission: true,
+		bypassPushPolicyPermission:        true,
+	}, effective["repoAdmins"])
+	assert.Equal(t, map[securityhelper.ActionName]bool{
+		bypassPushPolicyPermission: true,
+	}, effective["releasers"])
+	assert.NotContains(t, effective, "developers")
+	assert.NotContains(t, effective, "readers")
+}
+
+func TestDataBranchPolicyBypassPrincipals_EvaluateWithoutInheritance(t *testing.T) {
+	acls := []security.AccessControlList{
+		bypassACL(gitTokenRepository, true, map[string][2]int{
+			"repoAdmins": {32768, 0},
+		}),
+		bypassACL(gitTokenBranch, false, map[string][2]int{
+			"releasers": {32768, 0},
+		}),
+	}
+
+	effective := evaluateBypassPermissions(acls, bypassBits)
+	assert.Len(t, effective, 1)
+	assert.Contains(t, effective, "releasers")
+}
+
+func TestDataBranchPolicyBypassPrincipals_QueriesTokenHierarchy(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	securityClient := azdosdkmocks.NewMockSecurityClient(ctrl)
+	clients := &client.AggregatedClient{
+		SecurityClient: securityClient,
+		Ctx:            context.Background(),
+	}
+
+	expectedTokens := []string{
+		"repoV2",
+		gitTokenProject,
+		gitTokenRepository,
+		gitTokenRepository + "/refs",
+		gitTokenBranchAll,
+		gitTokenBranch,
+	}
+	var calls []any
+	for _, token := range expectedTokens {
+		acls := []security.AccessControlList{bypassACL(token, true, nil)}
+		calls = append(calls, securityClient.
+			EXPECT().
+			QueryAccessControlLists(clients.Ctx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, args security.QueryAccessControlListsArgs) (*[]security.AccessControlList, error) {
+				assert.Equal(t, token, *args.Token)
+				return &acls, nil
+			}))
+	}
+	gomock.InOrder(calls...)
+
+	acls, err := getGitTokenHierarchyACLs(clients, gitTokenBranch)
+	assert.Nil(t, err)
+	assert.Len(t, acls, len(expectedTokens))
+}
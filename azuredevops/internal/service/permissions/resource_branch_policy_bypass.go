@@ -0,0 +1,180 @@
+package permissions
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	securityhelper "github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/service/permissions/utils"
+)
+
+// Git repository permissions granting the right to bypass branch policies
+const (
+	bypassPullRequestPolicyPermission securityhelper.ActionName = "PullRequestBypassPolicy"
+	bypassPushPolicyPermission        securityhelper.ActionName = "PolicyExempt"
+)
+
+// ResourceBranchPolicyBypass schema and implementation for the branch policy bypass permissions of a principal
+func ResourceBranchPolicyBypass() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBranchPolicyBypassCreateOrUpdate,
+		Read:   resourceBranchPolicyBypassRead,
+		Update: resourceBranchPolicyBypassCreateOrUpdate,
+		Delete: resourceBranchPolicyBypassDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				ValidateFunc: validation.IsUUID,
+				Required:     true,
+				ForceNew:     true,
+			},
+			// Bypass permissions are never granted on the whole project or repository
+			"repository_id": {
+				Type:         schema.TypeString,
+				ValidateFunc: validation.IsUUID,
+				Required:     true,
+				ForceNew:     true,
+			},
+			"branch_name": {
+				Type:         schema.TypeString,
+				ValidateFunc: validateBypassBranchName,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"branch_name", "ref_folder"},
+			},
+			// A folder of branches, e.g. `refs/heads/releases/*`. The permissions apply to all branches below the folder.
+			"ref_folder": {
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^refs/heads(/.*)?$`), "must be a folder below `refs/heads`"),
+				Optional:     true,
+				ForceNew:     true,
+			},
+			"principal": {
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				Required:     true,
+				ForceNew:     true,
+			},
+			"bypass_pull_request_policies": {
+				Type:         schema.TypeBool,
+				Optional:     true,
+				Default:      false,
+				AtLeastOneOf: []string{"bypass_pull_request_policies", "bypass_push_policies"},
+			},
+			"bypass_push_policies": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func resourceBranchPolicyBypassCreateOrUpdate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	sn, err := securityhelper.NewSecurityNamespace(d, clients, securityhelper.SecurityNamespaceIDValues.GitRepositories, createGitToken)
+	if err != nil {
+		return err
+	}
+
+	// Only the bypass permissions are touched, other permissions of the principal on the ref are kept
+	permissions := map[securityhelper.ActionName]securityhelper.PermissionType{
+		bypassPullRequestPolicyPermission: bypassPermissionType(d.Get("bypass_pull_request_policies").(bool)),
+		bypassPushPolicyPermission:        bypassPermissionType(d.Get("bypass_push_policies").(bool)),
+	}
+	principal := d.Get("principal").(string)
+	if err := securityhelper.UpdatePrincipalPermissions(sn, principal, permissions, true); err != nil {
+		return fmt.Errorf(" Setting branch policy bypass permissions for principal %s. Error: %+v", principal, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", sn.GetToken(), principal))
+	return resourceBranchPolicyBypassRead(d, m)
+}
+
+func resourceBranchPolicyBypassRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	sn, err := securityhelper.NewSecurityNamespace(d, clients, securityhelper.SecurityNamespaceIDValues.GitRepositories, createGitToken)
+	if err != nil {
+		return err
+	}
+
+	principal := d.Get("principal").(string)
+	principalPermissions, err := sn.GetPrincipalPermissions(&[]string{principal})
+	if err != nil {
+		return fmt.Errorf(" Reading branch policy bypass permissions for principal %s. Error: %+v", principal, err)
+	}
+
+	if principalPermissions == nil || len(*principalPermissions) == 0 {
+		d.SetId("")
+		log.Printf("[INFO] Permissions for ACL token %q not found. Removing from state", sn.GetToken())
+		return nil
+	}
+	if len(*principalPermissions) != 1 {
+		return fmt.Errorf("Failed to retrieve current permissions for principal [%s]", principal)
+	}
+
+	permissions := (*principalPermissions)[0].Permissions
+
+	d.Set("bypass_pull_request_policies", permissions[bypassPullRequestPolicyPermission] == securityhelper.PermissionTypeValues.Allow)
+	d.Set("bypass_push_policies", permissions[bypassPushPolicyPermission] == securityhelper.PermissionTypeValues.Allow)
+	return nil
+}
+
+func resourceBranchPolicyBypassDelete(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	sn, err := securityhelper.NewSecurityNamespace(d, clients, securityhelper.SecurityNamespaceIDValues.GitRepositories, createGitToken)
+	if err != nil {
+		return err
+	}
+
+	permissions := map[securityhelper.ActionName]securityhelper.PermissionType{
+		bypassPullRequestPolicyPermission: securityhelper.PermissionTypeValues.NotSet,
+		bypassPushPolicyPermission:        securityhelper.PermissionTypeValues.NotSet,
+	}
+	principal := d.Get("principal").(string)
+	if err := securityhelper.UpdatePrincipalPermissions(sn, principal, permissions, true); err != nil {
+		return fmt.Errorf(" Removing branch policy bypass permissions for principal %s. Error: %+v", principal, err)
+	}
+	return nil
+}
+
+func bypassPermissionType(bypass bool) securityhelper.PermissionType {
+	if bypass {
+		return securityhelper.PermissionTypeValues.Allow
+	}
+	return securityhelper.PermissionTypeValues.NotSet
+}
+
+// validateBypassBranchName accepts a single branch, either as short name or below `refs/heads`
+func validateBypassBranchName(i interface{}, key string) ([]string, []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %q to be string", key)}
+	}
+	if strings.TrimSpace(v) == "" {
+		return nil, []error{fmt.Errorf("expected %q not to be an empty string or whitespace", key)}
+	}
+	if strings.Contains(v, "*") {
+		return nil, []error{fmt.Errorf("%q must name a single branch, use `ref_folder` to target a folder of branches", key)}
+	}
+	if name := strings.TrimPrefix(v, "/"); strings.HasPrefix(name, "refs/") {
+		if branch := strings.TrimPrefix(name, "refs/heads/"); branch == name || strings.Trim(branch, "/") == "" {
+			return nil, []error{fmt.Errorf("%q must be a branch below `refs/heads`, got %q", key, v)}
+		}
+	}
+	return nil, nil
+}
@@ -0,0 +1,103 @@
+//go:build (all || permissions || resource_branch_policy_bypass) && (!exclude_permissions || !exclude_resource_branch_policy_bypass)
+// +build all permissions resource_branch_policy_bypass
+// +build !exclude_permissions !exclude_resource_branch_policy_bypass
+
+package permissions
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBranchPolicyBypass_ValidateBranchName(t *testing.T) {
+	valid := []string{
+		"master",
+		"releases/1.0",
+		"refs/heads/master",
+		"/refs/heads/releases/1.0",
+	}
+	for _, name := range valid {
+		_, errs := validateBypassBranchName(name, "branch_name")
+		assert.Empty(t, errs, name)
+	}
+
+	invalid := []string{
+		"",
+		"  ",
+		"releases/*",
+		"refs/heads/*",
+		"refs/heads/",
+		"refs/heads",
+		"refs/tags/v1.0",
+	}
+	for _, name := range invalid {
+		_, errs := validateBypassBranchName(name, "branch_name")
+		assert.NotEmpty(t, errs, name)
+	}
+}
+
+func TestBranchPolicyBypass_CreateGitToken(t *testing.T) {
+	clients := &client.AggregatedClient{
+		Ctx: context.Background(),
+	}
+
+	cases := map[string]map[string]string{
+		gitTokenBranch: {
+			"branch_name": "refs/heads/" + gitBranchNameValid,
+		},
+		gitTokenSubBranch: {
+			"branch_name": gitBranchNameValid + "/" + gitSubBranchNameValid,
+		},
+		gitTokenBranchAll: {
+			"ref_folder": "refs/heads/*",
+		},
+		fmt.Sprintf("%s/refs/heads/%s", gitTokenRepository, encodeBranchName("releases")): {
+			"ref_folder": "refs/heads/releases/*",
+		},
+	}
+	for expected, scope := range cases {
+		d := schema.TestResourceDataRaw(t, ResourceBranchPolicyBypass().Schema, nil)
+		d.Set("project_id", gitProjectID)
+		d.Set("repository_id", gitRepositoryID)
+		for key, value := range scope {
+			d.Set(key, value)
+		}
+		token, err := createGitToken(d, clients)
+		assert.Nil(t, err)
+		assert.Equal(t, expected, token)
+	}
+}
+
+func TestBranchPolicyBypass_RefFolderBelowHeads(t *testing.T) {
+	validateFunc := ResourceBranchPolicyBypass().Schema["ref_folder"].ValidateFunc
+
+	for _, folder := range []string{"refs/heads", "refs/heads/*", "refs/heads/releases/*"} {
+		_, errs := validateFunc(folder, "ref_folder")
+		assert.Empty(t, errs, folder)
+	}
+	for _, folder := range []string{"refs/tags/*", "refs", "releases/*"} {
+		_, errs := validateFunc(folder, "ref_folder")
+		assert.NotEmpty(t, errs, folder)
+	}
+}
+
+func TestGitPermissions_GrantedBypassPermissions(t *testing.T) {
+	permissions := map[string]interface{}{
+		"GenericRead": "Allow",
+		string(bypassPullRequestPolicyPermission): "allow",
+		string(bypassPushPolicyPermission):        "Deny",
+	}
+
+	d := getGitPermissionsResource(t, gitProjectID, gitRepositoryID, "")
+	d.Set("permissions", permissions)
+	assert.Equal(t, []string{string(bypassPullRequestPolicyPermission)}, grantedBypassPermissions(d))
+
+	d = getGitPermissionsResource(t, gitProjectID, gitRepositoryID, gitBranchNameValid)
+	d.Set("permissions", permissions)
+	assert.Empty(t, grantedBypassPermissions(d))
+}
@@ -0,0 +1,54 @@
+//go:build (all || permissions || resource_delivery_plan_permissions) && (!exclude_permissions || !resource_delivery_plan_permissions)
+// +build all permissions resource_delivery_plan_permissions
+// +build !exclude_permissions !resource_delivery_plan_permissions
+
+package permissions
+
+// The tests in this file use the mock clients in mock_client.go to mock out
+// the Azure DevOps client operations.
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+/**
+ * Begin unit tests
+ */
+
+var deliveryPlanID = "d4b7b5a8-3e0f-4e3a-9c4b-6f1d2c3b4a59"
+
+func TestDeliveryPlanPermissions_CreateDeliveryPlanToken(t *testing.T) {
+	var d *schema.ResourceData
+	var token string
+	var err error
+
+	d = getDeliveryPlanPermissionsResource(t, projectID, "")
+	token, err = createDeliveryPlanToken(d, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, fmt.Sprintf("Plan/%s", projectID), token)
+
+	d = getDeliveryPlanPermissionsResource(t, projectID, deliveryPlanID)
+	token, err = createDeliveryPlanToken(d, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, fmt.Sprintf("Plan/%s/%s", projectID, deliveryPlanID), token)
+
+	d = getDeliveryPlanPermissionsResource(t, "", deliveryPlanID)
+	token, err = createDeliveryPlanToken(d, nil)
+	assert.Empty(t, token)
+	assert.NotNil(t, err)
+}
+
+func getDeliveryPlanPermissionsResource(t *testing.T, projectID string, planID string) *schema.ResourceData {
+	d := schema.TestResourceDataRaw(t, ResourceDeliveryPlanPermissions().Schema, nil)
+	if projectID != "" {
+		d.Set("project_id", projectID)
+	}
+	if planID != "" {
+		d.Set("plan_id", planID)
+	}
+	return d
+}
@@ -47,6 +47,15 @@ func ResourceGitPermissions() *schema.Resource {
 				ForceNew:     true,
 				RequiredWith: []string{"repository_id"},
 			},
+			// A folder of branches or tags, e.g. `refs/heads/releases/*`. The permissions apply to all refs below the folder.
+			"ref_folder": {
+				Type:          schema.TypeString,
+				ValidateFunc:  validation.StringMatch(regexp.MustCompile(`^refs/(heads|tags)(/.*)?$`), "must be a folder below `refs/heads` or `refs/tags`"),
+				Optional:      true,
+				ForceNew:      true,
+				RequiredWith:  []string{"repository_id"},
+				ConflictsWith: []string{"branch_name"},
+			},
 		}),
 	}
 }
@@ -59,6 +68,10 @@ func resourceGitPermissionsCreateOrUpdate(d *schema.ResourceData, m interface{})
 		return err
 	}
 
+	if bypass := grantedBypassPermissions(d); len(bypass) > 0 {
+		log.Printf("[WARN] Permissions %s allow bypassing branch policies on all branches of ACL token %q. Use azuredevops_branch_policy_bypass to grant them on specific branches.", strings.Join(bypass, ", "), sn.GetToken())
+	}
+
 	if err := securityhelper.SetPrincipalPermissions(d, sn, nil, false); err != nil {
 		return err
 	}
@@ -103,6 +116,22 @@ func resourceGitPermissionsDelete(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 
+// grantedBypassPermissions returns the branch policy bypass permissions allowed on a project or repository scope
+func grantedBypassPermissions(d *schema.ResourceData) []string {
+	if d.Get("branch_name").(string) != "" || d.Get("ref_folder").(string) != "" {
+		return nil
+	}
+
+	var granted []string
+	permissions := d.Get("permissions").(map[string]interface{})
+	for _, name := range []securityhelper.ActionName{bypassPullRequestPolicyPermission, bypassPushPolicyPermission} {
+		if value, ok := permissions[string(name)].(string); ok && strings.EqualFold(value, string(securityhelper.PermissionTypeValues.Allow)) {
+			granted = append(granted, string(name))
+		}
+	}
+	return granted
+}
+
 func createGitToken(d *schema.ResourceData, clients *client.AggregatedClient) (string, error) {
 	projectID, ok := d.GetOk("project_id")
 	if !ok {
@@ -115,6 +144,7 @@ func createGitToken(d *schema.ResourceData, clients *client.AggregatedClient) (s
 	 * ACL for a Git repository in a project:                     repoV2/#ProjectID#/#RepositoryID#
 	 * ACL for all branches inside a Git repository in a project: repoV2/#ProjectID#/#RepositoryID#/refs/heads
 	 * ACL for a branch inside a Git repository in a project:     repoV2/#ProjectID#/#RepositoryID#/refs/heads/#BranchID#
+	 * ACL for a ref folder inside a Git repository in a project: repoV2/#ProjectID#/#RepositoryID#/refs/heads|tags/#FolderID#
 	 */
 	aclToken := "repoV2/" + projectID.(string)
 	repositoryID, repoOk := d.GetOk("repository_id")
@@ -130,17 +160,42 @@ func createGitToken(d *schema.ResourceData, clients *client.AggregatedClient) (s
 		re := regexp.MustCompile(`(/?refs/heads/)?(.*)+`)
 		branchPath := re.FindStringSubmatch(branchName.(string))
 
-		paths := strings.Split(branchPath[len(branchPath)-1], "/")
-		encodedPaths := make([]string, len(paths))
-		for i, subBranchPath := range paths {
-			encoded, err := converter.EncodeUtf16HexString(subBranchPath)
+		encodedPath, err := encodeGitRefPath(branchPath[len(branchPath)-1])
+		if err != nil {
+			return "", err
+		}
+		aclToken += "/refs/heads/" + encodedPath
+	}
+	refFolder, refFolderOk := d.GetOk("ref_folder")
+	if refFolderOk {
+		if !repoOk {
+			return "", fmt.Errorf("Unable to create ACL token for ref folder %s, because no repository is specified", refFolder)
+		}
+
+		// The token of a folder is the parent of the tokens of all refs below it, a trailing wildcard is optional
+		parts := strings.SplitN(strings.TrimSuffix(strings.TrimSuffix(refFolder.(string), "*"), "/"), "/", 3)
+		aclToken += "/refs/" + parts[1]
+		if len(parts) == 3 && parts[2] != "" {
+			encodedPath, err := encodeGitRefPath(parts[2])
 			if err != nil {
 				return "", err
 			}
-			encodedPaths[i] = encoded
+			aclToken += "/" + encodedPath
 		}
-
-		aclToken += "/refs/heads/" + strings.Join(encodedPaths, "/")
 	}
 	return aclToken, nil
 }
+
+// encodeGitRefPath encodes each segment of a ref path the way the security namespace expects it
+func encodeGitRefPath(refPath string) (string, error) {
+	paths := strings.Split(refPath, "/")
+	encodedPaths := make([]string, len(paths))
+	for i, subPath := range paths {
+		encoded, err := converter.EncodeUtf16HexString(subPath)
+		if err != nil {
+			return "", err
+		}
+		encodedPaths[i] = encoded
+	}
+	return strings.Join(encodedPaths, "/"), nil
+}
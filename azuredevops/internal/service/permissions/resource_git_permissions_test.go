@@ -101,6 +101,37 @@ func TestGitPermissions_CreateGitTokenWithBranch(t *testing.T) {
 	assert.Equal(t, gitTokenSubBranch, token)
 }
 
+func TestGitPermissions_CreateGitTokenWithRefFolder(t *testing.T) {
+	clients := &client.AggregatedClient{
+		Ctx: context.Background(),
+	}
+
+	cases := map[string]string{
+		"refs/heads":                       gitTokenBranchAll,
+		"refs/heads/releases":              fmt.Sprintf("%s/refs/heads/%s", gitTokenRepository, encodeBranchName("releases")),
+		"refs/heads/releases/*":            fmt.Sprintf("%s/refs/heads/%s", gitTokenRepository, encodeBranchName("releases")),
+		"refs/heads/releases/2024/":        fmt.Sprintf("%s/refs/heads/%s/%s", gitTokenRepository, encodeBranchName("releases"), encodeBranchName("2024")),
+		"refs/tags/*":                      fmt.Sprintf("%s/refs/tags", gitTokenRepository),
+		"refs/tags/v" + gitBranchNameValid: fmt.Sprintf("%s/refs/tags/%s", gitTokenRepository, encodeBranchName("v"+gitBranchNameValid)),
+	}
+	for refFolder, expected := range cases {
+		d := schema.TestResourceDataRaw(t, ResourceGitPermissions().Schema, nil)
+		d.Set("project_id", gitProjectID)
+		d.Set("repository_id", gitRepositoryID)
+		d.Set("ref_folder", refFolder)
+		token, err := createGitToken(d, clients)
+		assert.Nil(t, err)
+		assert.Equal(t, expected, token, refFolder)
+	}
+
+	d := schema.TestResourceDataRaw(t, ResourceGitPermissions().Schema, nil)
+	d.Set("project_id", gitProjectID)
+	d.Set("ref_folder", "refs/heads/releases/*")
+	token, err := createGitToken(d, clients)
+	assert.Empty(t, token)
+	assert.NotNil(t, err)
+}
+
 func encodeBranchName(branchName string) string {
 	ret, _ := converter.EncodeUtf16HexString(branchName)
 	return ret
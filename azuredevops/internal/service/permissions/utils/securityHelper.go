@@ -34,11 +34,52 @@ func SetPrincipalPermissions(d *schema.ResourceData, sn *SecurityNamespace, forc
 			permissionMap[ActionName(key)] = PermissionType(elem.(string))
 		}
 	}
+	if err := UpdatePrincipalPermissions(sn, principal.(string), permissionMap, bReplace.(bool)); err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", sn.token, principal.(string)))
+	return nil
+}
+
+// GetPrincipalPermissions gets permissions for a specific security namespac
+func GetPrincipalPermissions(d *schema.ResourceData, sn *SecurityNamespace) (*PrincipalPermission, error) {
+	principal, ok := d.GetOk("principal")
+	if !ok {
+		return nil, fmt.Errorf("Failed to get 'principal' from schema")
+	}
+
+	permissions, ok := d.GetOk("permissions")
+	if !ok {
+		return nil, fmt.Errorf("Failed to get 'permissions' from schema")
+	}
+
+	principalList := []string{*converter.StringFromInterface(principal)}
+	principalPermissions, err := sn.GetPrincipalPermissions(&principalList)
+	if err != nil {
+		return nil, err
+	}
+	if principalPermissions == nil || len(*principalPermissions) == 0 {
+		return nil, nil
+	}
+	if len(*principalPermissions) != 1 {
+		return nil, fmt.Errorf("Failed to retrieve current permissions for principal [%s]", principalList[0])
+	}
+	for key := range ((*principalPermissions)[0]).Permissions {
+		if _, ok := permissions.(map[string]interface{})[string(key)]; !ok {
+			delete(((*principalPermissions)[0]).Permissions, key)
+		}
+	}
+	return &(*principalPermissions)[0], nil
+}
+
+// UpdatePrincipalPermissions sets the given permissions of a principal and waits until the backend reports them
+func UpdatePrincipalPermissions(sn *SecurityNamespace, principal string, permissionMap map[ActionName]PermissionType, replace bool) error {
 	setPermissions := []SetPrincipalPermission{
 		{
-			Replace: bReplace.(bool),
+			Replace: replace,
 			PrincipalPermission: PrincipalPermission{
-				SubjectDescriptor: principal.(string),
+				SubjectDescriptor: principal,
 				Permissions:       permissionMap,
 			},
 		},
@@ -54,13 +95,13 @@ func SetPrincipalPermissions(d *schema.ResourceData, sn *SecurityNamespace, forc
 		Refresh: func() (interface{}, string, error) {
 			state := "Waiting"
 			currentPermissions, err := sn.GetPrincipalPermissions(&[]string{
-				principal.(string),
+				principal,
 			})
 			if err != nil {
-				return nil, "", fmt.Errorf("Reading permissions for principal %s: %+v", err, principal.(string))
+				return nil, "", fmt.Errorf("Reading permissions for principal %s: %+v", err, principal)
 			}
 			if len(*currentPermissions) != 1 {
-				return nil, "", fmt.Errorf("Received multiple permission sets for principal [%s] from backend. Expected single value.", principal.(string))
+				return nil, "", fmt.Errorf("Received multiple permission sets for principal [%s] from backend. Expected single value.", principal)
 			}
 
 			bInsnyc := false
@@ -87,37 +128,5 @@ func SetPrincipalPermissions(d *schema.ResourceData, sn *SecurityNamespace, forc
 		return fmt.Errorf("waiting for permission update. %v ", err)
 	}
 
-	d.SetId(fmt.Sprintf("%s/%s", sn.token, principal.(string)))
 	return nil
 }
-
-// GetPrincipalPermissions gets permissions for a specific security namespac
-func GetPrincipalPermissions(d *schema.ResourceData, sn *SecurityNamespace) (*PrincipalPermission, error) {
-	principal, ok := d.GetOk("principal")
-	if !ok {
-		return nil, fmt.Errorf("Failed to get 'principal' from schema")
-	}
-
-	permissions, ok := d.GetOk("permissions")
-	if !ok {
-		return nil, fmt.Errorf("Failed to get 'permissions' from schema")
-	}
-
-	principalList := []string{*converter.StringFromInterface(principal)}
-	principalPermissions, err := sn.GetPrincipalPermissions(&principalList)
-	if err != nil {
-		return nil, err
-	}
-	if principalPermissions == nil || len(*principalPermissions) == 0 {
-		return nil, nil
-	}
-	if len(*principalPermissions) != 1 {
-		return nil, fmt.Errorf("Failed to retrieve current permissions for principal [%s]", principalList[0])
-	}
-	for key := range ((*principalPermissions)[0]).Permissions {
-		if _, ok := permissions.(map[string]interface{})[string(key)]; !ok {
-			delete(((*principalPermissions)[0]).Permissions, key)
-		}
-	}
-	return &(*principalPermissions)[0], nil
-}
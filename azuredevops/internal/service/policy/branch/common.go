@@ -1,13 +1,18 @@
 package branch
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"log"
+	"slices"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/policy"
@@ -16,6 +21,7 @@ import (
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/suppress"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/sdk/policyextras"
 )
 
 /**
@@ -47,11 +53,11 @@ type policyCrudArgs struct {
 // genBasePolicyResource creates a Resource with the common elements of a build policy
 func genBasePolicyResource(crudArgs *policyCrudArgs) *schema.Resource {
 	return &schema.Resource{
-		Create:   genPolicyCreateFunc(crudArgs),
-		Read:     genPolicyReadFunc(crudArgs),
-		Update:   genPolicyUpdateFunc(crudArgs),
-		Delete:   genPolicyDeleteFunc(crudArgs),
-		Importer: tfhelper.ImportProjectQualifiedResourceInteger(),
+		CreateContext: genPolicyCreateFunc(crudArgs),
+		Read:          genPolicyReadFunc(crudArgs),
+		UpdateContext: genPolicyUpdateFunc(crudArgs),
+		Delete:        genPolicyDeleteFunc(crudArgs),
+		Importer:      genPolicyImporter(crudArgs),
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(5 * time.Minute),
 			Read:   schema.DefaultTimeout(2 * time.Minute),
@@ -75,6 +81,12 @@ func genBasePolicyResource(crudArgs *policyCrudArgs) *schema.Resource {
 				Optional: true,
 				Default:  true,
 			},
+			// Only evaluated when the policy is created
+			"adopt_existing": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"settings": {
 				Type:     schema.TypeList,
 				Required: true,
@@ -219,6 +231,9 @@ func expandSettings(d *schema.ResourceData) (map[string]interface{}, error) {
 			}
 		}
 		if repoRef, ok := scopeMap["repository_ref"]; ok {
+			if strings.Contains(repoRef.(string), "*") {
+				return nil, fmt.Errorf("'repository_ref' does not support wildcards, use 'match_type=Prefix' with the ref prefix instead, e.g. 'refs/heads/release/' instead of 'refs/heads/release/*'")
+			}
 			if repoRef == "" {
 				scopeSetting["refName"] = nil
 			} else {
@@ -232,7 +247,7 @@ func expandSettings(d *schema.ResourceData) (map[string]interface{}, error) {
 				scopeSetting["matchKind"] = matchType
 			}
 		}
-		if strings.EqualFold(scopeSetting["matchKind"].(string), "DefaultBranch") && (scopeSetting["repositoryId"] != nil || scopeSetting["refName"] != nil) {
+		if matchType, _ := scopeSetting["matchKind"].(string); strings.EqualFold(matchType, "DefaultBranch") && (scopeSetting["repositoryId"] != nil || scopeSetting["refName"] != nil) {
 			return nil, fmt.Errorf("neither 'repository_id' nor 'repository_ref' can be set when 'match_type=DefaultBranch'")
 		}
 		scopes[index] = scopeSetting
@@ -242,26 +257,179 @@ func expandSettings(d *schema.ResourceData) (map[string]interface{}, error) {
 	}, nil
 }
 
-//lint:ignore SA1019 SDKv2 migration  - staticcheck's own linter directives are currently being ignored under golanci-lint
-func genPolicyCreateFunc(crudArgs *policyCrudArgs) schema.CreateFunc { //nolint:staticcheck
-	return func(d *schema.ResourceData, m interface{}) error {
-		clients := m.(*client.AggregatedClient)
-		policyConfig, projectID, err := crudArgs.ExpandFunc(d, crudArgs.PolicyType)
+// flattenStringsInConfiguredOrder keeps the configured order of a list if the service returns the same values in a
+// different order, e.g. the filename patterns of a policy
+func flattenStringsInConfiguredOrder(configured []interface{}, values []string) []string {
+	if len(configured) != len(values) {
+		return values
+	}
+
+	counts := map[string]int{}
+	for _, value := range values {
+		counts[value]++
+	}
+	configuredValues := make([]string, len(configured))
+	for i, value := range configured {
+		configuredValues[i], _ = value.(string)
+		counts[configuredValues[i]]--
+	}
+	for _, count := range counts {
+		if count != 0 {
+			return values
+		}
+	}
+	return configuredValues
+}
+
+// policyOverlapWarnings warns about existing policies of the same type that overlap the scopes of the policy, i.e.
+// a project-wide policy and a repository policy applying to the same branches. Azure DevOps evaluates both policies.
+// The warnings are returned by the apply, CustomizeDiff of the plugin SDK can only fail a plan but not warn.
+func policyOverlapWarnings(crudArgs *policyCrudArgs, d *schema.ResourceData, clients *client.AggregatedClient) diag.Diagnostics {
+	typeID := crudArgs.PolicyType
+	if typeID == uuid.Nil {
+		parsedTypeID, err := uuid.Parse(d.Get("type_id").(string))
 		if err != nil {
-			return err
+			return nil
 		}
+		typeID = parsedTypeID
+	}
+	scopes, _ := d.Get("settings.0.scope").([]interface{})
+	if len(scopes) == 0 {
+		return nil
+	}
+
+	// The check is best effort, failing to list the policies must not fail the apply
+	overlapping, err := findOverlappingPolicies(clients, d.Get("project_id").(string), typeID, d.Id(), scopes)
+	if err != nil {
+		log.Printf("[WARN] Unable to check the policies of type %s for overlapping scopes: %+v", typeID, err)
+		return nil
+	}
 
-		createdPolicy, err := clients.PolicyClient.CreatePolicyConfiguration(clients.Ctx, policy.CreatePolicyConfigurationArgs{
-			Configuration: policyConfig,
-			Project:       projectID,
+	var diags diag.Diagnostics
+	for _, policyID := range overlapping {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("The scope of the policy overlaps the scope of policy %d", policyID),
+			Detail: fmt.Sprintf("Policy %d has the same type %s. One of the policies applies to all repositories of the project and the other to a single repository, "+
+				"Azure DevOps evaluates both policies for the overlapping branches.", policyID, typeID),
 		})
+	}
+	return diags
+}
+
+// findOverlappingPolicies returns the IDs of the policies of the type that apply to all repositories of the project
+// where the scopes apply to a single repository, or vice versa, for overlapping branches
+func findOverlappingPolicies(clients *client.AggregatedClient, projectID string, typeID uuid.UUID, policyID string, scopes []interface{}) ([]int, error) {
+	configs, err := policyextras.ListPolicyConfigurations(clients.Ctx, clients.PolicyClientExtras, policyextras.GetPolicyConfigurationsArgs{
+		Project:    converter.String(projectID),
+		PolicyType: &typeID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	overlapping := []int{}
+	for _, config := range configs {
+		if converter.ToBool(config.IsDeleted, false) || config.Id == nil || strconv.Itoa(*config.Id) == policyID {
+			continue
+		}
+		_, existingScopes, err := flattenBranchPolicySettings(&config)
 		if err != nil {
-			return fmt.Errorf("creating policy in Azure DevOps: %+v", err)
+			return nil, err
 		}
+		if policyScopesOverlap(scopes, existingScopes) {
+			overlapping = append(overlapping, *config.Id)
+		}
+	}
+	return overlapping, nil
+}
+
+// policyScopesOverlap reports whether a project-wide scope of one list applies to the same branches as a repository
+// scope of the other list
+func policyScopesOverlap(scopes []interface{}, otherScopes []interface{}) bool {
+	for _, s := range scopes {
+		scope := s.(map[string]interface{})
+		for _, o := range otherScopes {
+			otherScope := o.(map[string]interface{})
+			projectWide := scope["repository_id"] == nil || scope["repository_id"] == ""
+			otherProjectWide := otherScope["repository_id"] == nil || otherScope["repository_id"] == ""
+			if projectWide != otherProjectWide {
+				if policyRefsOverlap(scope, otherScope) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+func policyRefsOverlap(scope map[string]interface{}, otherScope map[string]interface{}) bool {
+	matchType, _ := scope["match_type"].(string)
+	otherMatchType, _ := otherScope["match_type"].(string)
+	ref, _ := scope["repository_ref"].(string)
+	otherRef, _ := otherScope["repository_ref"].(string)
+	ref = strings.ToLower(ref)
+	otherRef = strings.ToLower(otherRef)
+
+	// The default branch of the repositories is not known, only default branch scopes are compared with each other
+	defaultBranch := strings.EqualFold(matchType, "DefaultBranch")
+	otherDefaultBranch := strings.EqualFold(otherMatchType, "DefaultBranch")
+	if defaultBranch || otherDefaultBranch {
+		return defaultBranch && otherDefaultBranch
+	}
+
+	prefix := strings.EqualFold(matchType, "Prefix")
+	otherPrefix := strings.EqualFold(otherMatchType, "Prefix")
+	switch {
+	case prefix && otherPrefix:
+		return strings.HasPrefix(ref, otherRef) || strings.HasPrefix(otherRef, ref)
+	case prefix:
+		return strings.HasPrefix(otherRef, ref)
+	case otherPrefix:
+		return strings.HasPrefix(ref, otherRef)
+	default:
+		return ref == otherRef
+	}
+}
+
+func genPolicyCreateFunc(crudArgs *policyCrudArgs) schema.CreateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		if err := createPolicy(crudArgs, d, m); err != nil {
+			return diag.FromErr(err)
+		}
+		return policyOverlapWarnings(crudArgs, d, m.(*client.AggregatedClient))
+	}
+}
+
+func createPolicy(crudArgs *policyCrudArgs, d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	policyConfig, projectID, err := crudArgs.ExpandFunc(d, crudArgs.PolicyType)
+	if err != nil {
+		return err
+	}
+
+	if d.Get("adopt_existing").(bool) {
+		policyID, err := findAdoptablePolicy(clients, *projectID, *policyConfig.Type.Id, d.Get("settings.0.scope").([]interface{}))
+		if err != nil {
+			return fmt.Errorf("looking up an existing policy to adopt: %+v", err)
+		}
+		if policyID != nil {
+			log.Printf("[INFO] Adopting existing policy %d with the same type and scope", *policyID)
+			d.SetId(strconv.Itoa(*policyID))
+			return updatePolicy(crudArgs, d, m)
+		}
+	}
 
-		d.SetId(strconv.Itoa(*createdPolicy.Id))
-		return genPolicyReadFunc(crudArgs)(d, m)
+	createdPolicy, err := clients.PolicyClient.CreatePolicyConfiguration(clients.Ctx, policy.CreatePolicyConfigurationArgs{
+		Configuration: policyConfig,
+		Project:       projectID,
+	})
+	if err != nil {
+		return fmt.Errorf("creating policy in Azure DevOps: %+v", err)
 	}
+
+	d.SetId(strconv.Itoa(*createdPolicy.Id))
+	return genPolicyReadFunc(crudArgs)(d, m)
 }
 
 //lint:ignore SA1019 SDKv2 migration  - staticcheck's own linter directives are currently being ignored under golanci-lint
@@ -292,26 +460,35 @@ func genPolicyReadFunc(crudArgs *policyCrudArgs) schema.ReadFunc { //nolint:stat
 	}
 }
 
-//lint:ignore SA1019 SDKv2 migration  - staticcheck's own linter directives are currently being ignored under golanci-lint
-func genPolicyUpdateFunc(crudArgs *policyCrudArgs) schema.UpdateFunc { //nolint:staticcheck
-	return func(d *schema.ResourceData, m interface{}) error {
-		clients := m.(*client.AggregatedClient)
-		policyConfig, projectID, err := crudArgs.ExpandFunc(d, crudArgs.PolicyType)
-		if err != nil {
-			return err
+func genPolicyUpdateFunc(crudArgs *policyCrudArgs) schema.UpdateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		if err := updatePolicy(crudArgs, d, m); err != nil {
+			return diag.FromErr(err)
 		}
-
-		_, err = clients.PolicyClient.UpdatePolicyConfiguration(clients.Ctx, policy.UpdatePolicyConfigurationArgs{
-			ConfigurationId: policyConfig.Id,
-			Configuration:   policyConfig,
-			Project:         projectID,
-		})
-		if err != nil {
-			return fmt.Errorf("updating policy in Azure DevOps: %+v", err)
+		if !d.HasChange("settings") {
+			return nil
 		}
+		return policyOverlapWarnings(crudArgs, d, m.(*client.AggregatedClient))
+	}
+}
+
+func updatePolicy(crudArgs *policyCrudArgs, d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	policyConfig, projectID, err := crudArgs.ExpandFunc(d, crudArgs.PolicyType)
+	if err != nil {
+		return err
+	}
 
-		return genPolicyReadFunc(crudArgs)(d, m)
+	_, err = clients.PolicyClient.UpdatePolicyConfiguration(clients.Ctx, policy.UpdatePolicyConfigurationArgs{
+		ConfigurationId: policyConfig.Id,
+		Configuration:   policyConfig,
+		Project:         projectID,
+	})
+	if err != nil {
+		return fmt.Errorf("updating policy in Azure DevOps: %+v", err)
 	}
+
+	return genPolicyReadFunc(crudArgs)(d, m)
 }
 
 //lint:ignore SA1019 SDKv2 migration  - staticcheck's own linter directives are currently being ignored under golanci-lint
@@ -334,3 +511,143 @@ func genPolicyDeleteFunc(crudArgs *policyCrudArgs) schema.DeleteFunc { //nolint:
 		return nil
 	}
 }
+
+// findAdoptablePolicy returns the ID of the policy of the type whose scopes are exactly the given scopes, or nil if there
+// is no such policy
+func findAdoptablePolicy(clients *client.AggregatedClient, projectID string, typeID uuid.UUID, scopes []interface{}) (*int, error) {
+	configs, err := policyextras.ListPolicyConfigurations(clients.Ctx, clients.PolicyClientExtras, policyextras.GetPolicyConfigurationsArgs{
+		Project:    converter.String(projectID),
+		PolicyType: &typeID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	scopeKeys := policyScopeKeys(scopes)
+	var matches []int
+	for _, config := range configs {
+		if converter.ToBool(config.IsDeleted, false) || config.Id == nil {
+			continue
+		}
+		_, existingScopes, err := flattenBranchPolicySettings(&config)
+		if err != nil {
+			return nil, err
+		}
+		if existingScopes != nil && slices.Equal(scopeKeys, policyScopeKeys(existingScopes)) {
+			matches = append(matches, *config.Id)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, nil
+	case 1:
+		return &matches[0], nil
+	default:
+		return nil, fmt.Errorf("policies %v of type %s have the same scope, import one of them by its ID instead", matches, typeID)
+	}
+}
+
+// policyScopeKeys returns a sorted, case insensitive representation of the scopes that can be compared
+func policyScopeKeys(scopes []interface{}) []string {
+	keys := make([]string, 0, len(scopes))
+	for _, s := range scopes {
+		scope, ok := s.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		repositoryID, _ := scope["repository_id"].(string)
+		repositoryRef, _ := scope["repository_ref"].(string)
+		matchType, _ := scope["match_type"].(string)
+		if matchType == "" {
+			matchType = "Exact"
+		}
+		keys = append(keys, strings.ToLower(repositoryID+"|"+repositoryRef+"|"+matchType))
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// genPolicyImporter imports a policy by an ID that looks like one of the following:
+//
+//	<project ID or name>/<policy configuration ID>
+//	<project ID or name>/<repository ID>/<ref>/<policy type ID>
+//
+// A repository ID of `*` selects a policy that applies to all repositories of the project.
+func genPolicyImporter(crudArgs *policyCrudArgs) *schema.ResourceImporter {
+	importByID := tfhelper.ImportProjectQualifiedResourceInteger()
+	return &schema.ResourceImporter{
+		State: func(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+			d.Set("adopt_existing", false)
+
+			parts := strings.Split(d.Id(), "/")
+			if len(parts) < 4 {
+				return importByID.State(d, m) //nolint:staticcheck
+			}
+
+			typeID, err := uuid.Parse(parts[len(parts)-1])
+			if err != nil || parts[0] == "" || parts[1] == "" {
+				return nil, fmt.Errorf("unexpected format of ID (%s), expected projectid/repositoryId/ref/policyTypeId", d.Id())
+			}
+			if crudArgs.PolicyType != uuid.Nil && typeID != crudArgs.PolicyType {
+				return nil, fmt.Errorf("the policy type %s does not match the policy type %s of the resource", typeID, crudArgs.PolicyType)
+			}
+
+			projectID, err := tfhelper.GetRealProjectId(parts[0], m)
+			if err != nil {
+				return nil, err
+			}
+			repositoryID := parts[1]
+			if repositoryID == "*" {
+				repositoryID = ""
+			}
+			ref := strings.Join(parts[2:len(parts)-1], "/")
+
+			policyID, err := findPolicyByRef(m.(*client.AggregatedClient), projectID, typeID, repositoryID, ref)
+			if err != nil {
+				return nil, err
+			}
+			d.Set("project_id", projectID)
+			d.SetId(strconv.Itoa(policyID))
+			return []*schema.ResourceData{d}, nil
+		},
+	}
+}
+
+// findPolicyByRef returns the ID of the only policy of the type with a scope on the repository and ref
+func findPolicyByRef(clients *client.AggregatedClient, projectID string, typeID uuid.UUID, repositoryID string, ref string) (int, error) {
+	configs, err := policyextras.ListPolicyConfigurations(clients.Ctx, clients.PolicyClientExtras, policyextras.GetPolicyConfigurationsArgs{
+		Project:    converter.String(projectID),
+		PolicyType: &typeID,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("listing policies of type %s: %+v", typeID, err)
+	}
+
+	var matches []int
+	for _, config := range configs {
+		if converter.ToBool(config.IsDeleted, false) || config.Id == nil {
+			continue
+		}
+		_, scopes, err := flattenBranchPolicySettings(&config)
+		if err != nil {
+			return 0, err
+		}
+		for _, s := range scopes {
+			scope := s.(map[string]interface{})
+			if strings.EqualFold(scope["repository_id"].(string), repositoryID) && strings.EqualFold(scope["repository_ref"].(string), ref) {
+				matches = append(matches, *config.Id)
+				break
+			}
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return 0, fmt.Errorf("no policy of type %s found for repository %q and ref %q", typeID, repositoryID, ref)
+	case 1:
+		return matches[0], nil
+	default:
+		return 0, fmt.Errorf("policies %v of type %s apply to repository %q and ref %q, import one of them by its ID instead", matches, typeID, repositoryID, ref)
+	}
+}
@@ -14,11 +14,13 @@ import (
 	"testing"
 
 	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/policy"
 	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/sdk/policyextras"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 )
@@ -91,8 +93,9 @@ func TestBranchPolicyCRUD_CreateError_NotSwallowed(t *testing.T) {
 		Return(nil, errors.New("CreatePolicyConfiguration() Failed")).
 		Times(1)
 
-	err := testResource.Create(resourceData, clients)
-	require.Regexp(t, ".*CreatePolicyConfiguration\\(\\) Failed$", err.Error())
+	diags := testResource.CreateContext(clients.Ctx, resourceData, clients)
+	require.True(t, diags.HasError())
+	require.Regexp(t, ".*CreatePolicyConfiguration\\(\\) Failed$", diags[0].Summary)
 }
 
 // verifies that READ failures are not swallowed
@@ -143,8 +146,9 @@ func TestBranchPolicyCRUD_UpdateError_NotSwallowed(t *testing.T) {
 		Return(nil, errors.New("UpdatePolicyConfiguration() Failed")).
 		Times(1)
 
-	err := testResource.Update(resourceData, clients)
-	require.Regexp(t, ".*UpdatePolicyConfiguration\\(\\) Failed$", err.Error())
+	diags := testResource.UpdateContext(clients.Ctx, resourceData, clients)
+	require.True(t, diags.HasError())
+	require.Regexp(t, ".*UpdatePolicyConfiguration\\(\\) Failed$", diags[0].Summary)
 }
 
 // verifies that DELETE failures are not swallowed
@@ -172,3 +176,343 @@ func TestBranchPolicyCRUD_DeleteError_NotSwallowed(t *testing.T) {
 	err := testResource.Delete(resourceData, clients)
 	require.Regexp(t, ".*DeletePolicyConfiguration\\(\\) Failed$", err.Error())
 }
+
+// verifies that every branch policy resource supports scopes applying to all repositories of the project
+func TestBranchPolicyCRUD_ExpandSettings_ProjectWidePrefixScope(t *testing.T) {
+	resources := map[string]*schema.Resource{
+		"min_reviewers":      ResourceBranchPolicyMinReviewers(),
+		"build_validation":   ResourceBranchPolicyBuildValidation(),
+		"auto_reviewers":     ResourceBranchPolicyAutoReviewers(),
+		"work_item_linking":  ResourceBranchPolicyWorkItemLinking(),
+		"comment_resolution": ResourceBranchPolicyCommentResolution(),
+		"merge_types":        ResourceBranchPolicyMergeTypes(),
+		"status_check":       ResourceBranchPolicyStatusCheck(),
+		"generic":            ResourceBranchPolicyGeneric(),
+	}
+	for name, resource := range resources {
+		t.Run(name, func(t *testing.T) {
+			resourceData := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+				"project_id": projectID,
+				"settings": []interface{}{
+					map[string]interface{}{
+						"scope": []interface{}{
+							map[string]interface{}{
+								"repository_ref": "refs/heads/release/",
+								"match_type":     "Prefix",
+							},
+						},
+					},
+				},
+			})
+
+			settings, err := expandSettings(resourceData)
+			require.Nil(t, err)
+			require.Equal(t, []map[string]interface{}{
+				{
+					"repositoryId": nil,
+					"refName":      "refs/heads/release/",
+					"matchKind":    "Prefix",
+				},
+			}, settings["scope"])
+		})
+	}
+}
+
+// verifies that wildcard refs are rejected in favor of prefix scopes
+func TestBranchPolicyCRUD_ExpandSettings_RejectsWildcardRef(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, testResource.Schema, map[string]interface{}{
+		"project_id": projectID,
+		"settings": []interface{}{
+			map[string]interface{}{
+				"scope": []interface{}{
+					map[string]interface{}{
+						"repository_ref": "refs/heads/release/*",
+						"match_type":     "Exact",
+					},
+				},
+			},
+		},
+	})
+
+	_, err := expandSettings(resourceData)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "match_type=Prefix")
+}
+
+func TestBranchPolicyCRUD_PolicyScopesOverlap(t *testing.T) {
+	projectWide := func(ref string, matchType string) []interface{} {
+		return []interface{}{map[string]interface{}{"repository_id": "", "repository_ref": ref, "match_type": matchType}}
+	}
+	repository := func(ref string, matchType string) []interface{} {
+		return []interface{}{map[string]interface{}{"repository_id": "repo", "repository_ref": ref, "match_type": matchType}}
+	}
+
+	require.True(t, policyScopesOverlap(projectWide("refs/heads/release/", "Prefix"), repository("refs/heads/release/1.0", "Exact")))
+	require.True(t, policyScopesOverlap(repository("refs/heads/release/1.0", "Exact"), projectWide("refs/heads/release/", "Prefix")))
+	require.True(t, policyScopesOverlap(projectWide("refs/heads/release/", "Prefix"), repository("refs/heads/release/hotfix/", "Prefix")))
+	require.True(t, policyScopesOverlap(projectWide("refs/heads/main", "Exact"), repository("refs/heads/Main", "Exact")))
+	require.True(t, policyScopesOverlap(projectWide("", "DefaultBranch"), repository("", "DefaultBranch")))
+
+	require.False(t, policyScopesOverlap(projectWide("refs/heads/release/", "Prefix"), repository("refs/heads/main", "Exact")))
+	require.False(t, policyScopesOverlap(projectWide("refs/heads/main", "Exact"), repository("", "DefaultBranch")))
+	require.False(t, policyScopesOverlap(projectWide("refs/heads/release/", "Prefix"), projectWide("refs/heads/release/1.0", "Exact")))
+	require.False(t, policyScopesOverlap(repository("refs/heads/main", "Exact"), repository("refs/heads/main", "Exact")))
+}
+
+func TestBranchPolicyCRUD_FindOverlappingPolicies(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	policyExtrasClient := azdosdkmocks.NewMockPolicyextrasClient(ctrl)
+	clients := &client.AggregatedClient{PolicyClientExtras: policyExtrasClient, Ctx: context.Background()}
+
+	repositoryScope := func(ref string) map[string]interface{} {
+		return map[string]interface{}{
+			"scope": []interface{}{
+				map[string]interface{}{"repositoryId": "repo", "refName": ref, "matchKind": "Exact"},
+			},
+		}
+	}
+	gomock.InOrder(
+		policyExtrasClient.EXPECT().GetPolicyConfigurations(clients.Ctx, policyextras.GetPolicyConfigurationsArgs{
+			Project:    converter.String(projectID),
+			PolicyType: &MinReviewerCount,
+		}).Return(&policy.GetPolicyConfigurationsResponseValue{
+			Value: []policy.PolicyConfiguration{
+				{Id: converter.Int(2), Settings: repositoryScope("refs/heads/main")},
+				{Id: converter.Int(3), IsDeleted: converter.Bool(true), Settings: repositoryScope("refs/heads/release/2.0")},
+			},
+			ContinuationToken: "page2",
+		}, nil).Times(1),
+		// The overlapping policy is only returned on the second page
+		policyExtrasClient.EXPECT().GetPolicyConfigurations(clients.Ctx, policyextras.GetPolicyConfigurationsArgs{
+			Project:           converter.String(projectID),
+			PolicyType:        &MinReviewerCount,
+			ContinuationToken: converter.String("page2"),
+		}).Return(&policy.GetPolicyConfigurationsResponseValue{
+			Value: []policy.PolicyConfiguration{
+				{Id: converter.Int(1), Settings: repositoryScope("refs/heads/release/1.0")},
+				// The policy itself
+				{Id: converter.Int(4), Settings: repositoryScope("refs/heads/release/3.0")},
+			},
+		}, nil).Times(1),
+	)
+
+	overlapping, err := findOverlappingPolicies(clients, projectID, MinReviewerCount, "4", []interface{}{
+		map[string]interface{}{"repository_id": "", "repository_ref": "refs/heads/release/", "match_type": "Prefix"},
+	})
+	require.Nil(t, err)
+	require.Equal(t, []int{1}, overlapping)
+}
+
+// verifies that an overlapping policy is reported to the user as a warning of the apply
+func TestBranchPolicyCRUD_Create_WarnsAboutOverlappingPolicies(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	policyClient := azdosdkmocks.NewMockPolicyClient(ctrl)
+	policyExtrasClient := azdosdkmocks.NewMockPolicyextrasClient(ctrl)
+	clients := &client.AggregatedClient{PolicyClient: policyClient, PolicyClientExtras: policyExtrasClient, Ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, testResource.Schema, map[string]interface{}{
+		"project_id": projectID,
+		"settings": []interface{}{
+			map[string]interface{}{
+				"scope": []interface{}{
+					map[string]interface{}{"repository_ref": "refs/heads/release/", "match_type": "Prefix"},
+				},
+			},
+		},
+	})
+
+	projectWideScope := map[string]interface{}{"repositoryId": nil, "refName": "refs/heads/release/", "matchKind": "Prefix"}
+	policyClient.EXPECT().
+		CreatePolicyConfiguration(clients.Ctx, gomock.Any()).
+		Return(&policy.PolicyConfiguration{Id: converter.Int(7)}, nil).
+		Times(1)
+	policyClient.EXPECT().
+		GetPolicyConfiguration(clients.Ctx, policy.GetPolicyConfigurationArgs{Project: &projectID, ConfigurationId: converter.Int(7)}).
+		Return(&policy.PolicyConfiguration{Id: converter.Int(7), IsDeleted: converter.Bool(false), Settings: scopeSettings(projectWideScope)}, nil).
+		Times(1)
+	policyExtrasClient.EXPECT().GetPolicyConfigurations(clients.Ctx, policyextras.GetPolicyConfigurationsArgs{
+		Project:    converter.String(projectID),
+		PolicyType: &randomUUID,
+	}).Return(&policy.GetPolicyConfigurationsResponseValue{
+		Value: []policy.PolicyConfiguration{
+			{Id: converter.Int(5), Settings: scopeSettings(map[string]interface{}{"repositoryId": "repo", "refName": "refs/heads/release/1.0", "matchKind": "Exact"})},
+			{Id: converter.Int(7), Settings: scopeSettings(projectWideScope)},
+		},
+	}, nil).Times(1)
+
+	diags := testResource.CreateContext(clients.Ctx, resourceData, clients)
+	require.False(t, diags.HasError())
+	require.Len(t, diags, 1)
+	require.Equal(t, diag.Warning, diags[0].Severity)
+	require.Contains(t, diags[0].Summary, "policy 5")
+	require.Equal(t, "7", resourceData.Id())
+}
+
+func scopeSettings(scopes ...map[string]interface{}) map[string]interface{} {
+	values := make([]interface{}, len(scopes))
+	for i, scope := range scopes {
+		values[i] = scope
+	}
+	return map[string]interface{}{"scope": values}
+}
+
+// verifies that a policy with the same type and scope is adopted instead of creating a duplicate
+func TestBranchPolicyCRUD_Create_AdoptsExistingPolicy(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	policyClient := azdosdkmocks.NewMockPolicyClient(ctrl)
+	policyExtrasClient := azdosdkmocks.NewMockPolicyextrasClient(ctrl)
+	clients := &client.AggregatedClient{PolicyClient: policyClient, PolicyClientExtras: policyExtrasClient, Ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, testResource.Schema, map[string]interface{}{
+		"project_id":     projectID,
+		"adopt_existing": true,
+		"settings": []interface{}{
+			map[string]interface{}{
+				"scope": []interface{}{
+					map[string]interface{}{"repository_id": "repo", "repository_ref": "refs/heads/main"},
+					map[string]interface{}{"match_type": "DefaultBranch"},
+				},
+			},
+		},
+	})
+
+	mainScope := map[string]interface{}{"repositoryId": "REPO", "refName": "refs/heads/main", "matchKind": "exact"}
+	defaultBranchScope := map[string]interface{}{"repositoryId": nil, "refName": nil, "matchKind": "DefaultBranch"}
+	policyExtrasClient.EXPECT().GetPolicyConfigurations(clients.Ctx, policyextras.GetPolicyConfigurationsArgs{
+		Project:    converter.String(projectID),
+		PolicyType: &randomUUID,
+	}).Return(&policy.GetPolicyConfigurationsResponseValue{
+		Value: []policy.PolicyConfiguration{
+			{Id: converter.Int(1), Settings: scopeSettings(mainScope)},
+			{Id: converter.Int(2), IsDeleted: converter.Bool(true), Settings: scopeSettings(defaultBranchScope, mainScope)},
+			{Id: converter.Int(3), Settings: scopeSettings(defaultBranchScope, mainScope)},
+		},
+	}, nil).Times(1)
+	policyClient.EXPECT().
+		UpdatePolicyConfiguration(clients.Ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, args policy.UpdatePolicyConfigurationArgs) (*policy.PolicyConfiguration, error) {
+			require.Equal(t, 3, *args.ConfigurationId)
+			return args.Configuration, nil
+		}).
+		Times(1)
+	policyClient.EXPECT().
+		GetPolicyConfiguration(clients.Ctx, policy.GetPolicyConfigurationArgs{Project: &projectID, ConfigurationId: converter.Int(3)}).
+		Return(&policy.PolicyConfiguration{Id: converter.Int(3), IsDeleted: converter.Bool(false), Settings: scopeSettings(defaultBranchScope, mainScope)}, nil).
+		Times(1)
+
+	// The overlap check finds no policy that applies to all repositories
+	policyExtrasClient.EXPECT().GetPolicyConfigurations(clients.Ctx, gomock.Any()).Return(&policy.GetPolicyConfigurationsResponseValue{
+		Value: []policy.PolicyConfiguration{
+			{Id: converter.Int(1), Settings: scopeSettings(mainScope)},
+		},
+	}, nil).Times(1)
+
+	require.Empty(t, testResource.CreateContext(clients.Ctx, resourceData, clients))
+	require.Equal(t, "3", resourceData.Id())
+}
+
+// verifies that several policies with the same type and scope are not adopted
+func TestBranchPolicyCRUD_FindAdoptablePolicy_Ambiguous(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	policyClient := azdosdkmocks.NewMockPolicyClient(ctrl)
+	policyExtrasClient := azdosdkmocks.NewMockPolicyextrasClient(ctrl)
+	clients := &client.AggregatedClient{PolicyClient: policyClient, PolicyClientExtras: policyExtrasClient, Ctx: context.Background()}
+
+	mainScope := map[string]interface{}{"repositoryId": "repo", "refName": "refs/heads/main", "matchKind": "Exact"}
+	policyExtrasClient.EXPECT().GetPolicyConfigurations(clients.Ctx, gomock.Any()).Return(&policy.GetPolicyConfigurationsResponseValue{
+		Value: []policy.PolicyConfiguration{
+			{Id: converter.Int(1), Settings: scopeSettings(mainScope)},
+			{Id: converter.Int(2), Settings: scopeSettings(mainScope)},
+		},
+	}, nil).Times(1)
+
+	policyID, err := findAdoptablePolicy(clients, projectID, MinReviewerCount, []interface{}{
+		map[string]interface{}{"repository_id": "repo", "repository_ref": "refs/heads/main", "match_type": "Exact"},
+	})
+	require.Nil(t, policyID)
+	require.ErrorContains(t, err, "[1 2]")
+}
+
+// verifies that a policy on a later page of the policy configurations is adopted
+func TestBranchPolicyCRUD_FindAdoptablePolicy_FollowsContinuationToken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	policyExtrasClient := azdosdkmocks.NewMockPolicyextrasClient(ctrl)
+	clients := &client.AggregatedClient{PolicyClientExtras: policyExtrasClient, Ctx: context.Background()}
+
+	gomock.InOrder(
+		policyExtrasClient.EXPECT().GetPolicyConfigurations(clients.Ctx, gomock.Any()).Return(&policy.GetPolicyConfigurationsResponseValue{
+			Value: []policy.PolicyConfiguration{
+				{Id: converter.Int(1), Settings: scopeSettings(map[string]interface{}{"repositoryId": "repo", "refName": "refs/heads/release", "matchKind": "Exact"})},
+			},
+			ContinuationToken: "page2",
+		}, nil).Times(1),
+		policyExtrasClient.EXPECT().GetPolicyConfigurations(clients.Ctx, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, args policyextras.GetPolicyConfigurationsArgs) (*policy.GetPolicyConfigurationsResponseValue, error) {
+				require.Equal(t, "page2", *args.ContinuationToken)
+				return &policy.GetPolicyConfigurationsResponseValue{
+					Value: []policy.PolicyConfiguration{
+						{Id: converter.Int(2), Settings: scopeSettings(map[string]interface{}{"repositoryId": "repo", "refName": "refs/heads/main", "matchKind": "Exact"})},
+					},
+				}, nil
+			}).Times(1),
+	)
+
+	policyID, err := findAdoptablePolicy(clients, projectID, MinReviewerCount, []interface{}{
+		map[string]interface{}{"repository_id": "repo", "repository_ref": "refs/heads/main", "match_type": "Exact"},
+	})
+	require.Nil(t, err)
+	require.Equal(t, 2, *policyID)
+}
+
+// verifies that a policy can be imported by its repository, ref and type
+func TestBranchPolicyCRUD_Import_ByScope(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	policyExtrasClient := azdosdkmocks.NewMockPolicyextrasClient(ctrl)
+	clients := &client.AggregatedClient{PolicyClientExtras: policyExtrasClient, Ctx: context.Background()}
+
+	policyExtrasClient.EXPECT().GetPolicyConfigurations(clients.Ctx, policyextras.GetPolicyConfigurationsArgs{
+		Project:    converter.String(projectID),
+		PolicyType: &randomUUID,
+	}).Return(&policy.GetPolicyConfigurationsResponseValue{
+		Value: []policy.PolicyConfiguration{
+			{Id: converter.Int(1), Settings: scopeSettings(map[string]interface{}{"repositoryId": "repo", "refName": "refs/heads/release", "matchKind": "Prefix"})},
+			{Id: converter.Int(2), Settings: scopeSettings(map[string]interface{}{"repositoryId": "repo", "refName": "refs/heads/main", "matchKind": "Exact"})},
+			{Id: converter.Int(3), Settings: scopeSettings(map[string]interface{}{"refName": "refs/heads/main", "matchKind": "Exact"})},
+		},
+	}, nil).Times(2)
+
+	resourceData := schema.TestResourceDataRaw(t, testResource.Schema, nil)
+	resourceData.SetId(projectID + "/repo/refs/heads/main/" + randomUUID.String())
+	imported, err := testResource.Importer.State(resourceData, clients) //nolint:staticcheck
+	require.Nil(t, err)
+	require.Len(t, imported, 1)
+	require.Equal(t, "2", imported[0].Id())
+	require.Equal(t, projectID, imported[0].Get("project_id"))
+
+	// A repository ID of `*` selects the policy that applies to all repositories
+	resourceData = schema.TestResourceDataRaw(t, testResource.Schema, nil)
+	resourceData.SetId(projectID + "/*/refs/heads/main/" + randomUUID.String())
+	imported, err = testResource.Importer.State(resourceData, clients) //nolint:staticcheck
+	require.Nil(t, err)
+	require.Equal(t, "3", imported[0].Id())
+}
+
+// verifies that the policy type of the import ID must match the policy type of the resource
+func TestBranchPolicyCRUD_Import_ByScope_TypeMismatch(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, testResource.Schema, nil)
+	resourceData.SetId(projectID + "/repo/refs/heads/main/" + MinReviewerCount.String())
+	_, err := testResource.Importer.State(resourceData, &client.AggregatedClient{Ctx: context.Background()}) //nolint:staticcheck
+	require.ErrorContains(t, err, "does not match")
+}
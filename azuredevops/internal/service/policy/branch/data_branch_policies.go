@@ -0,0 +1,253 @@
+package branch
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/policy"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/sdk/policyextras"
+)
+
+// branchPolicyResourceTypes the resource managing a policy type, policies of other types are managed by
+// azuredevops_branch_policy_generic
+var branchPolicyResourceTypes = map[uuid.UUID]string{
+	MinReviewerCount:  "azuredevops_branch_policy_min_reviewers",
+	BuildValidation:   "azuredevops_branch_policy_build_validation",
+	AutoReviewers:     "azuredevops_branch_policy_auto_reviewers",
+	WorkItemLinking:   "azuredevops_branch_policy_work_item_linking",
+	CommentResolution: "azuredevops_branch_policy_comment_resolution",
+	MergeTypes:        "azuredevops_branch_policy_merge_types",
+	StatusCheck:       "azuredevops_branch_policy_status_check",
+}
+
+// DataBranchPolicies schema and implementation for the branch policies of a project
+func DataBranchPolicies() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceBranchPoliciesRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			// Policies that apply to all repositories of the project are included
+			"repository_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			// Policies with an exact scope on the ref or a prefix scope matching the ref are included
+			"repository_ref": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"type_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"policies": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"type_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"blocking": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"settings": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"scope": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"repository_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"repository_ref": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"match_type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"resource_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"import_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceBranchPoliciesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+	projectID := d.Get("project_id").(string)
+	repoID := d.Get("repository_id").(string)
+	repoRef := d.Get("repository_ref").(string)
+	typeID := d.Get("type_id").(string)
+
+	args := policyextras.GetPolicyConfigurationsArgs{
+		Project: converter.String(projectID),
+	}
+	if typeID != "" {
+		policyType := uuid.MustParse(typeID)
+		args.PolicyType = &policyType
+	}
+
+	configs, err := policyextras.ListPolicyConfigurations(clients.Ctx, clients.PolicyClientExtras, args)
+	if err != nil {
+		return diag.Errorf(" Listing policy configurations of project %s. Error: %+v", projectID, err)
+	}
+
+	policies := make([]interface{}, 0)
+	for _, config := range configs {
+		if converter.ToBool(config.IsDeleted, false) || config.Id == nil || config.Type == nil || config.Type.Id == nil {
+			continue
+		}
+
+		settings, scopes, err := flattenBranchPolicySettings(&config)
+		if err != nil {
+			return diag.Errorf(" Flattening policy configuration %d. Error: %+v", *config.Id, err)
+		}
+		// Policies without branch scopes, e.g. repository policies, are not branch policies
+		if scopes == nil || !branchPolicyScopesMatch(scopes, repoID, repoRef) {
+			continue
+		}
+
+		resourceType, ok := branchPolicyResourceTypes[*config.Type.Id]
+		if !ok {
+			resourceType = "azuredevops_branch_policy_generic"
+		}
+		policies = append(policies, map[string]interface{}{
+			"id":            *config.Id,
+			"type_id":       config.Type.Id.String(),
+			"type":          converter.ToString(config.Type.DisplayName, ""),
+			"enabled":       converter.ToBool(config.IsEnabled, false),
+			"blocking":      converter.ToBool(config.IsBlocking, false),
+			"settings":      settings,
+			"scope":         scopes,
+			"resource_type": resourceType,
+			"import_id":     fmt.Sprintf("%s/%d", projectID, *config.Id),
+		})
+	}
+
+	d.SetId(fmt.Sprintf("branchPolicies#%s/%s:%s:%s", projectID, repoID, repoRef, typeID))
+	if err := d.Set("policies", policies); err != nil {
+		return diag.Errorf(" Setting policies. Error: %+v", err)
+	}
+	return nil
+}
+
+// flattenBranchPolicySettings returns the settings without the scope as JSON, and the branch scopes of the policy.
+// The scopes are nil if the policy has no branch scope.
+func flattenBranchPolicySettings(config *policy.PolicyConfiguration) (string, []interface{}, error) {
+	settingsJSON, err := json.Marshal(config.Settings)
+	if err != nil {
+		return "", nil, fmt.Errorf("Unable to marshal policy settings into JSON: %+v", err)
+	}
+	settings := map[string]interface{}{}
+	if err := json.Unmarshal(settingsJSON, &settings); err != nil {
+		return "", nil, fmt.Errorf("Unable to unmarshal policy settings. Error: %+v", err)
+	}
+
+	var scopes []interface{}
+	if rawScopes, ok := settings["scope"].([]interface{}); ok {
+		for _, rawScope := range rawScopes {
+			scope, ok := rawScope.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			matchType, _ := scope["matchKind"].(string)
+			if matchType == "" {
+				continue
+			}
+			repositoryID, _ := scope["repositoryId"].(string)
+			repositoryRef, _ := scope["refName"].(string)
+			scopes = append(scopes, map[string]interface{}{
+				"repository_id":  repositoryID,
+				"repository_ref": repositoryRef,
+				"match_type":     matchType,
+			})
+		}
+	}
+
+	delete(settings, "scope")
+	settingsJSON, err = json.Marshal(settings)
+	if err != nil {
+		return "", nil, fmt.Errorf("Unable to marshal policy settings into JSON: %+v", err)
+	}
+	return string(settingsJSON), scopes, nil
+}
+
+// branchPolicyScopesMatch reports whether any of the scopes applies to the repository and ref. An empty repository
+// ID or ref matches all scopes.
+func branchPolicyScopesMatch(scopes []interface{}, repoID string, repoRef string) bool {
+	for _, s := range scopes {
+		scope := s.(map[string]interface{})
+		scopeRepoID := scope["repository_id"].(string)
+		if repoID != "" && scopeRepoID != "" && !strings.EqualFold(scopeRepoID, repoID) {
+			continue
+		}
+		if repoRef != "" {
+			scopeRef := scope["repository_ref"].(string)
+			switch strings.ToLower(scope["match_type"].(string)) {
+			case "exact":
+				if !strings.EqualFold(scopeRef, repoRef) {
+					continue
+				}
+			case "prefix":
+				if !strings.HasPrefix(strings.ToLower(repoRef), strings.ToLower(scopeRef)) {
+					continue
+				}
+			default:
+				continue
+			}
+		}
+		return true
+	}
+	return false
+}
@@ -0,0 +1,236 @@
+//go:build (all || data_branch_policies) && !exclude_data_branch_policies
+// +build all data_branch_policies
+// +build !exclude_data_branch_policies
+
+package branch
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/policy"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/sdk/policyextras"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func testBranchPolicyConfigurations(repoID string, otherRepoID string) *policy.GetPolicyConfigurationsResponseValue {
+	extensionType := uuid.New()
+	return &policy.GetPolicyConfigurationsResponseValue{
+		Value: []policy.PolicyConfiguration{
+			{
+				Id:         converter.Int(1),
+				IsEnabled:  converter.Bool(true),
+				IsBlocking: converter.Bool(true),
+				Type:       &policy.PolicyTypeRef{Id: &MinReviewerCount, DisplayName: converter.String("Minimum number of reviewers")},
+				Settings: map[string]interface{}{
+					"minimumApproverCount": 2,
+					"scope": []interface{}{
+						map[string]interface{}{"repositoryId": repoID, "refName": "refs/heads/main", "matchKind": "Exact"},
+					},
+				},
+			},
+			{
+				// Applies to all repositories of the project
+				Id:        converter.Int(2),
+				IsEnabled: converter.Bool(true),
+				Type:      &policy.PolicyTypeRef{Id: &extensionType},
+				Settings: map[string]interface{}{
+					"scope": []interface{}{
+						map[string]interface{}{"repositoryId": nil, "refName": "refs/heads/release/", "matchKind": "Prefix"},
+					},
+				},
+			},
+			{
+				Id:   converter.Int(3),
+				Type: &policy.PolicyTypeRef{Id: &BuildValidation},
+				Settings: map[string]interface{}{
+					"scope": []interface{}{
+						map[string]interface{}{"repositoryId": otherRepoID, "refName": "refs/heads/main", "matchKind": "Exact"},
+					},
+				},
+			},
+			{
+				// Repository policies have no branch scope
+				Id:   converter.Int(4),
+				Type: &policy.PolicyTypeRef{Id: &extensionType},
+				Settings: map[string]interface{}{
+					"scope": []interface{}{map[string]interface{}{"repositoryId": repoID}},
+				},
+			},
+			{
+				Id:        converter.Int(5),
+				IsDeleted: converter.Bool(true),
+				Type:      &policy.PolicyTypeRef{Id: &MinReviewerCount},
+				Settings: map[string]interface{}{
+					"scope": []interface{}{
+						map[string]interface{}{"repositoryId": repoID, "refName": "refs/heads/main", "matchKind": "Exact"},
+					},
+				},
+			},
+		},
+	}
+}
+
+func readTestBranchPolicies(t *testing.T, values map[string]interface{}, response *policy.GetPolicyConfigurationsResponseValue) []interface{} {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	policyClient := azdosdkmocks.NewMockPolicyextrasClient(ctrl)
+	clients := &client.AggregatedClient{PolicyClientExtras: policyClient, Ctx: context.Background()}
+	policyClient.EXPECT().GetPolicyConfigurations(clients.Ctx, gomock.Any()).Return(response, nil).Times(1)
+
+	d := schema.TestResourceDataRaw(t, DataBranchPolicies().Schema, values)
+	diags := dataSourceBranchPoliciesRead(context.Background(), d, clients)
+	require.False(t, diags.HasError())
+	return d.Get("policies").([]interface{})
+}
+
+func policyIDs(policies []interface{}) []int {
+	ids := []int{}
+	for _, p := range policies {
+		ids = append(ids, p.(map[string]interface{})["id"].(int))
+	}
+	return ids
+}
+
+func TestDataBranchPolicies_Read_ReturnsBranchPoliciesOnly(t *testing.T) {
+	projectID := uuid.NewString()
+	repoID := uuid.NewString()
+
+	policies := readTestBranchPolicies(t, map[string]interface{}{
+		"project_id": projectID,
+	}, testBranchPolicyConfigurations(repoID, uuid.NewString()))
+	require.Equal(t, []int{1, 2, 3}, policyIDs(policies))
+
+	minReviewers := policies[0].(map[string]interface{})
+	require.Equal(t, MinReviewerCount.String(), minReviewers["type_id"])
+	require.Equal(t, "Minimum number of reviewers", minReviewers["type"])
+	require.True(t, minReviewers["enabled"].(bool))
+	require.True(t, minReviewers["blocking"].(bool))
+	require.Equal(t, "azuredevops_branch_policy_min_reviewers", minReviewers["resource_type"])
+	require.Equal(t, projectID+"/1", minReviewers["import_id"])
+
+	settings := map[string]interface{}{}
+	require.Nil(t, json.Unmarshal([]byte(minReviewers["settings"].(string)), &settings))
+	require.Equal(t, map[string]interface{}{"minimumApproverCount": float64(2)}, settings)
+
+	scope := minReviewers["scope"].([]interface{})[0].(map[string]interface{})
+	require.Equal(t, repoID, scope["repository_id"])
+	require.Equal(t, "refs/heads/main", scope["repository_ref"])
+	require.Equal(t, "Exact", scope["match_type"])
+
+	require.Equal(t, "azuredevops_branch_policy_generic", policies[1].(map[string]interface{})["resource_type"])
+	require.Equal(t, "azuredevops_branch_policy_build_validation", policies[2].(map[string]interface{})["resource_type"])
+}
+
+func TestDataBranchPolicies_Read_FiltersByRepository(t *testing.T) {
+	repoID := uuid.NewString()
+
+	policies := readTestBranchPolicies(t, map[string]interface{}{
+		"project_id":    uuid.NewString(),
+		"repository_id": repoID,
+	}, testBranchPolicyConfigurations(repoID, uuid.NewString()))
+	require.Equal(t, []int{1, 2}, policyIDs(policies))
+}
+
+func TestDataBranchPolicies_Read_FiltersByRef(t *testing.T) {
+	repoID := uuid.NewString()
+
+	policies := readTestBranchPolicies(t, map[string]interface{}{
+		"project_id":     uuid.NewString(),
+		"repository_id":  repoID,
+		"repository_ref": "refs/heads/release/1.0",
+	}, testBranchPolicyConfigurations(repoID, uuid.NewString()))
+	require.Equal(t, []int{2}, policyIDs(policies))
+
+	policies = readTestBranchPolicies(t, map[string]interface{}{
+		"project_id":     uuid.NewString(),
+		"repository_ref": "refs/heads/main",
+	}, testBranchPolicyConfigurations(repoID, uuid.NewString()))
+	require.Equal(t, []int{1, 3}, policyIDs(policies))
+}
+
+func TestDataBranchPolicies_Read_PassesTypeFilter(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	policyClient := azdosdkmocks.NewMockPolicyextrasClient(ctrl)
+	clients := &client.AggregatedClient{PolicyClientExtras: policyClient, Ctx: context.Background()}
+
+	projectID := uuid.NewString()
+	policyClient.EXPECT().GetPolicyConfigurations(clients.Ctx, policyextras.GetPolicyConfigurationsArgs{
+		Project:    converter.String(projectID),
+		PolicyType: &BuildValidation,
+	}).Return(&policy.GetPolicyConfigurationsResponseValue{}, nil).Times(1)
+
+	d := schema.TestResourceDataRaw(t, DataBranchPolicies().Schema, map[string]interface{}{
+		"project_id": projectID,
+		"type_id":    BuildValidation.String(),
+	})
+	diags := dataSourceBranchPoliciesRead(context.Background(), d, clients)
+	require.False(t, diags.HasError())
+	require.Empty(t, d.Get("policies").([]interface{}))
+}
+
+func TestDataBranchPolicies_Read_FollowsContinuationToken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	policyClient := azdosdkmocks.NewMockPolicyextrasClient(ctrl)
+	clients := &client.AggregatedClient{PolicyClientExtras: policyClient, Ctx: context.Background()}
+
+	repoID := uuid.NewString()
+	firstPage := testBranchPolicyConfigurations(repoID, uuid.NewString())
+	firstPage.ContinuationToken = "page2"
+	gomock.InOrder(
+		policyClient.EXPECT().GetPolicyConfigurations(clients.Ctx, gomock.Any()).Return(firstPage, nil).Times(1),
+		policyClient.EXPECT().GetPolicyConfigurations(clients.Ctx, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, args policyextras.GetPolicyConfigurationsArgs) (*policy.GetPolicyConfigurationsResponseValue, error) {
+				require.Equal(t, "page2", *args.ContinuationToken)
+				return &policy.GetPolicyConfigurationsResponseValue{
+					Value: []policy.PolicyConfiguration{
+						{
+							Id:   converter.Int(42),
+							Type: &policy.PolicyTypeRef{Id: &MinReviewerCount},
+							Settings: map[string]interface{}{
+								"scope": []interface{}{
+									map[string]interface{}{"repositoryId": repoID, "refName": "refs/heads/main", "matchKind": "Exact"},
+								},
+							},
+						},
+					},
+				}, nil
+			}).Times(1),
+	)
+
+	d := schema.TestResourceDataRaw(t, DataBranchPolicies().Schema, map[string]interface{}{
+		"project_id":    uuid.NewString(),
+		"repository_id": repoID,
+	})
+	require.False(t, dataSourceBranchPoliciesRead(context.Background(), d, clients).HasError())
+	require.Contains(t, policyIDs(d.Get("policies").([]interface{})), 42)
+}
+
+func TestDataBranchPolicies_Read_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	policyClient := azdosdkmocks.NewMockPolicyextrasClient(ctrl)
+	clients := &client.AggregatedClient{PolicyClientExtras: policyClient, Ctx: context.Background()}
+	policyClient.EXPECT().GetPolicyConfigurations(clients.Ctx, gomock.Any()).Return(nil, errors.New("GetPolicyConfigurations() Failed")).Times(1)
+
+	d := schema.TestResourceDataRaw(t, DataBranchPolicies().Schema, map[string]interface{}{
+		"project_id": uuid.NewString(),
+	})
+	diags := dataSourceBranchPoliciesRead(context.Background(), d, clients)
+	require.True(t, diags.HasError())
+	require.Contains(t, diags[0].Summary, "GetPolicyConfigurations() Failed")
+}
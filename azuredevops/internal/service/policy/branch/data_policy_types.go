@@ -0,0 +1,83 @@
+package branch
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/policy"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+)
+
+// DataPolicyTypes schema and implementation for the policy types available in a project
+func DataPolicyTypes() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourcePolicyTypesRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"policy_types": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourcePolicyTypesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+	projectID := d.Get("project_id").(string)
+
+	policyTypes, err := clients.PolicyClient.GetPolicyTypes(clients.Ctx, policy.GetPolicyTypesArgs{
+		Project: converter.String(projectID),
+	})
+	if err != nil {
+		return diag.Errorf(" Listing policy types of project %s. Error: %+v", projectID, err)
+	}
+
+	results := make([]interface{}, 0)
+	if policyTypes != nil {
+		for _, policyType := range *policyTypes {
+			if policyType.Id == nil {
+				continue
+			}
+			results = append(results, map[string]interface{}{
+				"id":          policyType.Id.String(),
+				"name":        converter.ToString(policyType.DisplayName, ""),
+				"description": converter.ToString(policyType.Description, ""),
+			})
+		}
+	}
+
+	d.SetId(fmt.Sprintf("policyTypes#%s", projectID))
+	if err := d.Set("policy_types", results); err != nil {
+		return diag.Errorf(" Setting policy types. Error: %+v", err)
+	}
+	return nil
+}
@@ -0,0 +1,75 @@
+//go:build (all || data_policy_types) && !exclude_data_policy_types
+// +build all data_policy_types
+// +build !exclude_data_policy_types
+
+package branch
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/policy"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestDataPolicyTypes_Read_ListsTypes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	policyClient := azdosdkmocks.NewMockPolicyClient(ctrl)
+	clients := &client.AggregatedClient{PolicyClient: policyClient, Ctx: context.Background()}
+
+	projectID := uuid.NewString()
+	policyClient.EXPECT().GetPolicyTypes(clients.Ctx, policy.GetPolicyTypesArgs{
+		Project: converter.String(projectID),
+	}).Return(&[]policy.PolicyType{
+		{
+			Id:          &MinReviewerCount,
+			DisplayName: converter.String("Minimum number of reviewers"),
+			Description: converter.String("This policy will ensure that a minimum number of reviewers have approved a pull request before completion."),
+		},
+		{
+			Id:          &BuildValidation,
+			DisplayName: converter.String("Build"),
+		},
+	}, nil).Times(1)
+
+	d := schema.TestResourceDataRaw(t, DataPolicyTypes().Schema, map[string]interface{}{
+		"project_id": projectID,
+	})
+	diags := dataSourcePolicyTypesRead(context.Background(), d, clients)
+	require.False(t, diags.HasError())
+	require.Equal(t, "policyTypes#"+projectID, d.Id())
+
+	policyTypes := d.Get("policy_types").([]interface{})
+	require.Len(t, policyTypes, 2)
+	require.Equal(t, map[string]interface{}{
+		"id":          MinReviewerCount.String(),
+		"name":        "Minimum number of reviewers",
+		"description": "This policy will ensure that a minimum number of reviewers have approved a pull request before completion.",
+	}, policyTypes[0])
+	require.Equal(t, "", policyTypes[1].(map[string]interface{})["description"])
+}
+
+func TestDataPolicyTypes_Read_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	policyClient := azdosdkmocks.NewMockPolicyClient(ctrl)
+	clients := &client.AggregatedClient{PolicyClient: policyClient, Ctx: context.Background()}
+	policyClient.EXPECT().GetPolicyTypes(clients.Ctx, gomock.Any()).Return(nil, errors.New("GetPolicyTypes() Failed")).Times(1)
+
+	d := schema.TestResourceDataRaw(t, DataPolicyTypes().Schema, map[string]interface{}{
+		"project_id": uuid.NewString(),
+	})
+	diags := dataSourcePolicyTypesRead(context.Background(), d, clients)
+	require.True(t, diags.HasError())
+	require.Contains(t, diags[0].Summary, "GetPolicyTypes() Failed")
+}
@@ -0,0 +1,192 @@
+package branch
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/policy"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+)
+
+// policyEvaluationsPageSize the number of policy evaluation records retrieved per request
+const policyEvaluationsPageSize = 100
+
+// DataPullRequestPolicyEvaluations schema and implementation for the policy evaluations of a pull request
+func DataPullRequestPolicyEvaluations() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourcePullRequestPolicyEvaluationsRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"pull_request_id": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"include_not_applicable": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"evaluations": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"evaluation_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"configuration_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"type_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"blocking": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"context": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"started_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"completed_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourcePullRequestPolicyEvaluationsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+	projectID := d.Get("project_id").(string)
+	pullRequestID := d.Get("pull_request_id").(int)
+
+	records, err := getPullRequestPolicyEvaluations(clients, projectID, pullRequestID, d.Get("include_not_applicable").(bool))
+	if err != nil {
+		return diag.Errorf(" Listing policy evaluations of pull request %d. Error: %+v", pullRequestID, err)
+	}
+
+	evaluations := make([]interface{}, 0, len(records))
+	for _, record := range records {
+		evaluation, err := flattenPolicyEvaluation(&record)
+		if err != nil {
+			return diag.Errorf(" Flattening policy evaluations of pull request %d. Error: %+v", pullRequestID, err)
+		}
+		evaluations = append(evaluations, evaluation)
+	}
+
+	d.SetId(fmt.Sprintf("policyEvaluations#%s/%d", projectID, pullRequestID))
+	if err := d.Set("evaluations", evaluations); err != nil {
+		return diag.Errorf(" Setting policy evaluations. Error: %+v", err)
+	}
+	return nil
+}
+
+func getPullRequestPolicyEvaluations(clients *client.AggregatedClient, projectID string, pullRequestID int, includeNotApplicable bool) ([]policy.PolicyEvaluationRecord, error) {
+	// The policy evaluations of a pull request are identified by the code review artifact of the pull request
+	artifactID := fmt.Sprintf("vstfs:///CodeReview/CodeReviewId/%s/%d", projectID, pullRequestID)
+
+	var records []policy.PolicyEvaluationRecord
+	for skip := 0; ; skip += policyEvaluationsPageSize {
+		page, err := clients.PolicyClient.GetPolicyEvaluations(clients.Ctx, policy.GetPolicyEvaluationsArgs{
+			Project:              converter.String(projectID),
+			ArtifactId:           converter.String(artifactID),
+			IncludeNotApplicable: converter.Bool(includeNotApplicable),
+			Top:                  converter.Int(policyEvaluationsPageSize),
+			Skip:                 converter.Int(skip),
+		})
+		if err != nil {
+			return nil, err
+		}
+		if page == nil {
+			break
+		}
+		records = append(records, *page...)
+		if len(*page) < policyEvaluationsPageSize {
+			break
+		}
+	}
+	return records, nil
+}
+
+func flattenPolicyEvaluation(record *policy.PolicyEvaluationRecord) (map[string]interface{}, error) {
+	evaluation := map[string]interface{}{
+		"evaluation_id":  "",
+		"status":         "",
+		"context":        "",
+		"started_date":   formatPolicyEvaluationDate(record.StartedDate),
+		"completed_date": formatPolicyEvaluationDate(record.CompletedDate),
+	}
+	if record.EvaluationId != nil {
+		evaluation["evaluation_id"] = record.EvaluationId.String()
+	}
+	if record.Status != nil {
+		evaluation["status"] = string(*record.Status)
+	}
+	if record.Context != nil {
+		contextJSON, err := json.Marshal(record.Context)
+		if err != nil {
+			return nil, fmt.Errorf("Unable to marshal policy evaluation context into JSON: %+v", err)
+		}
+		evaluation["context"] = string(contextJSON)
+	}
+
+	if config := record.Configuration; config != nil {
+		if config.Id != nil {
+			evaluation["configuration_id"] = *config.Id
+		}
+		evaluation["enabled"] = converter.ToBool(config.IsEnabled, false)
+		evaluation["blocking"] = converter.ToBool(config.IsBlocking, false)
+		if config.Type != nil {
+			if config.Type.Id != nil {
+				evaluation["type_id"] = config.Type.Id.String()
+			}
+			evaluation["type"] = converter.ToString(config.Type.DisplayName, "")
+		}
+	}
+	return evaluation, nil
+}
+
+func formatPolicyEvaluationDate(date *azuredevops.Time) string {
+	if date == nil {
+		return ""
+	}
+	return date.Time.Format(time.RFC3339)
+}
@@ -0,0 +1,136 @@
+//go:build (all || data_pull_request_policy_evaluations) && !exclude_data_pull_request_policy_evaluations
+// +build all data_pull_request_policy_evaluations
+// +build !exclude_data_pull_request_policy_evaluations
+
+package branch
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/policy"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestDataPullRequestPolicyEvaluations_Read_FlattensEvaluations(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	policyClient := azdosdkmocks.NewMockPolicyClient(ctrl)
+	clients := &client.AggregatedClient{PolicyClient: policyClient, Ctx: context.Background()}
+
+	projectID := uuid.NewString()
+	evaluationID := uuid.New()
+	startedDate := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	policyClient.EXPECT().GetPolicyEvaluations(clients.Ctx, policy.GetPolicyEvaluationsArgs{
+		Project:              converter.String(projectID),
+		ArtifactId:           converter.String("vstfs:///CodeReview/CodeReviewId/" + projectID + "/42"),
+		IncludeNotApplicable: converter.Bool(true),
+		Top:                  converter.Int(policyEvaluationsPageSize),
+		Skip:                 converter.Int(0),
+	}).Return(&[]policy.PolicyEvaluationRecord{
+		{
+			EvaluationId: &evaluationID,
+			Status:       &policy.PolicyEvaluationStatusValues.Rejected,
+			StartedDate:  &azuredevops.Time{Time: startedDate},
+			Context:      map[string]interface{}{"buildId": 7},
+			Configuration: &policy.PolicyConfiguration{
+				Id:         converter.Int(3),
+				IsEnabled:  converter.Bool(true),
+				IsBlocking: converter.Bool(true),
+				Type:       &policy.PolicyTypeRef{Id: &BuildValidation, DisplayName: converter.String("Build")},
+			},
+		},
+		{
+			Status:        &policy.PolicyEvaluationStatusValues.NotApplicable,
+			Configuration: &policy.PolicyConfiguration{Id: converter.Int(4)},
+		},
+	}, nil).Times(1)
+
+	d := schema.TestResourceDataRaw(t, DataPullRequestPolicyEvaluations().Schema, map[string]interface{}{
+		"project_id":             projectID,
+		"pull_request_id":        42,
+		"include_not_applicable": true,
+	})
+	diags := dataSourcePullRequestPolicyEvaluationsRead(context.Background(), d, clients)
+	require.False(t, diags.HasError())
+	require.Equal(t, "policyEvaluations#"+projectID+"/42", d.Id())
+
+	evaluations := d.Get("evaluations").([]interface{})
+	require.Len(t, evaluations, 2)
+	require.Equal(t, map[string]interface{}{
+		"evaluation_id":    evaluationID.String(),
+		"configuration_id": 3,
+		"type_id":          BuildValidation.String(),
+		"type":             "Build",
+		"enabled":          true,
+		"blocking":         true,
+		"status":           "rejected",
+		"context":          `{"buildId":7}`,
+		"started_date":     "2024-01-02T03:04:05Z",
+		"completed_date":   "",
+	}, evaluations[0])
+	require.Equal(t, "notApplicable", evaluations[1].(map[string]interface{})["status"])
+	require.Equal(t, 4, evaluations[1].(map[string]interface{})["configuration_id"])
+}
+
+func TestDataPullRequestPolicyEvaluations_Read_ReadsAllPages(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	policyClient := azdosdkmocks.NewMockPolicyClient(ctrl)
+	clients := &client.AggregatedClient{PolicyClient: policyClient, Ctx: context.Background()}
+
+	fullPage := make([]policy.PolicyEvaluationRecord, policyEvaluationsPageSize)
+	for i := range fullPage {
+		fullPage[i] = policy.PolicyEvaluationRecord{Status: &policy.PolicyEvaluationStatusValues.Approved}
+	}
+	gomock.InOrder(
+		policyClient.EXPECT().GetPolicyEvaluations(clients.Ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, args policy.GetPolicyEvaluationsArgs) (*[]policy.PolicyEvaluationRecord, error) {
+				require.Equal(t, 0, *args.Skip)
+				require.False(t, *args.IncludeNotApplicable)
+				return &fullPage, nil
+			}),
+		policyClient.EXPECT().GetPolicyEvaluations(clients.Ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, args policy.GetPolicyEvaluationsArgs) (*[]policy.PolicyEvaluationRecord, error) {
+				require.Equal(t, policyEvaluationsPageSize, *args.Skip)
+				return &[]policy.PolicyEvaluationRecord{{Status: &policy.PolicyEvaluationStatusValues.Queued}}, nil
+			}),
+	)
+
+	d := schema.TestResourceDataRaw(t, DataPullRequestPolicyEvaluations().Schema, map[string]interface{}{
+		"project_id":      uuid.NewString(),
+		"pull_request_id": 1,
+	})
+	diags := dataSourcePullRequestPolicyEvaluationsRead(context.Background(), d, clients)
+	require.False(t, diags.HasError())
+	require.Len(t, d.Get("evaluations").([]interface{}), policyEvaluationsPageSize+1)
+}
+
+func TestDataPullRequestPolicyEvaluations_Read_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	policyClient := azdosdkmocks.NewMockPolicyClient(ctrl)
+	clients := &client.AggregatedClient{PolicyClient: policyClient, Ctx: context.Background()}
+	policyClient.EXPECT().GetPolicyEvaluations(clients.Ctx, gomock.Any()).Return(nil, errors.New("GetPolicyEvaluations() Failed")).Times(1)
+
+	d := schema.TestResourceDataRaw(t, DataPullRequestPolicyEvaluations().Schema, map[string]interface{}{
+		"project_id":      uuid.NewString(),
+		"pull_request_id": 1,
+	})
+	diags := dataSourcePullRequestPolicyEvaluationsRead(context.Background(), d, clients)
+	require.True(t, diags.HasError())
+	require.Contains(t, diags[0].Summary, "GetPolicyEvaluations() Failed")
+}
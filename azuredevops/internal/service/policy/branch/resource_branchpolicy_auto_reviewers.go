@@ -1,14 +1,19 @@
 package branch
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"maps"
+	"strings"
 
 	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/graph"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/policy"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
 )
 
 type autoReviewerPolicySettings struct {
@@ -29,14 +34,39 @@ func ResourceBranchPolicyAutoReviewers() *schema.Resource {
 	settingsSchema := resource.Schema["settings"].Elem.(*schema.Resource).Schema
 	maps.Copy(settingsSchema, map[string]*schema.Schema{
 		"auto_reviewer_ids": {
-			Type:     schema.TypeList,
-			Required: true,
+			Type:         schema.TypeList,
+			Optional:     true,
+			AtLeastOneOf: []string{"settings.0.auto_reviewer_ids", "settings.0.reviewer_group"},
 			Elem: &schema.Schema{
 				Type:         schema.TypeString,
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 		},
 
+		// Groups resolved by name when the policy is created or updated
+		"reviewer_group": {
+			Type:         schema.TypeList,
+			Optional:     true,
+			AtLeastOneOf: []string{"settings.0.auto_reviewer_ids", "settings.0.reviewer_group"},
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotWhiteSpace,
+					},
+					"descriptor": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"id": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+
 		"path_filters": {
 			Type:     schema.TypeList,
 			Optional: true,
@@ -65,10 +95,29 @@ func ResourceBranchPolicyAutoReviewers() *schema.Resource {
 			ValidateFunc: validation.IntAtLeast(1),
 		},
 	})
+
+	create, update := resource.CreateContext, resource.UpdateContext
+	resource.CreateContext = func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		if err := resolveReviewerGroups(d, m.(*client.AggregatedClient)); err != nil {
+			return diag.FromErr(err)
+		}
+		return create(ctx, d, m)
+	}
+	resource.UpdateContext = func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		if err := resolveReviewerGroups(d, m.(*client.AggregatedClient)); err != nil {
+			return diag.FromErr(err)
+		}
+		return update(ctx, d, m)
+	}
 	return resource
 }
 
 func autoReviewersFlattenFunc(d *schema.ResourceData, policyConfig *policy.PolicyConfiguration, projectID *string) error {
+	// The configured settings are read before the settings are overwritten
+	configuredReviewerIds, _ := d.Get("settings.0.auto_reviewer_ids").([]interface{})
+	configuredPathFilters, _ := d.Get("settings.0.path_filters").([]interface{})
+	configuredGroups, _ := d.Get("settings.0.reviewer_group").([]interface{})
+
 	err := baseFlattenFunc(d, policyConfig, projectID)
 	if err != nil {
 		return err
@@ -87,9 +136,13 @@ func autoReviewersFlattenFunc(d *schema.ResourceData, policyConfig *policy.Polic
 	settingsList := d.Get("settings").([]interface{})
 	settings := settingsList[0].(map[string]interface{})
 
+	// The reviewers resolved from a configured group are tracked by the group, the other reviewers by ID
+	reviewerGroups, reviewerIds := flattenReviewerGroups(configuredGroups, policySettings.AutoReviewerIds)
+
 	settings["submitter_can_vote"] = policySettings.SubmitterCanVote
-	settings["auto_reviewer_ids"] = policySettings.AutoReviewerIds
-	settings["path_filters"] = policySettings.PathFilters
+	settings["auto_reviewer_ids"] = flattenStringsInConfiguredOrder(configuredReviewerIds, reviewerIds)
+	settings["reviewer_group"] = reviewerGroups
+	settings["path_filters"] = flattenStringsInConfiguredOrder(configuredPathFilters, policySettings.PathFilters)
 	settings["message"] = policySettings.DisplayMessage
 	settings["minimum_number_of_reviewers"] = policySettings.MinimumApproverCount
 	_ = d.Set("settings", settingsList)
@@ -115,6 +168,14 @@ func autoReviewersExpandFunc(d *schema.ResourceData, typeID uuid.UUID) (*policy.
 		for _, item := range value.([]interface{}) {
 			reviewersID = append(reviewersID, item.(string))
 		}
+		if groups, ok := settings["reviewer_group"].([]interface{}); ok {
+			for _, group := range groups {
+				// The groups are resolved when the policy is created or updated
+				if groupID, _ := group.(map[string]interface{})["id"].(string); groupID != "" {
+					reviewersID = append(reviewersID, groupID)
+				}
+			}
+		}
 		policySettings["requiredReviewerIds"] = reviewersID
 	}
 
@@ -128,3 +189,134 @@ func autoReviewersExpandFunc(d *schema.ResourceData, typeID uuid.UUID) (*policy.
 
 	return policyConfig, projectID, nil
 }
+
+// flattenReviewerGroups returns the configured reviewer groups that are reviewers of the policy, and the other reviewers
+func flattenReviewerGroups(configuredGroups []interface{}, reviewerIds []string) ([]interface{}, []string) {
+	remaining := map[string]bool{}
+	for _, id := range reviewerIds {
+		remaining[strings.ToLower(id)] = true
+	}
+
+	groups := []interface{}{}
+	for _, g := range configuredGroups {
+		group, ok := g.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		groupID, _ := group["id"].(string)
+		if groupID == "" || !remaining[strings.ToLower(groupID)] {
+			continue
+		}
+		delete(remaining, strings.ToLower(groupID))
+		groups = append(groups, group)
+	}
+
+	ids := []string{}
+	for _, id := range reviewerIds {
+		if remaining[strings.ToLower(id)] {
+			ids = append(ids, id)
+		}
+	}
+	return groups, ids
+}
+
+// resolveReviewerGroups resolves the descriptor and the identity ID of the reviewer groups by name. Groups of the
+// project take precedence over groups of the organization.
+func resolveReviewerGroups(d *schema.ResourceData, clients *client.AggregatedClient) error {
+	groups, _ := d.Get("settings.0.reviewer_group").([]interface{})
+	if len(groups) == 0 {
+		return nil
+	}
+
+	// The minimum applies to the policy, not to each reviewer group. Policies that only configure
+	// `auto_reviewer_ids` are left to the service to validate.
+	reviewerIds, _ := d.Get("settings.0.auto_reviewer_ids").([]interface{})
+	if len(groups)+len(reviewerIds) > 1 && d.Get("settings.0.minimum_number_of_reviewers").(int) > 1 {
+		return fmt.Errorf("'minimum_number_of_reviewers' can only be greater than 1 if the policy has exactly one reviewer group, create a policy for each reviewer group instead")
+	}
+
+	projectID := d.Get("project_id").(string)
+	projectUUID, err := uuid.Parse(projectID)
+	if err != nil {
+		return fmt.Errorf("parsing project ID %s: %+v", projectID, err)
+	}
+	projectDescriptor, err := clients.GraphClient.GetDescriptor(clients.Ctx, graph.GetDescriptorArgs{StorageKey: &projectUUID})
+	if err != nil {
+		return fmt.Errorf("getting descriptor of project %s: %+v", projectID, err)
+	}
+
+	var projectGroups, organizationGroups []graph.GraphGroup
+	for _, g := range groups {
+		group := g.(map[string]interface{})
+		name := group["name"].(string)
+
+		if projectGroups == nil {
+			if projectGroups, err = listReviewerGroups(clients, projectDescriptor.Value); err != nil {
+				return fmt.Errorf("listing groups of project %s: %+v", projectID, err)
+			}
+		}
+		match := selectReviewerGroup(projectGroups, name)
+		if match == nil {
+			if organizationGroups == nil {
+				if organizationGroups, err = listReviewerGroups(clients, nil); err != nil {
+					return fmt.Errorf("listing groups of the organization: %+v", err)
+				}
+			}
+			match = selectReviewerGroup(organizationGroups, name)
+		}
+		if match == nil || match.Descriptor == nil {
+			return fmt.Errorf("reviewer group %q not found in project %s or the organization", name, projectID)
+		}
+
+		storageKey, err := clients.GraphClient.GetStorageKey(clients.Ctx, graph.GetStorageKeyArgs{
+			SubjectDescriptor: match.Descriptor,
+		})
+		if err != nil {
+			return fmt.Errorf("getting storage key of group %q: %+v", name, err)
+		}
+		if storageKey == nil || storageKey.Value == nil {
+			return fmt.Errorf("storage key of group %q not found", name)
+		}
+		group["descriptor"] = *match.Descriptor
+		group["id"] = storageKey.Value.String()
+	}
+
+	settingsList := d.Get("settings").([]interface{})
+	settingsList[0].(map[string]interface{})["reviewer_group"] = groups
+	return d.Set("settings", settingsList)
+}
+
+func listReviewerGroups(clients *client.AggregatedClient, scopeDescriptor *string) ([]graph.GraphGroup, error) {
+	groups := []graph.GraphGroup{}
+	var continuationToken *string
+	for {
+		response, err := clients.GraphClient.ListGroups(clients.Ctx, graph.ListGroupsArgs{
+			ScopeDescriptor:   scopeDescriptor,
+			ContinuationToken: continuationToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if response == nil {
+			return groups, nil
+		}
+		if response.GraphGroups != nil {
+			groups = append(groups, *response.GraphGroups...)
+		}
+		if response.ContinuationToken == nil || len(*response.ContinuationToken) == 0 || (*response.ContinuationToken)[0] == "" {
+			return groups, nil
+		}
+		continuationToken = &(*response.ContinuationToken)[0]
+	}
+}
+
+// selectReviewerGroup selects a group by display name or principal name, e.g. `Contributors` or `[Project]\Contributors`
+func selectReviewerGroup(groups []graph.GraphGroup, name string) *graph.GraphGroup {
+	for i, group := range groups {
+		if (group.DisplayName != nil && strings.EqualFold(*group.DisplayName, name)) ||
+			(group.PrincipalName != nil && strings.EqualFold(*group.PrincipalName, name)) {
+			return &groups[i]
+		}
+	}
+	return nil
+}
@@ -5,14 +5,19 @@
 package branch
 
 import (
+	"context"
 	"strconv"
 	"testing"
 
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/graph"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/policy"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
 )
 
 // verifies that the flatten/expand round trip path produces repeatable results
@@ -52,3 +57,147 @@ func TestBranchPolicyAutoReviewers_ExpandFlatten_Roundtrip(t *testing.T) {
 	require.Equal(t, testPolicy, expandedPolicy)
 	require.Equal(t, projectID, *expandedProjectID)
 }
+
+func testAutoReviewersResourceData(t *testing.T, projectID string, settings map[string]interface{}) *schema.ResourceData {
+	settings["scope"] = []interface{}{
+		map[string]interface{}{
+			"repository_id":  "test-repo-id",
+			"repository_ref": "refs/heads/main",
+			"match_type":     "Exact",
+		},
+	}
+	return schema.TestResourceDataRaw(t, ResourceBranchPolicyAutoReviewers().Schema, map[string]interface{}{
+		"project_id": projectID,
+		"settings":   []interface{}{settings},
+	})
+}
+
+// verifies that the reviewers resolved from the configured groups are tracked by the groups
+func TestBranchPolicyAutoReviewers_Flatten_ReviewerGroups(t *testing.T) {
+	projectID := uuid.New().String()
+	groupID := uuid.New().String()
+	userID := uuid.New().String()
+	resourceData := testAutoReviewersResourceData(t, projectID, map[string]interface{}{
+		"reviewer_group": []interface{}{
+			map[string]interface{}{"name": "Contributors"},
+		},
+	})
+	settingsList := resourceData.Get("settings").([]interface{})
+	settingsList[0].(map[string]interface{})["reviewer_group"] = []interface{}{
+		map[string]interface{}{"name": "Contributors", "descriptor": "vssgp.contributors", "id": groupID},
+	}
+	require.Nil(t, resourceData.Set("settings", settingsList))
+
+	err := autoReviewersFlattenFunc(resourceData, &policy.PolicyConfiguration{
+		Id:   converter.Int(1),
+		Type: &policy.PolicyTypeRef{Id: &AutoReviewers},
+		Settings: map[string]interface{}{
+			"requiredReviewerIds":  []string{userID, groupID},
+			"minimumApproverCount": 1,
+		},
+	}, &projectID)
+	require.Nil(t, err)
+	require.Equal(t, []interface{}{userID}, resourceData.Get("settings.0.auto_reviewer_ids"))
+	require.Equal(t, groupID, resourceData.Get("settings.0.reviewer_group.0.id"))
+	require.Equal(t, "Contributors", resourceData.Get("settings.0.reviewer_group.0.name"))
+
+	// A group that is no longer a reviewer is removed
+	err = autoReviewersFlattenFunc(resourceData, &policy.PolicyConfiguration{
+		Id:   converter.Int(1),
+		Type: &policy.PolicyTypeRef{Id: &AutoReviewers},
+		Settings: map[string]interface{}{
+			"requiredReviewerIds": []string{userID},
+		},
+	}, &projectID)
+	require.Nil(t, err)
+	require.Empty(t, resourceData.Get("settings.0.reviewer_group"))
+}
+
+func TestBranchPolicyAutoReviewers_ResolveReviewerGroups(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	graphClient := azdosdkmocks.NewMockGraphClient(ctrl)
+	clients := &client.AggregatedClient{GraphClient: graphClient, Ctx: context.Background()}
+
+	projectID := uuid.New()
+	projectGroupID := uuid.New()
+	organizationGroupID := uuid.New()
+
+	graphClient.EXPECT().GetDescriptor(clients.Ctx, graph.GetDescriptorArgs{StorageKey: &projectID}).
+		Return(&graph.GraphDescriptorResult{Value: converter.String("scp.project")}, nil).Times(1)
+	graphClient.EXPECT().ListGroups(clients.Ctx, graph.ListGroupsArgs{ScopeDescriptor: converter.String("scp.project")}).
+		Return(&graph.PagedGraphGroups{
+			GraphGroups: &[]graph.GraphGroup{
+				{Descriptor: converter.String("vssgp.readers"), DisplayName: converter.String("Readers")},
+				{Descriptor: converter.String("vssgp.reviewers"), DisplayName: converter.String("Reviewers"), PrincipalName: converter.String("[project]\\Reviewers")},
+			},
+		}, nil).Times(1)
+	graphClient.EXPECT().ListGroups(clients.Ctx, graph.ListGroupsArgs{}).
+		Return(&graph.PagedGraphGroups{
+			GraphGroups: &[]graph.GraphGroup{
+				{Descriptor: converter.String("vssgp.admins"), DisplayName: converter.String("Project Collection Administrators")},
+			},
+		}, nil).Times(1)
+	graphClient.EXPECT().GetStorageKey(clients.Ctx, graph.GetStorageKeyArgs{SubjectDescriptor: converter.String("vssgp.reviewers")}).
+		Return(&graph.GraphStorageKeyResult{Value: &projectGroupID}, nil).Times(1)
+	graphClient.EXPECT().GetStorageKey(clients.Ctx, graph.GetStorageKeyArgs{SubjectDescriptor: converter.String("vssgp.admins")}).
+		Return(&graph.GraphStorageKeyResult{Value: &organizationGroupID}, nil).Times(1)
+
+	resourceData := testAutoReviewersResourceData(t, projectID.String(), map[string]interface{}{
+		"reviewer_group": []interface{}{
+			map[string]interface{}{"name": "[project]\\reviewers"},
+			map[string]interface{}{"name": "Project Collection Administrators"},
+		},
+	})
+	err := resolveReviewerGroups(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "vssgp.reviewers", resourceData.Get("settings.0.reviewer_group.0.descriptor"))
+	require.Equal(t, projectGroupID.String(), resourceData.Get("settings.0.reviewer_group.0.id"))
+	require.Equal(t, organizationGroupID.String(), resourceData.Get("settings.0.reviewer_group.1.id"))
+
+	expandedPolicy, _, err := autoReviewersExpandFunc(resourceData, AutoReviewers)
+	require.Nil(t, err)
+	require.Equal(t, []string{projectGroupID.String(), organizationGroupID.String()}, expandedPolicy.Settings.(map[string]interface{})["requiredReviewerIds"])
+}
+
+func TestBranchPolicyAutoReviewers_ResolveReviewerGroups_NotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	graphClient := azdosdkmocks.NewMockGraphClient(ctrl)
+	clients := &client.AggregatedClient{GraphClient: graphClient, Ctx: context.Background()}
+
+	graphClient.EXPECT().GetDescriptor(clients.Ctx, gomock.Any()).
+		Return(&graph.GraphDescriptorResult{Value: converter.String("scp.project")}, nil).Times(1)
+	graphClient.EXPECT().ListGroups(clients.Ctx, gomock.Any()).Return(&graph.PagedGraphGroups{}, nil).Times(2)
+
+	resourceData := testAutoReviewersResourceData(t, uuid.New().String(), map[string]interface{}{
+		"reviewer_group": []interface{}{
+			map[string]interface{}{"name": "Missing"},
+		},
+	})
+	err := resolveReviewerGroups(resourceData, clients)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), `reviewer group "Missing" not found`)
+}
+
+func TestBranchPolicyAutoReviewers_ResolveReviewerGroups_MinimumRequiresSingleReviewer(t *testing.T) {
+	resourceData := testAutoReviewersResourceData(t, uuid.New().String(), map[string]interface{}{
+		"auto_reviewer_ids":           []interface{}{uuid.New().String()},
+		"reviewer_group":              []interface{}{map[string]interface{}{"name": "Reviewers"}},
+		"minimum_number_of_reviewers": 2,
+	})
+	err := resolveReviewerGroups(resourceData, &client.AggregatedClient{Ctx: context.Background()})
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "minimum_number_of_reviewers")
+}
+
+// verifies that policies without reviewer groups are not validated, as they were before reviewer groups were supported
+func TestBranchPolicyAutoReviewers_ResolveReviewerGroups_ReviewerIdsOnly(t *testing.T) {
+	resourceData := testAutoReviewersResourceData(t, uuid.New().String(), map[string]interface{}{
+		"auto_reviewer_ids":           []interface{}{uuid.New().String(), uuid.New().String()},
+		"minimum_number_of_reviewers": 2,
+	})
+	require.Nil(t, resolveReviewerGroups(resourceData, &client.AggregatedClient{Ctx: context.Background()}))
+}
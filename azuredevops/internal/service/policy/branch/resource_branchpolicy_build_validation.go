@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"maps"
+	"strings"
 
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -11,6 +12,13 @@ import (
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/policy"
 )
 
+// Build expiration modes, each mode is a combination of validDuration and queueOnSourceUpdateOnly
+const (
+	buildExpirationImmediately   = "Immediately"
+	buildExpirationAfterDuration = "AfterDuration"
+	buildExpirationNever         = "Never"
+)
+
 type buildValidationPolicySettings struct {
 	BuildDefinitionID       int      `json:"buildDefinitionId"`
 	PolicyDisplayName       string   `json:"displayName"`
@@ -33,9 +41,10 @@ func ResourceBranchPolicyBuildValidation() *schema.Resource {
 			Type:     schema.TypeInt,
 			Required: true,
 		},
+		// Policies created through the web UI without a display name are shown with the name of the build definition
 		"display_name": {
 			Type:         schema.TypeString,
-			Required:     true,
+			Optional:     true,
 			ValidateFunc: validation.StringIsNotEmpty,
 		},
 		"manual_queue_only": {
@@ -44,15 +53,25 @@ func ResourceBranchPolicyBuildValidation() *schema.Resource {
 			Optional: true,
 		},
 		"queue_on_source_update_only": {
-			Type:     schema.TypeBool,
-			Default:  true,
-			Optional: true,
+			Type:             schema.TypeBool,
+			Default:          true,
+			Optional:         true,
+			DiffSuppressFunc: suppressBuildExpirationDiff,
 		},
 		"valid_duration": {
-			Type:         schema.TypeInt,
-			Default:      720,
-			Optional:     true,
-			ValidateFunc: validation.IntAtLeast(0),
+			Type:             schema.TypeInt,
+			Default:          720,
+			Optional:         true,
+			ValidateFunc:     validation.IntAtLeast(0),
+			DiffSuppressFunc: suppressBuildExpirationDiff,
+		},
+		"build_expiration": {
+			Type:     schema.TypeString,
+			Optional: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				buildExpirationImmediately, buildExpirationAfterDuration, buildExpirationNever,
+			}, false),
+			ConflictsWith: []string{"settings.0.queue_on_source_update_only"},
 		},
 		"filename_patterns": {
 			Type:     schema.TypeList,
@@ -67,10 +86,6 @@ func ResourceBranchPolicyBuildValidation() *schema.Resource {
 }
 
 func buildValidationFlattenFunc(d *schema.ResourceData, policyConfig *policy.PolicyConfiguration, projectID *string) error {
-	err := baseFlattenFunc(d, policyConfig, projectID)
-	if err != nil {
-		return err
-	}
 	policyAsJSON, err := json.Marshal(policyConfig.Settings)
 	if err != nil {
 		return fmt.Errorf("Unable to marshal policy settings into JSON: %+v", err)
@@ -82,6 +97,15 @@ func buildValidationFlattenFunc(d *schema.ResourceData, policyConfig *policy.Pol
 		return fmt.Errorf("Unable to unmarshal branch policy settings (%+v): %+v", policySettings, err)
 	}
 
+	// The configured patterns and the build expiration are read before the settings are overwritten
+	configuredPatterns, _ := d.Get("settings.0.filename_patterns").([]interface{})
+	buildExpiration, _ := d.Get("settings.0.build_expiration").(string)
+
+	err = baseFlattenFunc(d, policyConfig, projectID)
+	if err != nil {
+		return err
+	}
+
 	settingsList := d.Get("settings").([]interface{})
 	settings := settingsList[0].(map[string]interface{})
 
@@ -90,12 +114,53 @@ func buildValidationFlattenFunc(d *schema.ResourceData, policyConfig *policy.Pol
 	settings["manual_queue_only"] = policySettings.ManualQueueOnly
 	settings["queue_on_source_update_only"] = policySettings.QueueOnSourceUpdateOnly
 	settings["valid_duration"] = policySettings.ValidDuration
-	settings["filename_patterns"] = policySettings.FilenamePatterns
+	settings["filename_patterns"] = flattenStringsInConfiguredOrder(configuredPatterns, policySettings.FilenamePatterns)
+	// The build expiration is tracked only if it is configured, the individual settings are tracked otherwise
+	if buildExpiration != "" {
+		settings["build_expiration"] = flattenBuildExpiration(policySettings.QueueOnSourceUpdateOnly, policySettings.ValidDuration)
+	}
 
 	d.Set("settings", settingsList)
 	return nil
 }
 
+func flattenBuildExpiration(queueOnSourceUpdateOnly bool, validDuration int) string {
+	if validDuration > 0 {
+		return buildExpirationAfterDuration
+	}
+	if queueOnSourceUpdateOnly {
+		return buildExpirationNever
+	}
+	return buildExpirationImmediately
+}
+
+// expandBuildExpiration returns the queueOnSourceUpdateOnly and validDuration settings of the build expiration
+func expandBuildExpiration(buildExpiration string, validDuration int) (bool, int, error) {
+	switch buildExpiration {
+	case buildExpirationImmediately:
+		return false, 0, nil
+	case buildExpirationNever:
+		return true, 0, nil
+	default:
+		if validDuration <= 0 {
+			return false, 0, fmt.Errorf("'valid_duration' must be greater than 0 when 'build_expiration=%s'", buildExpirationAfterDuration)
+		}
+		return true, validDuration, nil
+	}
+}
+
+// suppressBuildExpirationDiff suppresses the differences of the settings that are derived from the build expiration
+func suppressBuildExpirationDiff(k, old, new string, d *schema.ResourceData) bool {
+	buildExpiration, _ := d.Get("settings.0.build_expiration").(string)
+	if buildExpiration == "" {
+		return false
+	}
+	if strings.HasSuffix(k, "valid_duration") {
+		return buildExpiration != buildExpirationAfterDuration
+	}
+	return true
+}
+
 func buildValidationExpandFunc(d *schema.ResourceData, typeID uuid.UUID) (*policy.PolicyConfiguration, *string, error) {
 	policyConfig, projectID, err := baseExpandFunc(d, typeID)
 	if err != nil {
@@ -111,6 +176,14 @@ func buildValidationExpandFunc(d *schema.ResourceData, typeID uuid.UUID) (*polic
 	policySettings["manualQueueOnly"] = settings["manual_queue_only"].(bool)
 	policySettings["queueOnSourceUpdateOnly"] = settings["queue_on_source_update_only"].(bool)
 	policySettings["validDuration"] = settings["valid_duration"].(int)
+	if buildExpiration, _ := settings["build_expiration"].(string); buildExpiration != "" {
+		queueOnSourceUpdateOnly, validDuration, err := expandBuildExpiration(buildExpiration, settings["valid_duration"].(int))
+		if err != nil {
+			return nil, nil, err
+		}
+		policySettings["queueOnSourceUpdateOnly"] = queueOnSourceUpdateOnly
+		policySettings["validDuration"] = validDuration
+	}
 	policySettings["filenamePatterns"] = expandFilenamePatterns(settings["filename_patterns"].([]interface{}))
 
 	return policyConfig, projectID, nil
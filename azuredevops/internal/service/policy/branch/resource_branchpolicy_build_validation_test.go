@@ -53,3 +53,140 @@ func TestBranchPolicyBuildValidation_ExpandFlatten_Roundtrip(t *testing.T) {
 	require.Equal(t, testPolicy, expandedPolicy)
 	require.Equal(t, projectID, *expandedProjectID)
 }
+
+func testBuildValidationPolicy(settings map[string]interface{}) *policy.PolicyConfiguration {
+	policySettings := map[string]interface{}{
+		"scope": []map[string]interface{}{
+			{
+				"repositoryId": "test-repo-id",
+				"refName":      "refs/heads/main",
+				"matchKind":    "Exact",
+			},
+		},
+		"buildDefinitionId":       77,
+		"displayName":             "",
+		"manualQueueOnly":         false,
+		"queueOnSourceUpdateOnly": true,
+		"validDuration":           720,
+	}
+	for key, value := range settings {
+		policySettings[key] = value
+	}
+	return &policy.PolicyConfiguration{
+		Id:         converter.Int(1),
+		IsEnabled:  converter.Bool(true),
+		IsBlocking: converter.Bool(true),
+		Type:       &policy.PolicyTypeRef{Id: &BuildValidation},
+		Settings:   policySettings,
+	}
+}
+
+func testBuildValidationResourceData(t *testing.T, settings map[string]interface{}) *schema.ResourceData {
+	settings["build_definition_id"] = 77
+	settings["scope"] = []interface{}{
+		map[string]interface{}{
+			"repository_id":  "test-repo-id",
+			"repository_ref": "refs/heads/main",
+			"match_type":     "Exact",
+		},
+	}
+	resourceData := schema.TestResourceDataRaw(t, ResourceBranchPolicyBuildValidation().Schema, map[string]interface{}{
+		"project_id": uuid.New().String(),
+		"settings":   []interface{}{settings},
+	})
+	resourceData.SetId("1")
+	return resourceData
+}
+
+// verifies that the configured order of the filename patterns is kept if the service reorders the patterns
+func TestBranchPolicyBuildValidation_Flatten_KeepsConfiguredPatternOrder(t *testing.T) {
+	projectID := uuid.New().String()
+	resourceData := testBuildValidationResourceData(t, map[string]interface{}{
+		"filename_patterns": []interface{}{"/src/*", "!/src/tests/*", "*.md"},
+	})
+
+	err := buildValidationFlattenFunc(resourceData, testBuildValidationPolicy(map[string]interface{}{
+		"filenamePatterns": []string{"!/src/tests/*", "*.md", "/src/*"},
+	}), &projectID)
+	require.Nil(t, err)
+	require.Equal(t, []interface{}{"/src/*", "!/src/tests/*", "*.md"}, resourceData.Get("settings.0.filename_patterns"))
+
+	err = buildValidationFlattenFunc(resourceData, testBuildValidationPolicy(map[string]interface{}{
+		"filenamePatterns": []string{"/src/*", "*.md", "/docs/*"},
+	}), &projectID)
+	require.Nil(t, err)
+	require.Equal(t, []interface{}{"/src/*", "*.md", "/docs/*"}, resourceData.Get("settings.0.filename_patterns"))
+}
+
+func TestBranchPolicyBuildValidation_Expand_BuildExpiration(t *testing.T) {
+	tests := []struct {
+		buildExpiration         string
+		validDuration           int
+		queueOnSourceUpdateOnly bool
+		expectedValidDuration   int
+	}{
+		{buildExpirationImmediately, 720, false, 0},
+		{buildExpirationAfterDuration, 360, true, 360},
+		{buildExpirationNever, 720, true, 0},
+	}
+	for _, test := range tests {
+		t.Run(test.buildExpiration, func(t *testing.T) {
+			resourceData := testBuildValidationResourceData(t, map[string]interface{}{
+				"build_expiration": test.buildExpiration,
+				"valid_duration":   test.validDuration,
+			})
+			expandedPolicy, _, err := buildValidationExpandFunc(resourceData, BuildValidation)
+			require.Nil(t, err)
+
+			settings := expandedPolicy.Settings.(map[string]interface{})
+			require.Equal(t, test.queueOnSourceUpdateOnly, settings["queueOnSourceUpdateOnly"])
+			require.Equal(t, test.expectedValidDuration, settings["validDuration"])
+		})
+	}
+}
+
+func TestBranchPolicyBuildValidation_Expand_AfterDurationRequiresDuration(t *testing.T) {
+	resourceData := testBuildValidationResourceData(t, map[string]interface{}{
+		"build_expiration": buildExpirationAfterDuration,
+		"valid_duration":   0,
+	})
+	_, _, err := buildValidationExpandFunc(resourceData, BuildValidation)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "valid_duration")
+}
+
+func TestBranchPolicyBuildValidation_Flatten_BuildExpiration(t *testing.T) {
+	tests := []struct {
+		queueOnSourceUpdateOnly bool
+		validDuration           int
+		expected                string
+	}{
+		{false, 0, buildExpirationImmediately},
+		{true, 360, buildExpirationAfterDuration},
+		{true, 0, buildExpirationNever},
+	}
+	for _, test := range tests {
+		t.Run(test.expected, func(t *testing.T) {
+			projectID := uuid.New().String()
+			resourceData := testBuildValidationResourceData(t, map[string]interface{}{
+				"build_expiration": buildExpirationAfterDuration,
+			})
+			err := buildValidationFlattenFunc(resourceData, testBuildValidationPolicy(map[string]interface{}{
+				"queueOnSourceUpdateOnly": test.queueOnSourceUpdateOnly,
+				"validDuration":           test.validDuration,
+			}), &projectID)
+			require.Nil(t, err)
+			require.Equal(t, test.expected, resourceData.Get("settings.0.build_expiration"))
+			require.Equal(t, test.validDuration, resourceData.Get("settings.0.valid_duration"))
+		})
+	}
+
+	// The build expiration is not tracked if it is not configured, e.g. after an import
+	projectID := uuid.New().String()
+	resourceData := schema.TestResourceDataRaw(t, ResourceBranchPolicyBuildValidation().Schema, nil)
+	resourceData.SetId("1")
+	err := buildValidationFlattenFunc(resourceData, testBuildValidationPolicy(nil), &projectID)
+	require.Nil(t, err)
+	require.Equal(t, "", resourceData.Get("settings.0.build_expiration"))
+	require.Equal(t, "", resourceData.Get("settings.0.display_name"))
+}
@@ -0,0 +1,113 @@
+package branch
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/policy"
+)
+
+// ResourceBranchPolicyGeneric schema and implementation for a branch policy of any policy type, e.g. a policy
+// type contributed by an extension
+func ResourceBranchPolicyGeneric() *schema.Resource {
+	resource := genBasePolicyResource(&policyCrudArgs{
+		FlattenFunc: genericFlattenFunc,
+		ExpandFunc:  genericExpandFunc,
+	})
+
+	resource.Schema["type_id"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		ValidateFunc: validation.IsUUID,
+	}
+
+	settingsSchema := resource.Schema["settings"].Elem.(*schema.Resource).Schema
+	maps.Copy(settingsSchema, map[string]*schema.Schema{
+		// The settings of the policy type except the scope, which is configured through the scope blocks
+		"json": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Default:          "{}",
+			ValidateFunc:     validation.StringIsJSON,
+			DiffSuppressFunc: structure.SuppressJsonDiff,
+		},
+	})
+	return resource
+}
+
+func genericFlattenFunc(d *schema.ResourceData, policyConfig *policy.PolicyConfiguration, projectID *string) error {
+	// The service adds the default values of settings that are not configured. Only the configured settings are
+	// tracked, unless nothing is known about the configuration, e.g. after an import.
+	configuredJSON, tracked := d.GetOk("settings.0.json")
+	configured := map[string]interface{}{}
+	if tracked {
+		if err := json.Unmarshal([]byte(configuredJSON.(string)), &configured); err != nil {
+			return fmt.Errorf("Unable to unmarshal configured policy settings. Error: %+v", err)
+		}
+	}
+
+	err := baseFlattenFunc(d, policyConfig, projectID)
+	if err != nil {
+		return err
+	}
+
+	if policyConfig.Type != nil && policyConfig.Type.Id != nil {
+		d.Set("type_id", policyConfig.Type.Id.String())
+	}
+
+	policySettings, ok := policyConfig.Settings.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("Unexpected type of policy settings: %T", policyConfig.Settings)
+	}
+
+	settingsJSON := map[string]interface{}{}
+	for key, value := range policySettings {
+		if key == "scope" {
+			continue
+		}
+		if _, ok := configured[key]; ok || !tracked {
+			settingsJSON[key] = value
+		}
+	}
+
+	settingsJSONString, err := json.Marshal(settingsJSON)
+	if err != nil {
+		return fmt.Errorf("Unable to marshal policy settings into JSON: %+v", err)
+	}
+
+	settingsList := d.Get("settings").([]interface{})
+	settings := settingsList[0].(map[string]interface{})
+	settings["json"] = string(settingsJSONString)
+	_ = d.Set("settings", settingsList)
+	return nil
+}
+
+func genericExpandFunc(d *schema.ResourceData, _ uuid.UUID) (*policy.PolicyConfiguration, *string, error) {
+	typeID, err := uuid.Parse(d.Get("type_id").(string))
+	if err != nil {
+		return nil, nil, fmt.Errorf("parsing policy type ID: (%+v)", err)
+	}
+
+	policyConfig, projectID, err := baseExpandFunc(d, typeID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	settingsJSON := map[string]interface{}{}
+	if err := json.Unmarshal([]byte(d.Get("settings.0.json").(string)), &settingsJSON); err != nil {
+		return nil, nil, fmt.Errorf("parsing policy settings JSON: (%+v)", err)
+	}
+	if _, ok := settingsJSON["scope"]; ok {
+		return nil, nil, fmt.Errorf("the scope of the policy must be configured through the `scope` blocks, not the settings JSON")
+	}
+
+	policySettings := policyConfig.Settings.(map[string]interface{})
+	maps.Copy(policySettings, settingsJSON)
+	return policyConfig, projectID, nil
+}
@@ -0,0 +1,104 @@
+//go:build (all || resource_branchpolicy_generic) && !exclude_resource_branchpolicy_generic
+// +build all resource_branchpolicy_generic
+// +build !exclude_resource_branchpolicy_generic
+
+package branch
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/policy"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+func testGenericPolicy(typeID uuid.UUID) *policy.PolicyConfiguration {
+	return &policy.PolicyConfiguration{
+		Id:         converter.Int(1),
+		IsEnabled:  converter.Bool(true),
+		IsBlocking: converter.Bool(false),
+		Type: &policy.PolicyTypeRef{
+			Id: &typeID,
+		},
+		Settings: map[string]interface{}{
+			"scope": []map[string]interface{}{
+				{
+					"repositoryId": "test-repo-id",
+					"refName":      "refs/heads/main",
+					"matchKind":    "Exact",
+				},
+			},
+			"qualityGate":    "Sonar way",
+			"allowDowngrade": false,
+		},
+	}
+}
+
+// verifies that the flatten/expand round trip path produces repeatable results
+func TestBranchPolicyGeneric_ExpandFlatten_Roundtrip(t *testing.T) {
+	projectID := uuid.New().String()
+	typeID := uuid.New()
+	testPolicy := testGenericPolicy(typeID)
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceBranchPolicyGeneric().Schema, nil)
+	resourceData.SetId(strconv.Itoa(*testPolicy.Id))
+	require.Nil(t, genericFlattenFunc(resourceData, testPolicy, &projectID))
+	require.Equal(t, typeID.String(), resourceData.Get("type_id"))
+	require.JSONEq(t, `{"qualityGate":"Sonar way","allowDowngrade":false}`, resourceData.Get("settings.0.json").(string))
+
+	expandedPolicy, expandedProjectID, err := genericExpandFunc(resourceData, uuid.Nil)
+	require.Nil(t, err)
+	require.Equal(t, testPolicy, expandedPolicy)
+	require.Equal(t, projectID, *expandedProjectID)
+}
+
+// verifies that settings the service adds with default values are not tracked
+func TestBranchPolicyGeneric_Flatten_TracksConfiguredSettingsOnly(t *testing.T) {
+	projectID := uuid.New().String()
+	typeID := uuid.New()
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceBranchPolicyGeneric().Schema, map[string]interface{}{
+		"project_id": projectID,
+		"type_id":    typeID.String(),
+		"settings": []interface{}{
+			map[string]interface{}{
+				"json": `{"qualityGate":"Sonar way"}`,
+				"scope": []interface{}{
+					map[string]interface{}{
+						"repository_id":  "test-repo-id",
+						"repository_ref": "refs/heads/main",
+					},
+				},
+			},
+		},
+	})
+	resourceData.SetId("1")
+	require.Nil(t, genericFlattenFunc(resourceData, testGenericPolicy(typeID), &projectID))
+	require.JSONEq(t, `{"qualityGate":"Sonar way"}`, resourceData.Get("settings.0.json").(string))
+	require.Equal(t, "refs/heads/main", resourceData.Get("settings.0.scope.0.repository_ref"))
+}
+
+// verifies that the scope cannot be configured through the settings JSON
+func TestBranchPolicyGeneric_Expand_RejectsScopeInJSON(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, ResourceBranchPolicyGeneric().Schema, map[string]interface{}{
+		"project_id": uuid.New().String(),
+		"type_id":    uuid.New().String(),
+		"settings": []interface{}{
+			map[string]interface{}{
+				"json": `{"scope":[]}`,
+				"scope": []interface{}{
+					map[string]interface{}{
+						"match_type": "DefaultBranch",
+					},
+				},
+			},
+		},
+	})
+
+	_, _, err := genericExpandFunc(resourceData, uuid.Nil)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "`scope` blocks")
+}
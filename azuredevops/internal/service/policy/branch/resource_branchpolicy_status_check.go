@@ -1,12 +1,19 @@
 package branch
 
 import (
+	"context"
+	"fmt"
 	"maps"
+	"regexp"
+	"strings"
 
 	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/graph"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/policy"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
 )
 
 type policyApplicability struct {
@@ -39,9 +46,34 @@ func ResourceBranchPolicyStatusCheck() *schema.Resource {
 		},
 
 		"author_id": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			ValidateFunc: validation.IsUUID,
+			Type:          schema.TypeString,
+			Optional:      true,
+			ValidateFunc:  validation.IsUUID,
+			ConflictsWith: []string{"settings.0.author"},
+		},
+
+		// A user or service principal resolved by descriptor when the policy is created or updated
+		"author": {
+			Type:          schema.TypeList,
+			Optional:      true,
+			MaxItems:      1,
+			ConflictsWith: []string{"settings.0.author_id"},
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"descriptor": {
+						Type:     schema.TypeString,
+						Required: true,
+						ValidateFunc: validation.All(
+							validation.StringIsNotWhiteSpace,
+							validation.StringDoesNotMatch(regexp.MustCompile(`^(vssgp|aadgp)\.`), "must be the descriptor of a user or service principal, groups can't post statuses"),
+						),
+					},
+					"id": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+				},
+			},
 		},
 
 		"invalidate_on_update": {
@@ -75,10 +107,27 @@ func ResourceBranchPolicyStatusCheck() *schema.Resource {
 			Default:  "",
 		},
 	})
+
+	create, update := resource.CreateContext, resource.UpdateContext
+	resource.CreateContext = func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		if err := resolveStatusAuthor(d, m.(*client.AggregatedClient)); err != nil {
+			return diag.FromErr(err)
+		}
+		return create(ctx, d, m)
+	}
+	resource.UpdateContext = func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		if err := resolveStatusAuthor(d, m.(*client.AggregatedClient)); err != nil {
+			return diag.FromErr(err)
+		}
+		return update(ctx, d, m)
+	}
 	return resource
 }
 
 func statusCheckFlattenFunc(d *schema.ResourceData, policyConfig *policy.PolicyConfiguration, projectID *string) error {
+	// The configured settings are read before the settings are overwritten
+	configuredAuthor, _ := d.Get("settings.0.author").([]interface{})
+
 	err := baseFlattenFunc(d, policyConfig, projectID)
 	if err != nil {
 		return err
@@ -92,9 +141,18 @@ func statusCheckFlattenFunc(d *schema.ResourceData, policyConfig *policy.PolicyC
 	settings["name"] = policySettings["statusName"]
 	settings["genre"] = policySettings["statusGenre"]
 	settings["author_id"] = policySettings["authorId"]
+	// The author resolved from a configured descriptor is tracked by the descriptor, as long as it still matches
+	if len(configuredAuthor) == 1 && configuredAuthor[0] != nil {
+		authorID, _ := policySettings["authorId"].(string)
+		if author := configuredAuthor[0].(map[string]interface{}); authorID != "" && strings.EqualFold(author["id"].(string), authorID) {
+			settings["author"] = configuredAuthor
+			settings["author_id"] = ""
+		}
+	}
 	settings["invalidate_on_update"] = policySettings["invalidateOnSourceUpdate"]
 	settings["display_name"] = policySettings["defaultDisplayName"]
 
+	settings["filename_patterns"] = nil
 	if patterns, ok := policySettings["filenamePatterns"]; ok {
 		if patterns != nil {
 			settings["filename_patterns"] = policySettings["filenamePatterns"].([]interface{})
@@ -124,6 +182,9 @@ func statusCheckExpandFunc(d *schema.ResourceData, typeID uuid.UUID) (*policy.Po
 	policySettings["statusName"] = settings["name"].(string)
 	policySettings["statusGenre"] = settings["genre"].(string)
 	policySettings["authorId"] = settings["author_id"].(string)
+	if author, ok := settings["author"].([]interface{}); ok && len(author) == 1 && author[0] != nil {
+		policySettings["authorId"] = author[0].(map[string]interface{})["id"].(string)
+	}
 	policySettings["invalidateOnSourceUpdate"] = settings["invalidate_on_update"].(bool)
 	policySettings["defaultDisplayName"] = settings["display_name"].(string)
 
@@ -143,3 +204,28 @@ func statusCheckExpandFunc(d *schema.ResourceData, typeID uuid.UUID) (*policy.Po
 
 	return policyConfig, projectID, nil
 }
+
+// resolveStatusAuthor resolves the identity ID of the user or service principal authorized to post the status
+func resolveStatusAuthor(d *schema.ResourceData, clients *client.AggregatedClient) error {
+	author, _ := d.Get("settings.0.author").([]interface{})
+	if len(author) != 1 || author[0] == nil {
+		return nil
+	}
+
+	authorSettings := author[0].(map[string]interface{})
+	descriptor := authorSettings["descriptor"].(string)
+	storageKey, err := clients.GraphClient.GetStorageKey(clients.Ctx, graph.GetStorageKeyArgs{
+		SubjectDescriptor: &descriptor,
+	})
+	if err != nil {
+		return fmt.Errorf("getting storage key of status author %q: %+v", descriptor, err)
+	}
+	if storageKey == nil || storageKey.Value == nil {
+		return fmt.Errorf("storage key of status author %q not found", descriptor)
+	}
+	authorSettings["id"] = storageKey.Value.String()
+
+	settingsList := d.Get("settings").([]interface{})
+	settingsList[0].(map[string]interface{})["author"] = author
+	return d.Set("settings", settingsList)
+}
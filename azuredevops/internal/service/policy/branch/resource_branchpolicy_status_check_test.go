@@ -0,0 +1,150 @@
+//go:build (all || resource_branchpolicy_status_check) && !exclude_resource_branchpolicy_status_check
+// +build all resource_branchpolicy_status_check
+// +build !exclude_resource_branchpolicy_status_check
+
+package branch
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/graph"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/policy"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func testStatusCheckResourceData(t *testing.T, projectID string, settings map[string]interface{}) *schema.ResourceData {
+	settings["name"] = "scan"
+	settings["scope"] = []interface{}{
+		map[string]interface{}{
+			"repository_id":  "test-repo-id",
+			"repository_ref": "refs/heads/main",
+			"match_type":     "Exact",
+		},
+	}
+	return schema.TestResourceDataRaw(t, ResourceBranchPolicyStatusCheck().Schema, map[string]interface{}{
+		"project_id": projectID,
+		"settings":   []interface{}{settings},
+	})
+}
+
+func testStatusCheckPolicy(authorID string, patterns interface{}) *policy.PolicyConfiguration {
+	typeID := StatusCheck
+	return &policy.PolicyConfiguration{
+		Id:         converter.Int(1),
+		IsEnabled:  converter.Bool(true),
+		IsBlocking: converter.Bool(true),
+		Type: &policy.PolicyTypeRef{
+			Id: &typeID,
+		},
+		Settings: map[string]interface{}{
+			"scope": []interface{}{
+				map[string]interface{}{
+					"repositoryId": "test-repo-id",
+					"refName":      "refs/heads/main",
+					"matchKind":    "Exact",
+				},
+			},
+			"statusName":               "scan",
+			"statusGenre":              "security",
+			"authorId":                 authorID,
+			"invalidateOnSourceUpdate": true,
+			"defaultDisplayName":       "",
+			"filenamePatterns":         patterns,
+			"policyApplicability":      float64(1),
+		},
+	}
+}
+
+// verifies that the author resolved from the configured descriptor is tracked by the descriptor
+func TestBranchPolicyStatusCheck_Flatten_Author(t *testing.T) {
+	projectID := uuid.New().String()
+	authorID := uuid.New().String()
+	resourceData := testStatusCheckResourceData(t, projectID, map[string]interface{}{
+		"author": []interface{}{
+			map[string]interface{}{
+				"descriptor": "aadsp.c2VydmljZS1wcmluY2lwYWw",
+			},
+		},
+	})
+	settingsList := resourceData.Get("settings").([]interface{})
+	settingsList[0].(map[string]interface{})["author"] = []interface{}{
+		map[string]interface{}{"descriptor": "aadsp.c2VydmljZS1wcmluY2lwYWw", "id": authorID},
+	}
+	require.Nil(t, resourceData.Set("settings", settingsList))
+
+	require.Nil(t, statusCheckFlattenFunc(resourceData, testStatusCheckPolicy(authorID, nil), &projectID))
+	require.Equal(t, "", resourceData.Get("settings.0.author_id"))
+	require.Equal(t, "aadsp.c2VydmljZS1wcmluY2lwYWw", resourceData.Get("settings.0.author.0.descriptor"))
+	require.Equal(t, "conditional", resourceData.Get("settings.0.applicability"))
+	require.Equal(t, true, resourceData.Get("settings.0.invalidate_on_update"))
+
+	expanded, _, err := statusCheckExpandFunc(resourceData, StatusCheck)
+	require.Nil(t, err)
+	require.Equal(t, authorID, expanded.Settings.(map[string]interface{})["authorId"])
+
+	// An author changed outside of Terraform is reported by ID
+	otherAuthorID := uuid.New().String()
+	require.Nil(t, statusCheckFlattenFunc(resourceData, testStatusCheckPolicy(otherAuthorID, nil), &projectID))
+	require.Equal(t, otherAuthorID, resourceData.Get("settings.0.author_id"))
+	require.Empty(t, resourceData.Get("settings.0.author"))
+}
+
+// verifies that path filters removed outside of Terraform are detected
+func TestBranchPolicyStatusCheck_Flatten_RemovedPathFilters(t *testing.T) {
+	projectID := uuid.New().String()
+	resourceData := testStatusCheckResourceData(t, projectID, map[string]interface{}{
+		"filename_patterns": []interface{}{"/src/*"},
+	})
+
+	require.Nil(t, statusCheckFlattenFunc(resourceData, testStatusCheckPolicy("", []interface{}{"/src/*", "!/src/test/*"}), &projectID))
+	require.Equal(t, []interface{}{"/src/*", "!/src/test/*"}, resourceData.Get("settings.0.filename_patterns"))
+
+	require.Nil(t, statusCheckFlattenFunc(resourceData, testStatusCheckPolicy("", nil), &projectID))
+	require.Empty(t, resourceData.Get("settings.0.filename_patterns"))
+}
+
+// verifies that the author is resolved from the descriptor
+func TestBranchPolicyStatusCheck_ResolveAuthor(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	graphClient := azdosdkmocks.NewMockGraphClient(ctrl)
+	clients := &client.AggregatedClient{GraphClient: graphClient, Ctx: context.Background()}
+
+	descriptor := "aadsp.c2VydmljZS1wcmluY2lwYWw"
+	authorID := uuid.New()
+	graphClient.EXPECT().
+		GetStorageKey(clients.Ctx, graph.GetStorageKeyArgs{SubjectDescriptor: &descriptor}).
+		Return(&graph.GraphStorageKeyResult{Value: &authorID}, nil).
+		Times(1)
+
+	resourceData := testStatusCheckResourceData(t, uuid.New().String(), map[string]interface{}{
+		"author": []interface{}{
+			map[string]interface{}{
+				"descriptor": descriptor,
+			},
+		},
+	})
+	require.Nil(t, resolveStatusAuthor(resourceData, clients))
+	require.Equal(t, authorID.String(), resourceData.Get("settings.0.author.0.id"))
+}
+
+// verifies that group descriptors are rejected as status author
+func TestBranchPolicyStatusCheck_AuthorDescriptorValidation(t *testing.T) {
+	validateFunc := ResourceBranchPolicyStatusCheck().Schema["settings"].Elem.(*schema.Resource).
+		Schema["author"].Elem.(*schema.Resource).Schema["descriptor"].ValidateFunc
+
+	_, errs := validateFunc("aadsp.c2VydmljZS1wcmluY2lwYWw", "descriptor")
+	require.Empty(t, errs)
+	_, errs = validateFunc("aad.dXNlcg", "descriptor")
+	require.Empty(t, errs)
+	_, errs = validateFunc("vssgp.Z3JvdXA", "descriptor")
+	require.NotEmpty(t, errs)
+}
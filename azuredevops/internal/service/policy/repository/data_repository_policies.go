@@ -0,0 +1,192 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/policy"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/sdk/policyextras"
+)
+
+const (
+	policyScopeProject    = "project"
+	policyScopeRepository = "repository"
+)
+
+// repositoryPolicyTypes all policy types managed by the repository policy resources
+var repositoryPolicyTypes = map[uuid.UUID]bool{
+	AuthorEmailPattern: true,
+	FilePathPattern:    true,
+	CaseEnforcement:    true,
+	ReservedNames:      true,
+	PathLength:         true,
+	FileSize:           true,
+	CheckCredentials:   true,
+}
+
+// DataRepositoryPolicies schema and implementation for the repository policies that apply to a repository
+func DataRepositoryPolicies() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceRepositoryPoliciesRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"repository_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"policies": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"type_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"blocking": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"scope": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"settings": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceRepositoryPoliciesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+	projectID := d.Get("project_id").(string)
+	repoID := d.Get("repository_id").(string)
+
+	configs, err := policyextras.ListPolicyConfigurations(clients.Ctx, clients.PolicyClientExtras, policyextras.GetPolicyConfigurationsArgs{
+		Project: &projectID,
+	})
+	if err != nil {
+		return diag.Errorf(" Listing policy configurations of project %s. Error: %+v", projectID, err)
+	}
+
+	policies := make([]interface{}, 0)
+	for _, config := range configs {
+		if converter.ToBool(config.IsDeleted, false) || config.Id == nil || config.Type == nil || config.Type.Id == nil {
+			continue
+		}
+		if !repositoryPolicyTypes[*config.Type.Id] {
+			continue
+		}
+
+		flattened, err := flattenRepositoryPolicy(&config, repoID)
+		if err != nil {
+			return diag.Errorf(" Flattening policy configuration %d. Error: %+v", *config.Id, err)
+		}
+		if flattened != nil {
+			policies = append(policies, flattened)
+		}
+	}
+
+	d.SetId(fmt.Sprintf("repositoryPolicies#%s/%s", projectID, repoID))
+	if err := d.Set("policies", policies); err != nil {
+		return diag.Errorf(" Setting policies. Error: %+v", err)
+	}
+	return nil
+}
+
+// flattenRepositoryPolicy returns nil if the policy does not apply to the repository. A policy that applies to the
+// whole project is reported with the project scope even if the repository is listed as well.
+func flattenRepositoryPolicy(config *policy.PolicyConfiguration, repoID string) (map[string]interface{}, error) {
+	settingsJSON, err := json.Marshal(config.Settings)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to marshal policy settings into JSON: %+v", err)
+	}
+	settings := map[string]interface{}{}
+	if err := json.Unmarshal(settingsJSON, &settings); err != nil {
+		return nil, fmt.Errorf("Unable to unmarshal policy settings. Error: %+v", err)
+	}
+
+	scope := policyScopeOf(settings, repoID)
+	if scope == "" {
+		return nil, nil
+	}
+
+	delete(settings, "scope")
+	settingsJSON, err = json.Marshal(settings)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to marshal policy settings into JSON: %+v", err)
+	}
+
+	typeName := ""
+	if config.Type.DisplayName != nil {
+		typeName = *config.Type.DisplayName
+	}
+	return map[string]interface{}{
+		"id":       *config.Id,
+		"type_id":  config.Type.Id.String(),
+		"type":     typeName,
+		"enabled":  converter.ToBool(config.IsEnabled, false),
+		"blocking": converter.ToBool(config.IsBlocking, false),
+		"scope":    scope,
+		"settings": string(settingsJSON),
+	}, nil
+}
+
+// policyScopeOf returns the scope through which the policy applies to the repository, or an empty string
+func policyScopeOf(settings map[string]interface{}, repoID string) string {
+	scopes, ok := settings["scope"].([]interface{})
+	if !ok || len(scopes) == 0 {
+		return policyScopeProject
+	}
+
+	scope := ""
+	for _, s := range scopes {
+		sm, ok := s.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		// A scope without repository ID applies to all repositories of the project
+		id, _ := sm["repositoryId"].(string)
+		if id == "" {
+			return policyScopeProject
+		}
+		if strings.EqualFold(id, repoID) {
+			scope = policyScopeRepository
+		}
+	}
+	return scope
+}
@@ -0,0 +1,158 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/policy"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/sdk/policyextras"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestDataRepositoryPolicies_Read_ReportsScope(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	policyClient := azdosdkmocks.NewMockPolicyextrasClient(ctrl)
+	clients := &client.AggregatedClient{PolicyClientExtras: policyClient, Ctx: context.Background()}
+
+	projectID := uuid.NewString()
+	repoID := uuid.NewString()
+	otherRepoID := uuid.NewString()
+	buildPolicyType := uuid.MustParse("0609b952-1397-4640-95ec-e00a01b2c241")
+
+	policyClient.EXPECT().GetPolicyConfigurations(clients.Ctx, gomock.Any()).Return(&policy.GetPolicyConfigurationsResponseValue{
+		Value: []policy.PolicyConfiguration{
+			{
+				Id:         converter.Int(1),
+				IsEnabled:  converter.Bool(true),
+				IsBlocking: converter.Bool(true),
+				Type:       &policy.PolicyTypeRef{Id: &FileSize, DisplayName: converter.String("File size restriction")},
+				Settings: map[string]interface{}{
+					"maximumGitBlobSizeInBytes": 1024,
+					"scope":                     []interface{}{map[string]interface{}{"repositoryId": nil}},
+				},
+			},
+			{
+				Id:         converter.Int(2),
+				IsEnabled:  converter.Bool(true),
+				IsBlocking: converter.Bool(false),
+				Type:       &policy.PolicyTypeRef{Id: &PathLength},
+				Settings: map[string]interface{}{
+					"maxPathLength": 248,
+					"scope":         []interface{}{map[string]interface{}{"repositoryId": otherRepoID}, map[string]interface{}{"repositoryId": repoID}},
+				},
+			},
+			{
+				// Applies to another repository only
+				Id:   converter.Int(3),
+				Type: &policy.PolicyTypeRef{Id: &ReservedNames},
+				Settings: map[string]interface{}{
+					"scope": []interface{}{map[string]interface{}{"repositoryId": otherRepoID}},
+				},
+			},
+			{
+				// Not a repository policy
+				Id:   converter.Int(4),
+				Type: &policy.PolicyTypeRef{Id: &buildPolicyType},
+				Settings: map[string]interface{}{
+					"scope": []interface{}{map[string]interface{}{"repositoryId": repoID}},
+				},
+			},
+			{
+				Id:        converter.Int(5),
+				IsDeleted: converter.Bool(true),
+				Type:      &policy.PolicyTypeRef{Id: &CaseEnforcement},
+				Settings:  map[string]interface{}{},
+			},
+		},
+	}, nil).Times(1)
+
+	d := schema.TestResourceDataRaw(t, DataRepositoryPolicies().Schema, map[string]interface{}{
+		"project_id":    projectID,
+		"repository_id": repoID,
+	})
+	require.Empty(t, dataSourceRepositoryPoliciesRead(context.Background(), d, clients))
+
+	policies := d.Get("policies").([]interface{})
+	require.Len(t, policies, 2)
+
+	projectPolicy := policies[0].(map[string]interface{})
+	require.Equal(t, 1, projectPolicy["id"])
+	require.Equal(t, FileSize.String(), projectPolicy["type_id"])
+	require.Equal(t, "File size restriction", projectPolicy["type"])
+	require.Equal(t, "project", projectPolicy["scope"])
+	require.Equal(t, `{"maximumGitBlobSizeInBytes":1024}`, projectPolicy["settings"])
+
+	repoPolicy := policies[1].(map[string]interface{})
+	require.Equal(t, 2, repoPolicy["id"])
+	require.Equal(t, "repository", repoPolicy["scope"])
+	require.Equal(t, false, repoPolicy["blocking"])
+}
+
+func TestDataRepositoryPolicies_Read_FollowsContinuationToken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	policyClient := azdosdkmocks.NewMockPolicyextrasClient(ctrl)
+	clients := &client.AggregatedClient{PolicyClientExtras: policyClient, Ctx: context.Background()}
+
+	projectID := uuid.NewString()
+	repoID := uuid.NewString()
+	repoPolicy := func(id int) policy.PolicyConfiguration {
+		return policy.PolicyConfiguration{
+			Id:       converter.Int(id),
+			Type:     &policy.PolicyTypeRef{Id: &PathLength},
+			Settings: map[string]interface{}{"scope": []interface{}{map[string]interface{}{"repositoryId": repoID}}},
+		}
+	}
+	gomock.InOrder(
+		policyClient.EXPECT().GetPolicyConfigurations(clients.Ctx, policyextras.GetPolicyConfigurationsArgs{
+			Project: &projectID,
+		}).Return(&policy.GetPolicyConfigurationsResponseValue{
+			Value:             []policy.PolicyConfiguration{repoPolicy(1)},
+			ContinuationToken: "page2",
+		}, nil).Times(1),
+		policyClient.EXPECT().GetPolicyConfigurations(clients.Ctx, policyextras.GetPolicyConfigurationsArgs{
+			Project:           &projectID,
+			ContinuationToken: converter.String("page2"),
+		}).Return(&policy.GetPolicyConfigurationsResponseValue{
+			Value: []policy.PolicyConfiguration{repoPolicy(2)},
+		}, nil).Times(1),
+	)
+
+	d := schema.TestResourceDataRaw(t, DataRepositoryPolicies().Schema, map[string]interface{}{
+		"project_id":    projectID,
+		"repository_id": repoID,
+	})
+	require.Empty(t, dataSourceRepositoryPoliciesRead(context.Background(), d, clients))
+
+	policies := d.Get("policies").([]interface{})
+	require.Len(t, policies, 2)
+	require.Equal(t, 2, policies[1].(map[string]interface{})["id"])
+}
+
+func TestDataRepositoryPolicies_Read_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	policyClient := azdosdkmocks.NewMockPolicyextrasClient(ctrl)
+	clients := &client.AggregatedClient{PolicyClientExtras: policyClient, Ctx: context.Background()}
+
+	policyClient.EXPECT().GetPolicyConfigurations(clients.Ctx, gomock.Any()).Return(nil, errors.New("GetPolicyConfigurations() Failed")).Times(1)
+
+	d := schema.TestResourceDataRaw(t, DataRepositoryPolicies().Schema, map[string]interface{}{
+		"project_id":    uuid.NewString(),
+		"repository_id": uuid.NewString(),
+	})
+	diags := dataSourceRepositoryPoliciesRead(context.Background(), d, clients)
+	require.NotEmpty(t, diags)
+	require.Contains(t, diags[0].Summary, "GetPolicyConfigurations() Failed")
+}
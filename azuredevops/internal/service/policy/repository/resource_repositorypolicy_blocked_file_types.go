@@ -0,0 +1,103 @@
+package repository
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/policy"
+)
+
+// fileExtensionRegex file extensions without the leading dot, wildcards, path separators or pattern separators
+var fileExtensionRegex = regexp.MustCompile(`^[^.*?/\\;!\s][^*?/\\;!\s]*$`)
+
+// ResourceRepositoryPolicyBlockedFileTypes schema and implementation for a file path pattern policy that blocks
+// pushes introducing files with the configured extensions
+func ResourceRepositoryPolicyBlockedFileTypes() *schema.Resource {
+	resource := genBasePolicyResource(&policyCrudArgs{
+		FlattenFunc: blockedFileTypesFlattenFunc,
+		ExpandFunc:  blockedFileTypesExpandFunc,
+		PolicyType:  FilePathPattern,
+	})
+
+	resource.Schema["file_extensions"] = &schema.Schema{
+		Type:     schema.TypeSet,
+		Required: true,
+		MinItems: 1,
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: validation.StringMatch(fileExtensionRegex, "file extensions must be specified without the leading dot, wildcards or path separators"),
+		},
+	}
+	resource.Schema["filepath_patterns"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+	return resource
+}
+
+func blockedFileTypesFlattenFunc(d *schema.ResourceData, policyConfig *policy.PolicyConfiguration, projectID *string) error {
+	err := baseFlattenFunc(d, policyConfig, projectID)
+	if err != nil {
+		return err
+	}
+
+	policySettings := policyConfig.Settings.(map[string]interface{})
+	patterns, _ := policySettings["filenamePatterns"].([]interface{})
+	_ = d.Set("filepath_patterns", patterns)
+	_ = d.Set("file_extensions", flattenBlockedFileExtensions(patterns))
+	return nil
+}
+
+func blockedFileTypesExpandFunc(d *schema.ResourceData, typeID uuid.UUID) (*policy.PolicyConfiguration, *string, error) {
+	policyConfig, projectID, err := baseExpandFunc(d, typeID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	policySettings := policyConfig.Settings.(map[string]interface{})
+	policySettings["filenamePatterns"] = expandBlockedFileExtensions(d.Get("file_extensions").(*schema.Set).List())
+	return policyConfig, projectID, nil
+}
+
+// expandBlockedFileExtensions compiles the extensions into sorted file path patterns so that the order of the
+// configured extensions does not affect the policy
+func expandBlockedFileExtensions(extensions []interface{}) []string {
+	patterns := make([]string, 0, len(extensions))
+	for _, extension := range extensions {
+		patterns = append(patterns, "*."+extension.(string))
+	}
+	sort.Strings(patterns)
+	return patterns
+}
+
+// flattenBlockedFileExtensions returns the extensions blocked by the file path patterns. Patterns that do not block a
+// file extension, e.g. added outside of Terraform, are returned as they are so that they show up in the plan.
+func flattenBlockedFileExtensions(patterns []interface{}) []interface{} {
+	extensions := make([]interface{}, 0, len(patterns))
+	for _, pattern := range patterns {
+		value, ok := pattern.(string)
+		if !ok {
+			continue
+		}
+		// Multiple patterns can be specified in a single entry using ";" as separator
+		for _, p := range strings.Split(value, ";") {
+			p = strings.TrimSpace(p)
+			if p == "" {
+				continue
+			}
+			if extension, found := strings.CutPrefix(p, "*."); found && fileExtensionRegex.MatchString(extension) {
+				extensions = append(extensions, extension)
+				continue
+			}
+			extensions = append(extensions, p)
+		}
+	}
+	return extensions
+}
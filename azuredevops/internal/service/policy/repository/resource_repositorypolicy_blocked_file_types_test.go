@@ -0,0 +1,69 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/policy"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepositoryPolicyBlockedFileTypes_Expand_SortedPatterns(t *testing.T) {
+	projectID := uuid.NewString()
+	repoID := uuid.NewString()
+	d := schema.TestResourceDataRaw(t, ResourceRepositoryPolicyBlockedFileTypes().Schema, map[string]interface{}{
+		"project_id":      projectID,
+		"file_extensions": []interface{}{"exe", "dll", "tar.gz"},
+		"repository_ids":  []interface{}{repoID},
+	})
+
+	policyConfig, expandedProjectID, err := blockedFileTypesExpandFunc(d, FilePathPattern)
+	require.Nil(t, err)
+	require.Equal(t, projectID, *expandedProjectID)
+	require.Equal(t, FilePathPattern, *policyConfig.Type.Id)
+
+	settings := policyConfig.Settings.(map[string]interface{})
+	require.Equal(t, []string{"*.dll", "*.exe", "*.tar.gz"}, settings["filenamePatterns"])
+	require.Equal(t, repoID, settings["scope"].([]map[string]interface{})[0]["repositoryId"])
+}
+
+func TestRepositoryPolicyBlockedFileTypes_Flatten_ReportsForeignPatterns(t *testing.T) {
+	projectID := uuid.NewString()
+	d := schema.TestResourceDataRaw(t, ResourceRepositoryPolicyBlockedFileTypes().Schema, map[string]interface{}{
+		"project_id":      projectID,
+		"file_extensions": []interface{}{"exe", "dll"},
+	})
+
+	patterns := []interface{}{"*.dll", "*.exe;/src/*.exe", "*.*"}
+	require.Nil(t, blockedFileTypesFlattenFunc(d, &policy.PolicyConfiguration{
+		Id:         converter.Int(1),
+		IsEnabled:  converter.Bool(true),
+		IsBlocking: converter.Bool(true),
+		Settings: map[string]interface{}{
+			"filenamePatterns": patterns,
+			"scope":            []interface{}{map[string]interface{}{"repositoryId": nil}},
+		},
+	}, &projectID))
+
+	require.Equal(t, patterns, d.Get("filepath_patterns"))
+	extensions := d.Get("file_extensions").(*schema.Set)
+	require.Equal(t, 4, extensions.Len())
+	for _, value := range []string{"dll", "exe", "/src/*.exe", "*.*"} {
+		require.True(t, extensions.Contains(value), value)
+	}
+}
+
+func TestRepositoryPolicyBlockedFileTypes_ValidateExtension(t *testing.T) {
+	validateFunc := ResourceRepositoryPolicyBlockedFileTypes().Schema["file_extensions"].Elem.(*schema.Schema).ValidateFunc
+
+	for _, extension := range []string{"exe", "tar.gz", "DLL", "c++"} {
+		_, errs := validateFunc(extension, "file_extensions")
+		require.Empty(t, errs, extension)
+	}
+	for _, extension := range []string{"", ".exe", "*.exe", "ex?", "bin/exe", "exe;dll", "!exe", "e xe"} {
+		_, errs := validateFunc(extension, "file_extensions")
+		require.NotEmpty(t, errs, extension)
+	}
+}
@@ -0,0 +1,216 @@
+package testplan
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/test"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/testplan"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
+)
+
+// ResourceTestConfiguration schema and implementation for test configuration resource
+func ResourceTestConfiguration() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceTestConfigurationCreate,
+		ReadContext:   resourceTestConfigurationRead,
+		UpdateContext: resourceTestConfigurationUpdate,
+		DeleteContext: resourceTestConfigurationDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(2 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Importer: tfhelper.ImportProjectQualifiedResourceInteger(),
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"state": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  string(test.TestConfigurationStateValues.Active),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(test.TestConfigurationStateValues.Active),
+					string(test.TestConfigurationStateValues.Inactive),
+				}, false),
+			},
+
+			// Default configurations are assigned to new test cases of all test plans
+			"is_default": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			// Every variable must exist as a test variable of the project and the value must be one of
+			// the allowed values of that variable.
+			"variable": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+						"value": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceTestConfigurationCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+
+	configuration, err := clients.TestPlanClient.CreateTestConfiguration(clients.Ctx, testplan.CreateTestConfigurationArgs{
+		Project:                                 converter.String(d.Get("project_id").(string)),
+		TestConfigurationCreateUpdateParameters: expandTestConfiguration(d),
+	})
+	if err != nil {
+		return diag.Errorf(" Creating test configuration. Error: %+v", err)
+	}
+
+	if configuration == nil || configuration.Id == nil {
+		return diag.Errorf(" Creating test configuration. Error: the service returned no configuration ID")
+	}
+
+	d.SetId(strconv.Itoa(*configuration.Id))
+	return resourceTestConfigurationRead(clients.Ctx, d, m)
+}
+
+func resourceTestConfigurationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+
+	configurationID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.Errorf(" Parsing test configuration ID: %s. Error: %+v", d.Id(), err)
+	}
+
+	configuration, err := clients.TestPlanClient.GetTestConfigurationById(clients.Ctx, testplan.GetTestConfigurationByIdArgs{
+		Project:             converter.String(d.Get("project_id").(string)),
+		TestConfigurationId: &configurationID,
+	})
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf(" Getting test configuration with ID: %s. Error: %+v", d.Id(), err)
+	}
+
+	d.Set("name", converter.ToString(configuration.Name, ""))
+	d.Set("description", converter.ToString(configuration.Description, ""))
+	if configuration.State != nil {
+		d.Set("state", string(*configuration.State))
+	}
+	d.Set("is_default", converter.ToBool(configuration.IsDefault, false))
+	if err := d.Set("variable", flattenTestConfigurationVariables(configuration.Values)); err != nil {
+		return diag.Errorf(" Setting variable. Error: %+v", err)
+	}
+	return nil
+}
+
+func resourceTestConfigurationUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+
+	configurationID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.Errorf(" Parsing test configuration ID: %s. Error: %+v", d.Id(), err)
+	}
+
+	_, err = clients.TestPlanClient.UpdateTestConfiguration(clients.Ctx, testplan.UpdateTestConfigurationArgs{
+		Project:                                 converter.String(d.Get("project_id").(string)),
+		TestConfiguartionId:                     &configurationID,
+		TestConfigurationCreateUpdateParameters: expandTestConfiguration(d),
+	})
+	if err != nil {
+		return diag.Errorf(" Updating test configuration with ID: %s. Error: %+v", d.Id(), err)
+	}
+	return resourceTestConfigurationRead(clients.Ctx, d, m)
+}
+
+func resourceTestConfigurationDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+
+	configurationID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.Errorf(" Parsing test configuration ID: %s. Error: %+v", d.Id(), err)
+	}
+
+	err = clients.TestPlanClient.DeleteTestConfguration(clients.Ctx, testplan.DeleteTestConfgurationArgs{
+		Project:             converter.String(d.Get("project_id").(string)),
+		TestConfiguartionId: &configurationID,
+	})
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			return nil
+		}
+		return diag.Errorf(" Deleting test configuration with ID: %s. Error: %+v", d.Id(), err)
+	}
+	return nil
+}
+
+func expandTestConfiguration(d *schema.ResourceData) *testplan.TestConfigurationCreateUpdateParameters {
+	values := []test.NameValuePair{}
+	for _, raw := range d.Get("variable").(*schema.Set).List() {
+		variable := raw.(map[string]interface{})
+		values = append(values, test.NameValuePair{
+			Name:  converter.String(variable["name"].(string)),
+			Value: converter.String(variable["value"].(string)),
+		})
+	}
+
+	state := test.TestConfigurationState(d.Get("state").(string))
+	return &testplan.TestConfigurationCreateUpdateParameters{
+		Name:        converter.String(d.Get("name").(string)),
+		Description: converter.String(d.Get("description").(string)),
+		State:       &state,
+		IsDefault:   converter.Bool(d.Get("is_default").(bool)),
+		Values:      &values,
+	}
+}
+
+func flattenTestConfigurationVariables(values *[]test.NameValuePair) []interface{} {
+	variables := []interface{}{}
+	if values == nil {
+		return variables
+	}
+	for _, value := range *values {
+		variables = append(variables, map[string]interface{}{
+			"name":  converter.ToString(value.Name, ""),
+			"value": converter.ToString(value.Value, ""),
+		})
+	}
+	return variables
+}
@@ -0,0 +1,171 @@
+package testplan
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/test"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/testplan"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func getTestConfigurationResourceData(t *testing.T) *schema.ResourceData {
+	return schema.TestResourceDataRaw(t, ResourceTestConfiguration().Schema, map[string]interface{}{
+		"project_id":  testPlanProjectID,
+		"name":        "Windows 11 - Edge",
+		"description": "Windows 11 with the latest Edge",
+		"variable": []interface{}{
+			map[string]interface{}{"name": "Operating System", "value": "Windows 11"},
+			map[string]interface{}{"name": "Browser", "value": "Edge"},
+		},
+	})
+}
+
+func TestTestConfiguration_Create_ExpandsVariables(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := azdosdkmocks.NewMockTestplanClient(ctrl)
+	clients := &client.AggregatedClient{TestPlanClient: mockClient, Ctx: context.Background()}
+
+	inactive := test.TestConfigurationStateValues.Inactive
+	mockClient.EXPECT().CreateTestConfiguration(clients.Ctx, gomock.Any()).DoAndReturn(
+		func(ctx context.Context, args testplan.CreateTestConfigurationArgs) (*testplan.TestConfiguration, error) {
+			params := args.TestConfigurationCreateUpdateParameters
+			require.Equal(t, testPlanProjectID, *args.Project)
+			require.Equal(t, "Windows 11 - Edge", *params.Name)
+			require.Equal(t, test.TestConfigurationStateValues.Active, *params.State)
+			require.False(t, *params.IsDefault)
+			require.ElementsMatch(t, []test.NameValuePair{
+				{Name: converter.String("Operating System"), Value: converter.String("Windows 11")},
+				{Name: converter.String("Browser"), Value: converter.String("Edge")},
+			}, *params.Values)
+			return &testplan.TestConfiguration{Id: converter.Int(12)}, nil
+		}).Times(1)
+
+	mockClient.EXPECT().GetTestConfigurationById(clients.Ctx, testplan.GetTestConfigurationByIdArgs{
+		Project:             converter.String(testPlanProjectID),
+		TestConfigurationId: converter.Int(12),
+	}).Return(&testplan.TestConfiguration{
+		Id:          converter.Int(12),
+		Name:        converter.String("Windows 11 - Edge"),
+		Description: converter.String("Windows 11 with the latest Edge"),
+		State:       &inactive,
+		IsDefault:   converter.Bool(true),
+		Values: &[]test.NameValuePair{
+			{Name: converter.String("Browser"), Value: converter.String("Edge")},
+			{Name: converter.String("Operating System"), Value: converter.String("Windows 11")},
+		},
+	}, nil).Times(1)
+
+	d := getTestConfigurationResourceData(t)
+	diags := resourceTestConfigurationCreate(context.Background(), d, clients)
+	require.Empty(t, diags)
+	assert.Equal(t, "12", d.Id())
+	assert.Equal(t, string(inactive), d.Get("state"))
+	assert.True(t, d.Get("is_default").(bool))
+	assert.ElementsMatch(t, []interface{}{
+		map[string]interface{}{"name": "Operating System", "value": "Windows 11"},
+		map[string]interface{}{"name": "Browser", "value": "Edge"},
+	}, d.Get("variable").(*schema.Set).List())
+}
+
+func TestTestConfiguration_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := azdosdkmocks.NewMockTestplanClient(ctrl)
+	clients := &client.AggregatedClient{TestPlanClient: mockClient, Ctx: context.Background()}
+
+	mockClient.EXPECT().CreateTestConfiguration(clients.Ctx, gomock.Any()).Return(nil, errors.New("CreateTestConfiguration() Failed")).Times(1)
+
+	d := getTestConfigurationResourceData(t)
+	diags := resourceTestConfigurationCreate(context.Background(), d, clients)
+	require.NotEmpty(t, diags)
+	require.Contains(t, diags[0].Summary, "CreateTestConfiguration() Failed")
+	assert.Empty(t, d.Id())
+}
+
+func TestTestConfiguration_Flatten_NoVariables(t *testing.T) {
+	require.Equal(t, []interface{}{}, flattenTestConfigurationVariables(nil))
+	require.Equal(t, []interface{}{
+		map[string]interface{}{"name": "Browser", "value": ""},
+	}, flattenTestConfigurationVariables(&[]test.NameValuePair{{Name: converter.String("Browser")}}))
+}
+
+func TestTestConfiguration_Read_NotFound_ClearsID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := azdosdkmocks.NewMockTestplanClient(ctrl)
+	clients := &client.AggregatedClient{TestPlanClient: mockClient, Ctx: context.Background()}
+
+	code := 404
+	mockClient.EXPECT().GetTestConfigurationById(clients.Ctx, gomock.Any()).Return(nil, azuredevops.WrappedError{StatusCode: &code}).Times(1)
+
+	d := getTestConfigurationResourceData(t)
+	d.SetId("12")
+	diags := resourceTestConfigurationRead(context.Background(), d, clients)
+	require.Empty(t, diags)
+	assert.Empty(t, d.Id())
+}
+
+func TestTestConfiguration_Read_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := azdosdkmocks.NewMockTestplanClient(ctrl)
+	clients := &client.AggregatedClient{TestPlanClient: mockClient, Ctx: context.Background()}
+
+	mockClient.EXPECT().GetTestConfigurationById(clients.Ctx, gomock.Any()).Return(nil, errors.New("GetTestConfigurationById() Failed")).Times(1)
+
+	d := getTestConfigurationResourceData(t)
+	d.SetId("12")
+	diags := resourceTestConfigurationRead(context.Background(), d, clients)
+	require.NotEmpty(t, diags)
+	require.Contains(t, diags[0].Summary, "GetTestConfigurationById() Failed")
+	assert.Equal(t, "12", d.Id())
+}
+
+func TestTestConfiguration_Update_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := azdosdkmocks.NewMockTestplanClient(ctrl)
+	clients := &client.AggregatedClient{TestPlanClient: mockClient, Ctx: context.Background()}
+
+	mockClient.EXPECT().UpdateTestConfiguration(clients.Ctx, gomock.Any()).DoAndReturn(
+		func(ctx context.Context, args testplan.UpdateTestConfigurationArgs) (*testplan.TestConfiguration, error) {
+			require.Equal(t, 12, *args.TestConfiguartionId)
+			return nil, errors.New("UpdateTestConfiguration() Failed")
+		}).Times(1)
+
+	d := getTestConfigurationResourceData(t)
+	d.SetId("12")
+	diags := resourceTestConfigurationUpdate(context.Background(), d, clients)
+	require.NotEmpty(t, diags)
+	require.Contains(t, diags[0].Summary, "UpdateTestConfiguration() Failed")
+}
+
+func TestTestConfiguration_Delete_NotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := azdosdkmocks.NewMockTestplanClient(ctrl)
+	clients := &client.AggregatedClient{TestPlanClient: mockClient, Ctx: context.Background()}
+
+	code := 404
+	mockClient.EXPECT().DeleteTestConfguration(clients.Ctx, gomock.Any()).Return(azuredevops.WrappedError{StatusCode: &code}).Times(1)
+
+	d := getTestConfigurationResourceData(t)
+	d.SetId("12")
+	require.Empty(t, resourceTestConfigurationDelete(context.Background(), d, clients))
+}
@@ -0,0 +1,279 @@
+package testplan
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/test"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/testplan"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/suppress"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
+)
+
+const (
+	testPlanStateActive   = "Active"
+	testPlanStateInactive = "Inactive"
+)
+
+// ResourceTestPlan schema and implementation for test plan resource
+func ResourceTestPlan() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceTestPlanCreate,
+		ReadContext:   resourceTestPlanRead,
+		UpdateContext: resourceTestPlanUpdate,
+		DeleteContext: resourceTestPlanDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(2 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Importer: tfhelper.ImportProjectQualifiedResourceInteger(),
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+
+			"iteration": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+
+			"area_path": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			// The service defaults the start date to the creation time and the end date to one week later.
+			"start_date": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: suppress.RFC3339TimeDifference,
+			},
+
+			"end_date": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: suppress.RFC3339TimeDifference,
+			},
+
+			"state": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      testPlanStateActive,
+				ValidateFunc: validation.StringInSlice([]string{testPlanStateActive, testPlanStateInactive}, false),
+			},
+
+			"sync_outcome_across_suites": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"root_suite_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"revision": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceTestPlanCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+
+	startDate, endDate, err := expandTestPlanDates(d)
+	if err != nil {
+		return diag.Errorf(" Expanding test plan dates. Error: %+v", err)
+	}
+
+	plan, err := clients.TestPlanClient.CreateTestPlan(clients.Ctx, testplan.CreateTestPlanArgs{
+		Project: converter.String(d.Get("project_id").(string)),
+		TestPlanCreateParams: &testplan.TestPlanCreateParams{
+			Name:        converter.String(d.Get("name").(string)),
+			Iteration:   converter.String(d.Get("iteration").(string)),
+			AreaPath:    expandTestPlanAreaPath(d),
+			Description: converter.String(d.Get("description").(string)),
+			StartDate:   startDate,
+			EndDate:     endDate,
+			State:       converter.String(d.Get("state").(string)),
+			TestOutcomeSettings: &test.TestOutcomeSettings{
+				SyncOutcomeAcrossSuites: converter.Bool(d.Get("sync_outcome_across_suites").(bool)),
+			},
+		},
+	})
+	if err != nil {
+		return diag.Errorf(" Creating test plan. Error: %+v", err)
+	}
+
+	if plan == nil || plan.Id == nil {
+		return diag.Errorf(" Creating test plan. Error: the service returned no plan ID")
+	}
+
+	d.SetId(strconv.Itoa(*plan.Id))
+	return resourceTestPlanRead(clients.Ctx, d, m)
+}
+
+func resourceTestPlanRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+
+	planID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.Errorf(" Parsing test plan ID: %s. Error: %+v", d.Id(), err)
+	}
+
+	plan, err := clients.TestPlanClient.GetTestPlanById(clients.Ctx, testplan.GetTestPlanByIdArgs{
+		Project: converter.String(d.Get("project_id").(string)),
+		PlanId:  &planID,
+	})
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf(" Getting test plan with ID: %s. Error: %+v", d.Id(), err)
+	}
+
+	d.Set("name", converter.ToString(plan.Name, ""))
+	d.Set("iteration", converter.ToString(plan.Iteration, ""))
+	d.Set("area_path", converter.ToString(plan.AreaPath, ""))
+	d.Set("description", converter.ToString(plan.Description, ""))
+	d.Set("state", converter.ToString(plan.State, testPlanStateActive))
+	if plan.StartDate != nil {
+		d.Set("start_date", plan.StartDate.Time.UTC().Format(time.RFC3339))
+	}
+	if plan.EndDate != nil {
+		d.Set("end_date", plan.EndDate.Time.UTC().Format(time.RFC3339))
+	}
+	syncOutcome := false
+	if plan.TestOutcomeSettings != nil {
+		syncOutcome = converter.ToBool(plan.TestOutcomeSettings.SyncOutcomeAcrossSuites, false)
+	}
+	d.Set("sync_outcome_across_suites", syncOutcome)
+	if plan.RootSuite != nil && plan.RootSuite.Id != nil {
+		d.Set("root_suite_id", *plan.RootSuite.Id)
+	}
+	if plan.Revision != nil {
+		d.Set("revision", *plan.Revision)
+	}
+	return nil
+}
+
+func resourceTestPlanUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+
+	planID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.Errorf(" Parsing test plan ID: %s. Error: %+v", d.Id(), err)
+	}
+
+	startDate, endDate, err := expandTestPlanDates(d)
+	if err != nil {
+		return diag.Errorf(" Expanding test plan dates. Error: %+v", err)
+	}
+
+	_, err = clients.TestPlanClient.UpdateTestPlan(clients.Ctx, testplan.UpdateTestPlanArgs{
+		Project: converter.String(d.Get("project_id").(string)),
+		PlanId:  &planID,
+		TestPlanUpdateParams: &testplan.TestPlanUpdateParams{
+			Name:        converter.String(d.Get("name").(string)),
+			Iteration:   converter.String(d.Get("iteration").(string)),
+			AreaPath:    expandTestPlanAreaPath(d),
+			Description: converter.String(d.Get("description").(string)),
+			StartDate:   startDate,
+			EndDate:     endDate,
+			State:       converter.String(d.Get("state").(string)),
+			TestOutcomeSettings: &test.TestOutcomeSettings{
+				SyncOutcomeAcrossSuites: converter.Bool(d.Get("sync_outcome_across_suites").(bool)),
+			},
+		},
+	})
+	if err != nil {
+		return diag.Errorf(" Updating test plan with ID: %s. Error: %+v", d.Id(), err)
+	}
+	return resourceTestPlanRead(clients.Ctx, d, m)
+}
+
+func resourceTestPlanDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+
+	planID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.Errorf(" Parsing test plan ID: %s. Error: %+v", d.Id(), err)
+	}
+
+	err = clients.TestPlanClient.DeleteTestPlan(clients.Ctx, testplan.DeleteTestPlanArgs{
+		Project: converter.String(d.Get("project_id").(string)),
+		PlanId:  &planID,
+	})
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			return nil
+		}
+		return diag.Errorf(" Deleting test plan with ID: %s. Error: %+v", d.Id(), err)
+	}
+	return nil
+}
+
+// The area path is only sent if configured, otherwise the service uses the root area of the project.
+func expandTestPlanAreaPath(d *schema.ResourceData) *string {
+	if v, ok := d.GetOk("area_path"); ok {
+		return converter.String(v.(string))
+	}
+	return nil
+}
+
+func expandTestPlanDates(d *schema.ResourceData) (*azuredevops.Time, *azuredevops.Time, error) {
+	var dates []*azuredevops.Time
+	for _, key := range []string{"start_date", "end_date"} {
+		v, ok := d.GetOk(key)
+		if !ok {
+			dates = append(dates, nil)
+			continue
+		}
+		date, err := time.Parse(time.RFC3339, v.(string))
+		if err != nil {
+			return nil, nil, fmt.Errorf("parsing %s %q: %+v", key, v, err)
+		}
+		dates = append(dates, &azuredevops.Time{Time: date})
+	}
+
+	if dates[0] != nil && dates[1] != nil && dates[1].Time.Before(dates[0].Time) {
+		return nil, nil, fmt.Errorf("end_date %s must not be before start_date %s", d.Get("end_date"), d.Get("start_date"))
+	}
+	return dates[0], dates[1], nil
+}
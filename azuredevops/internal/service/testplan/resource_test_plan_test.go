@@ -0,0 +1,118 @@
+package testplan
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/test"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/testplan"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var testPlanProjectID = uuid.NewString()
+
+func getTestPlanResourceData(t *testing.T, startDate string, endDate string) *schema.ResourceData {
+	return schema.TestResourceDataRaw(t, ResourceTestPlan().Schema, map[string]interface{}{
+		"project_id": testPlanProjectID,
+		"name":       "Release 1 regression",
+		"iteration":  "project\\Release 1",
+		"start_date": startDate,
+		"end_date":   endDate,
+	})
+}
+
+func TestTestPlan_Create_ExpandsDates(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := azdosdkmocks.NewMockTestplanClient(ctrl)
+	clients := &client.AggregatedClient{TestPlanClient: mockClient, Ctx: context.Background()}
+
+	mockClient.EXPECT().CreateTestPlan(clients.Ctx, gomock.Any()).DoAndReturn(
+		func(ctx context.Context, args testplan.CreateTestPlanArgs) (*testplan.TestPlan, error) {
+			require.Equal(t, testPlanProjectID, *args.Project)
+			require.Equal(t, "project\\Release 1", *args.TestPlanCreateParams.Iteration)
+			require.Nil(t, args.TestPlanCreateParams.AreaPath)
+			require.Equal(t, testPlanStateActive, *args.TestPlanCreateParams.State)
+			require.Equal(t, time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC), args.TestPlanCreateParams.StartDate.Time.UTC())
+			require.Equal(t, time.Date(2025, 3, 15, 0, 0, 0, 0, time.UTC), args.TestPlanCreateParams.EndDate.Time.UTC())
+			require.False(t, *args.TestPlanCreateParams.TestOutcomeSettings.SyncOutcomeAcrossSuites)
+			return &testplan.TestPlan{Id: converter.Int(42)}, nil
+		}).Times(1)
+
+	mockClient.EXPECT().GetTestPlanById(clients.Ctx, gomock.Any()).Return(&testplan.TestPlan{
+		Id:                  converter.Int(42),
+		Name:                converter.String("Release 1 regression"),
+		Iteration:           converter.String("project\\Release 1"),
+		AreaPath:            converter.String("project"),
+		State:               converter.String(testPlanStateActive),
+		StartDate:           &azuredevops.Time{Time: time.Date(2025, 3, 1, 1, 0, 0, 0, time.FixedZone("CET", 3600))},
+		EndDate:             &azuredevops.Time{Time: time.Date(2025, 3, 15, 0, 0, 0, 0, time.UTC)},
+		TestOutcomeSettings: &test.TestOutcomeSettings{SyncOutcomeAcrossSuites: converter.Bool(false)},
+		RootSuite:           &testplan.TestSuiteReference{Id: converter.Int(43)},
+		Revision:            converter.Int(1),
+	}, nil).Times(1)
+
+	d := getTestPlanResourceData(t, "2025-03-01T00:00:00Z", "2025-03-15T00:00:00Z")
+	diags := resourceTestPlanCreate(context.Background(), d, clients)
+	require.Empty(t, diags)
+	assert.Equal(t, "42", d.Id())
+	assert.Equal(t, 43, d.Get("root_suite_id"))
+	assert.Equal(t, "project", d.Get("area_path"))
+	assert.Equal(t, "2025-03-01T00:00:00Z", d.Get("start_date"))
+}
+
+func TestTestPlan_Create_RejectsEndDateBeforeStartDate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := azdosdkmocks.NewMockTestplanClient(ctrl)
+	clients := &client.AggregatedClient{TestPlanClient: mockClient, Ctx: context.Background()}
+
+	d := getTestPlanResourceData(t, "2025-03-15T00:00:00Z", "2025-03-01T00:00:00Z")
+	diags := resourceTestPlanCreate(context.Background(), d, clients)
+	require.NotEmpty(t, diags)
+	require.Contains(t, diags[0].Summary, "must not be before start_date")
+}
+
+func TestTestPlan_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := azdosdkmocks.NewMockTestplanClient(ctrl)
+	clients := &client.AggregatedClient{TestPlanClient: mockClient, Ctx: context.Background()}
+
+	mockClient.EXPECT().CreateTestPlan(clients.Ctx, gomock.Any()).Return(nil, errors.New("CreateTestPlan() Failed")).Times(1)
+
+	d := getTestPlanResourceData(t, "", "")
+	diags := resourceTestPlanCreate(context.Background(), d, clients)
+	require.NotEmpty(t, diags)
+	require.Contains(t, diags[0].Summary, "CreateTestPlan() Failed")
+}
+
+func TestTestPlan_Read_NotFound_ClearsID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := azdosdkmocks.NewMockTestplanClient(ctrl)
+	clients := &client.AggregatedClient{TestPlanClient: mockClient, Ctx: context.Background()}
+
+	code := 404
+	mockClient.EXPECT().GetTestPlanById(clients.Ctx, gomock.Any()).Return(nil, azuredevops.WrappedError{StatusCode: &code}).Times(1)
+
+	d := getTestPlanResourceData(t, "", "")
+	d.SetId("42")
+	diags := resourceTestPlanRead(context.Background(), d, clients)
+	require.Empty(t, diags)
+	assert.Empty(t, d.Id())
+}
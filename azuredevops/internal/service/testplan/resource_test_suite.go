@@ -0,0 +1,366 @@
+package testplan
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/testplan"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
+)
+
+const (
+	testSuiteTypeStatic      = "static"
+	testSuiteTypeRequirement = "requirement_based"
+	testSuiteTypeQuery       = "query_based"
+)
+
+var testSuiteTypes = map[string]testplan.TestSuiteType{
+	testSuiteTypeStatic:      testplan.TestSuiteTypeValues.StaticTestSuite,
+	testSuiteTypeRequirement: testplan.TestSuiteTypeValues.RequirementTestSuite,
+	testSuiteTypeQuery:       testplan.TestSuiteTypeValues.DynamicTestSuite,
+}
+
+// ResourceTestSuite schema and implementation for test suite resource
+func ResourceTestSuite() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceTestSuiteCreate,
+		ReadContext:   resourceTestSuiteRead,
+		UpdateContext: resourceTestSuiteUpdate,
+		DeleteContext: resourceTestSuiteDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(2 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: importTestSuite,
+		},
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+			if !d.NewValueKnown("requirement_id") || !d.NewValueKnown("query_string") {
+				return nil
+			}
+			return validateTestSuiteType(d.Get("type").(string), !d.GetRawConfig().GetAttr("name").IsNull(), d.Get("requirement_id").(int), d.Get("query_string").(string))
+		},
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"test_plan_id": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			// Defaults to the root suite of the test plan
+			"parent_suite_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      testSuiteTypeStatic,
+				ValidateFunc: validation.StringInSlice([]string{testSuiteTypeStatic, testSuiteTypeRequirement, testSuiteTypeQuery}, false),
+			},
+
+			// The name of requirement-based suites is derived from the requirement by the service.
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+
+			"requirement_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"query_string": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+
+			// The suite inherits the default configurations of its parent unless any are specified.
+			"default_configuration_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeInt,
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+			},
+
+			"revision": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceTestSuiteCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+
+	projectID := d.Get("project_id").(string)
+	planID := d.Get("test_plan_id").(int)
+
+	parentSuiteID := d.Get("parent_suite_id").(int)
+	if parentSuiteID == 0 {
+		plan, err := clients.TestPlanClient.GetTestPlanById(clients.Ctx, testplan.GetTestPlanByIdArgs{
+			Project: converter.String(projectID),
+			PlanId:  &planID,
+		})
+		if err != nil {
+			return diag.Errorf(" Getting test plan with ID: %d. Error: %+v", planID, err)
+		}
+		if plan.RootSuite == nil || plan.RootSuite.Id == nil {
+			return diag.Errorf(" Getting test plan with ID: %d. Error: the test plan has no root suite", planID)
+		}
+		parentSuiteID = *plan.RootSuite.Id
+	}
+
+	suiteType := testSuiteTypes[d.Get("type").(string)]
+	params := &testplan.TestSuiteCreateParams{
+		SuiteType:   &suiteType,
+		ParentSuite: &testplan.TestSuiteReference{Id: &parentSuiteID},
+	}
+	if v, ok := d.GetOk("name"); ok {
+		params.Name = converter.String(v.(string))
+	}
+	if v, ok := d.GetOk("requirement_id"); ok {
+		params.RequirementId = converter.Int(v.(int))
+	}
+	if v, ok := d.GetOk("query_string"); ok {
+		params.QueryString = converter.String(v.(string))
+	}
+	params.InheritDefaultConfigurations, params.DefaultConfigurations = expandTestSuiteDefaultConfigurations(d)
+
+	suite, err := clients.TestPlanClient.CreateTestSuite(clients.Ctx, testplan.CreateTestSuiteArgs{
+		Project:               converter.String(projectID),
+		PlanId:                &planID,
+		TestSuiteCreateParams: params,
+	})
+	if err != nil {
+		return diag.Errorf(" Creating test suite in test plan %d. Error: %+v", planID, err)
+	}
+
+	if suite == nil || suite.Id == nil {
+		return diag.Errorf(" Creating test suite in test plan %d. Error: the service returned no suite ID", planID)
+	}
+
+	d.SetId(strconv.Itoa(*suite.Id))
+	return resourceTestSuiteRead(clients.Ctx, d, m)
+}
+
+func resourceTestSuiteRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+
+	suiteID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.Errorf(" Parsing test suite ID: %s. Error: %+v", d.Id(), err)
+	}
+
+	suite, err := clients.TestPlanClient.GetTestSuiteById(clients.Ctx, testplan.GetTestSuiteByIdArgs{
+		Project: converter.String(d.Get("project_id").(string)),
+		PlanId:  converter.Int(d.Get("test_plan_id").(int)),
+		SuiteId: &suiteID,
+	})
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf(" Getting test suite with ID: %s. Error: %+v", d.Id(), err)
+	}
+
+	d.Set("name", converter.ToString(suite.Name, ""))
+	if suite.ParentSuite != nil && suite.ParentSuite.Id != nil {
+		d.Set("parent_suite_id", *suite.ParentSuite.Id)
+	}
+	if suite.SuiteType != nil {
+		for name, suiteType := range testSuiteTypes {
+			if strings.EqualFold(string(suiteType), string(*suite.SuiteType)) {
+				d.Set("type", name)
+			}
+		}
+	}
+	if suite.RequirementId != nil {
+		d.Set("requirement_id", *suite.RequirementId)
+	}
+	d.Set("query_string", converter.ToString(suite.QueryString, ""))
+	d.Set("default_configuration_ids", flattenTestSuiteDefaultConfigurations(suite))
+	if suite.Revision != nil {
+		d.Set("revision", *suite.Revision)
+	}
+	return nil
+}
+
+func resourceTestSuiteUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+
+	suiteID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.Errorf(" Parsing test suite ID: %s. Error: %+v", d.Id(), err)
+	}
+	projectID := d.Get("project_id").(string)
+	planID := d.Get("test_plan_id").(int)
+
+	// The service rejects updates that are not based on the latest revision of the suite, which changes
+	// whenever test cases are added to the suite.
+	existing, err := clients.TestPlanClient.GetTestSuiteById(clients.Ctx, testplan.GetTestSuiteByIdArgs{
+		Project: converter.String(projectID),
+		PlanId:  &planID,
+		SuiteId: &suiteID,
+	})
+	if err != nil {
+		return diag.Errorf(" Getting test suite with ID: %s. Error: %+v", d.Id(), err)
+	}
+
+	params := &testplan.TestSuiteUpdateParams{
+		Revision: existing.Revision,
+	}
+	if d.HasChange("name") {
+		params.Name = converter.String(d.Get("name").(string))
+	}
+	if d.HasChange("parent_suite_id") {
+		params.ParentSuite = &testplan.TestSuiteReference{Id: converter.Int(d.Get("parent_suite_id").(int))}
+	}
+	if d.HasChange("query_string") {
+		params.QueryString = converter.String(d.Get("query_string").(string))
+	}
+	params.InheritDefaultConfigurations, params.DefaultConfigurations = expandTestSuiteDefaultConfigurations(d)
+
+	_, err = clients.TestPlanClient.UpdateTestSuite(clients.Ctx, testplan.UpdateTestSuiteArgs{
+		Project:               converter.String(projectID),
+		PlanId:                &planID,
+		SuiteId:               &suiteID,
+		TestSuiteUpdateParams: params,
+	})
+	if err != nil {
+		return diag.Errorf(" Updating test suite with ID: %s. Error: %+v", d.Id(), err)
+	}
+	return resourceTestSuiteRead(clients.Ctx, d, m)
+}
+
+func resourceTestSuiteDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+
+	suiteID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.Errorf(" Parsing test suite ID: %s. Error: %+v", d.Id(), err)
+	}
+
+	err = clients.TestPlanClient.DeleteTestSuite(clients.Ctx, testplan.DeleteTestSuiteArgs{
+		Project: converter.String(d.Get("project_id").(string)),
+		PlanId:  converter.Int(d.Get("test_plan_id").(int)),
+		SuiteId: &suiteID,
+	})
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			return nil
+		}
+		return diag.Errorf(" Deleting test suite with ID: %s. Error: %+v", d.Id(), err)
+	}
+	return nil
+}
+
+// importTestSuite imports a test suite by an ID that looks like <project name or ID>/<test plan ID>/<test suite ID>
+func importTestSuite(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 3 || parts[0] == "" {
+		return nil, fmt.Errorf("unexpected format of ID (%s), expected <project name or ID>/<test plan ID>/<test suite ID>", d.Id())
+	}
+
+	planID, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return nil, fmt.Errorf("test plan ID was expected to be integer, but was not: %+v", err)
+	}
+	if _, err := strconv.Atoi(parts[2]); err != nil {
+		return nil, fmt.Errorf("test suite ID was expected to be integer, but was not: %+v", err)
+	}
+
+	projectID, err := tfhelper.GetRealProjectId(parts[0], m)
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("project_id", projectID)
+	d.Set("test_plan_id", planID)
+	d.SetId(parts[2])
+	return []*schema.ResourceData{d}, nil
+}
+
+// validateTestSuiteType checks that the arguments of a suite match its type
+func validateTestSuiteType(suiteType string, hasName bool, requirementID int, queryString string) error {
+	switch suiteType {
+	case testSuiteTypeStatic:
+		if !hasName {
+			return fmt.Errorf("`name` is required for %s test suites", suiteType)
+		}
+		if requirementID != 0 || queryString != "" {
+			return fmt.Errorf("`requirement_id` and `query_string` cannot be specified for %s test suites", suiteType)
+		}
+	case testSuiteTypeRequirement:
+		if requirementID == 0 {
+			return fmt.Errorf("`requirement_id` is required for %s test suites", suiteType)
+		}
+		if hasName || queryString != "" {
+			return fmt.Errorf("`name` and `query_string` cannot be specified for %s test suites, the name is derived from the requirement", suiteType)
+		}
+	case testSuiteTypeQuery:
+		if !hasName || queryString == "" {
+			return fmt.Errorf("`name` and `query_string` are required for %s test suites", suiteType)
+		}
+		if requirementID != 0 {
+			return fmt.Errorf("`requirement_id` cannot be specified for %s test suites", suiteType)
+		}
+	}
+	return nil
+}
+
+func expandTestSuiteDefaultConfigurations(d *schema.ResourceData) (*bool, *[]testplan.TestConfigurationReference) {
+	configurations := []testplan.TestConfigurationReference{}
+	for _, id := range d.Get("default_configuration_ids").(*schema.Set).List() {
+		configurations = append(configurations, testplan.TestConfigurationReference{Id: converter.Int(id.(int))})
+	}
+	if len(configurations) == 0 {
+		return converter.Bool(true), nil
+	}
+	return converter.Bool(false), &configurations
+}
+
+func flattenTestSuiteDefaultConfigurations(suite *testplan.TestSuite) []interface{} {
+	ids := []interface{}{}
+	if converter.ToBool(suite.InheritDefaultConfigurations, false) || suite.DefaultConfigurations == nil {
+		return ids
+	}
+	for _, configuration := range *suite.DefaultConfigurations {
+		if configuration.Id != nil {
+			ids = append(ids, *configuration.Id)
+		}
+	}
+	return ids
+}
@@ -0,0 +1,132 @@
+package testplan
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/testplan"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var testSuiteProjectID = uuid.NewString()
+
+func TestTestSuite_ValidateType(t *testing.T) {
+	cases := []struct {
+		Name          string
+		SuiteType     string
+		HasName       bool
+		RequirementID int
+		QueryString   string
+		Error         string
+	}{
+		{Name: "static", SuiteType: testSuiteTypeStatic, HasName: true},
+		{Name: "static without name", SuiteType: testSuiteTypeStatic, Error: "`name` is required"},
+		{Name: "static with query", SuiteType: testSuiteTypeStatic, HasName: true, QueryString: "SELECT", Error: "cannot be specified"},
+		{Name: "requirement", SuiteType: testSuiteTypeRequirement, RequirementID: 12},
+		{Name: "requirement without ID", SuiteType: testSuiteTypeRequirement, Error: "`requirement_id` is required"},
+		{Name: "requirement with name", SuiteType: testSuiteTypeRequirement, HasName: true, RequirementID: 12, Error: "derived from the requirement"},
+		{Name: "query", SuiteType: testSuiteTypeQuery, HasName: true, QueryString: "SELECT"},
+		{Name: "query without query string", SuiteType: testSuiteTypeQuery, HasName: true, Error: "are required"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			err := validateTestSuiteType(tc.SuiteType, tc.HasName, tc.RequirementID, tc.QueryString)
+			if tc.Error == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.Error)
+			}
+		})
+	}
+}
+
+func TestTestSuite_Create_DefaultsToRootSuite(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := azdosdkmocks.NewMockTestplanClient(ctrl)
+	clients := &client.AggregatedClient{TestPlanClient: mockClient, Ctx: context.Background()}
+
+	mockClient.EXPECT().GetTestPlanById(clients.Ctx, gomock.Any()).Return(&testplan.TestPlan{
+		Id:        converter.Int(42),
+		RootSuite: &testplan.TestSuiteReference{Id: converter.Int(43)},
+	}, nil).Times(1)
+
+	mockClient.EXPECT().CreateTestSuite(clients.Ctx, gomock.Any()).DoAndReturn(
+		func(ctx context.Context, args testplan.CreateTestSuiteArgs) (*testplan.TestSuite, error) {
+			require.Equal(t, 42, *args.PlanId)
+			require.Equal(t, 43, *args.TestSuiteCreateParams.ParentSuite.Id)
+			require.Equal(t, testplan.TestSuiteTypeValues.StaticTestSuite, *args.TestSuiteCreateParams.SuiteType)
+			require.False(t, *args.TestSuiteCreateParams.InheritDefaultConfigurations)
+			require.Equal(t, 7, *(*args.TestSuiteCreateParams.DefaultConfigurations)[0].Id)
+			require.Nil(t, args.TestSuiteCreateParams.RequirementId)
+			return &testplan.TestSuite{Id: converter.Int(44)}, nil
+		}).Times(1)
+
+	suiteType := testplan.TestSuiteTypeValues.StaticTestSuite
+	mockClient.EXPECT().GetTestSuiteById(clients.Ctx, gomock.Any()).Return(&testplan.TestSuite{
+		Id:                           converter.Int(44),
+		Name:                         converter.String("Smoke"),
+		SuiteType:                    &suiteType,
+		ParentSuite:                  &testplan.TestSuiteReference{Id: converter.Int(43)},
+		InheritDefaultConfigurations: converter.Bool(false),
+		DefaultConfigurations:        &[]testplan.TestConfigurationReference{{Id: converter.Int(7)}},
+		Revision:                     converter.Int(2),
+	}, nil).Times(1)
+
+	d := schema.TestResourceDataRaw(t, ResourceTestSuite().Schema, map[string]interface{}{
+		"project_id":                testSuiteProjectID,
+		"test_plan_id":              42,
+		"name":                      "Smoke",
+		"default_configuration_ids": []interface{}{7},
+	})
+	diags := resourceTestSuiteCreate(context.Background(), d, clients)
+	require.Empty(t, diags)
+	assert.Equal(t, "44", d.Id())
+	assert.Equal(t, 43, d.Get("parent_suite_id"))
+	assert.Equal(t, testSuiteTypeStatic, d.Get("type"))
+	assert.Equal(t, 1, d.Get("default_configuration_ids").(*schema.Set).Len())
+}
+
+func TestTestSuite_Read_MapsQueryBasedSuite(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := azdosdkmocks.NewMockTestplanClient(ctrl)
+	clients := &client.AggregatedClient{TestPlanClient: mockClient, Ctx: context.Background()}
+
+	suiteType := testplan.TestSuiteTypeValues.DynamicTestSuite
+	mockClient.EXPECT().GetTestSuiteById(clients.Ctx, gomock.Any()).DoAndReturn(
+		func(ctx context.Context, args testplan.GetTestSuiteByIdArgs) (*testplan.TestSuite, error) {
+			require.Equal(t, 42, *args.PlanId)
+			require.Equal(t, 45, *args.SuiteId)
+			return &testplan.TestSuite{
+				Id:                           converter.Int(45),
+				Name:                         converter.String("Priority 1"),
+				SuiteType:                    &suiteType,
+				QueryString:                  converter.String("SELECT [System.Id] FROM WorkItems"),
+				InheritDefaultConfigurations: converter.Bool(true),
+				DefaultConfigurations:        &[]testplan.TestConfigurationReference{{Id: converter.Int(1)}},
+			}, nil
+		}).Times(1)
+
+	d := schema.TestResourceDataRaw(t, ResourceTestSuite().Schema, map[string]interface{}{
+		"project_id":   testSuiteProjectID,
+		"test_plan_id": 42,
+	})
+	d.SetId("45")
+	diags := resourceTestSuiteRead(context.Background(), d, clients)
+	require.Empty(t, diags)
+	assert.Equal(t, testSuiteTypeQuery, d.Get("type"))
+	assert.Equal(t, "SELECT [System.Id] FROM WorkItems", d.Get("query_string"))
+	// Inherited configurations are not managed by the suite
+	assert.Equal(t, 0, d.Get("default_configuration_ids").(*schema.Set).Len())
+}
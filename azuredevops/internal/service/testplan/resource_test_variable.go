@@ -0,0 +1,160 @@
+package testplan
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/testplan"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
+)
+
+// ResourceTestVariable schema and implementation for test variable resource
+func ResourceTestVariable() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceTestVariableCreate,
+		ReadContext:   resourceTestVariableRead,
+		UpdateContext: resourceTestVariableUpdate,
+		DeleteContext: resourceTestVariableDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(2 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Importer: tfhelper.ImportProjectQualifiedResourceInteger(),
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"values": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotWhiteSpace,
+				},
+			},
+		},
+	}
+}
+
+func resourceTestVariableCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+
+	variable, err := clients.TestPlanClient.CreateTestVariable(clients.Ctx, testplan.CreateTestVariableArgs{
+		Project:                            converter.String(d.Get("project_id").(string)),
+		TestVariableCreateUpdateParameters: expandTestVariable(d),
+	})
+	if err != nil {
+		return diag.Errorf(" Creating test variable. Error: %+v", err)
+	}
+
+	if variable == nil || variable.Id == nil {
+		return diag.Errorf(" Creating test variable. Error: the service returned no variable ID")
+	}
+
+	d.SetId(strconv.Itoa(*variable.Id))
+	return resourceTestVariableRead(clients.Ctx, d, m)
+}
+
+func resourceTestVariableRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+
+	variableID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.Errorf(" Parsing test variable ID: %s. Error: %+v", d.Id(), err)
+	}
+
+	variable, err := clients.TestPlanClient.GetTestVariableById(clients.Ctx, testplan.GetTestVariableByIdArgs{
+		Project:        converter.String(d.Get("project_id").(string)),
+		TestVariableId: &variableID,
+	})
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf(" Getting test variable with ID: %s. Error: %+v", d.Id(), err)
+	}
+
+	d.Set("name", converter.ToString(variable.Name, ""))
+	d.Set("description", converter.ToString(variable.Description, ""))
+	values := []string{}
+	if variable.Values != nil {
+		values = *variable.Values
+	}
+	d.Set("values", values)
+	return nil
+}
+
+func resourceTestVariableUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+
+	variableID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.Errorf(" Parsing test variable ID: %s. Error: %+v", d.Id(), err)
+	}
+
+	_, err = clients.TestPlanClient.UpdateTestVariable(clients.Ctx, testplan.UpdateTestVariableArgs{
+		Project:                            converter.String(d.Get("project_id").(string)),
+		TestVariableId:                     &variableID,
+		TestVariableCreateUpdateParameters: expandTestVariable(d),
+	})
+	if err != nil {
+		return diag.Errorf(" Updating test variable with ID: %s. Error: %+v", d.Id(), err)
+	}
+	return resourceTestVariableRead(clients.Ctx, d, m)
+}
+
+func resourceTestVariableDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+
+	variableID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.Errorf(" Parsing test variable ID: %s. Error: %+v", d.Id(), err)
+	}
+
+	err = clients.TestPlanClient.DeleteTestVariable(clients.Ctx, testplan.DeleteTestVariableArgs{
+		Project:        converter.String(d.Get("project_id").(string)),
+		TestVariableId: &variableID,
+	})
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			return nil
+		}
+		return diag.Errorf(" Deleting test variable with ID: %s. Error: %+v", d.Id(), err)
+	}
+	return nil
+}
+
+func expandTestVariable(d *schema.ResourceData) *testplan.TestVariableCreateUpdateParameters {
+	values := tfhelper.ExpandStringList(d.Get("values").([]interface{}))
+	return &testplan.TestVariableCreateUpdateParameters{
+		Name:        converter.String(d.Get("name").(string)),
+		Description: converter.String(d.Get("description").(string)),
+		Values:      &values,
+	}
+}
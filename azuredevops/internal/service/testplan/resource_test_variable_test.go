@@ -0,0 +1,150 @@
+package testplan
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/testplan"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func getTestVariableResourceData(t *testing.T) *schema.ResourceData {
+	return schema.TestResourceDataRaw(t, ResourceTestVariable().Schema, map[string]interface{}{
+		"project_id": testPlanProjectID,
+		"name":       "Browser",
+		"values":     []interface{}{"Edge", "Firefox", "Chrome"},
+	})
+}
+
+func TestTestVariable_Create_ExpandsValues(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := azdosdkmocks.NewMockTestplanClient(ctrl)
+	clients := &client.AggregatedClient{TestPlanClient: mockClient, Ctx: context.Background()}
+
+	mockClient.EXPECT().CreateTestVariable(clients.Ctx, gomock.Any()).DoAndReturn(
+		func(ctx context.Context, args testplan.CreateTestVariableArgs) (*testplan.TestVariable, error) {
+			params := args.TestVariableCreateUpdateParameters
+			require.Equal(t, testPlanProjectID, *args.Project)
+			require.Equal(t, "Browser", *params.Name)
+			require.Equal(t, "", *params.Description)
+			// The order of the values is kept
+			require.Equal(t, []string{"Edge", "Firefox", "Chrome"}, *params.Values)
+			return &testplan.TestVariable{Id: converter.Int(3)}, nil
+		}).Times(1)
+
+	mockClient.EXPECT().GetTestVariableById(clients.Ctx, testplan.GetTestVariableByIdArgs{
+		Project:        converter.String(testPlanProjectID),
+		TestVariableId: converter.Int(3),
+	}).Return(&testplan.TestVariable{
+		Id:     converter.Int(3),
+		Name:   converter.String("Browser"),
+		Values: &[]string{"Edge", "Firefox", "Chrome"},
+	}, nil).Times(1)
+
+	d := getTestVariableResourceData(t)
+	diags := resourceTestVariableCreate(context.Background(), d, clients)
+	require.Empty(t, diags)
+	assert.Equal(t, "3", d.Id())
+	assert.Equal(t, []interface{}{"Edge", "Firefox", "Chrome"}, d.Get("values"))
+}
+
+func TestTestVariable_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := azdosdkmocks.NewMockTestplanClient(ctrl)
+	clients := &client.AggregatedClient{TestPlanClient: mockClient, Ctx: context.Background()}
+
+	mockClient.EXPECT().CreateTestVariable(clients.Ctx, gomock.Any()).Return(nil, errors.New("CreateTestVariable() Failed")).Times(1)
+
+	d := getTestVariableResourceData(t)
+	diags := resourceTestVariableCreate(context.Background(), d, clients)
+	require.NotEmpty(t, diags)
+	require.Contains(t, diags[0].Summary, "CreateTestVariable() Failed")
+	assert.Empty(t, d.Id())
+}
+
+// verifies that values removed outside of Terraform show up in the plan
+func TestTestVariable_Read_FlattensValues(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := azdosdkmocks.NewMockTestplanClient(ctrl)
+	clients := &client.AggregatedClient{TestPlanClient: mockClient, Ctx: context.Background()}
+
+	mockClient.EXPECT().GetTestVariableById(clients.Ctx, gomock.Any()).Return(&testplan.TestVariable{
+		Id:          converter.Int(3),
+		Name:        converter.String("Browser"),
+		Description: converter.String("Supported browsers"),
+	}, nil).Times(1)
+
+	d := getTestVariableResourceData(t)
+	d.SetId("3")
+	diags := resourceTestVariableRead(context.Background(), d, clients)
+	require.Empty(t, diags)
+	assert.Equal(t, "Supported browsers", d.Get("description"))
+	assert.Empty(t, d.Get("values"))
+}
+
+func TestTestVariable_Read_NotFound_ClearsID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := azdosdkmocks.NewMockTestplanClient(ctrl)
+	clients := &client.AggregatedClient{TestPlanClient: mockClient, Ctx: context.Background()}
+
+	code := 404
+	mockClient.EXPECT().GetTestVariableById(clients.Ctx, gomock.Any()).Return(nil, azuredevops.WrappedError{StatusCode: &code}).Times(1)
+
+	d := getTestVariableResourceData(t)
+	d.SetId("3")
+	diags := resourceTestVariableRead(context.Background(), d, clients)
+	require.Empty(t, diags)
+	assert.Empty(t, d.Id())
+}
+
+func TestTestVariable_Update_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := azdosdkmocks.NewMockTestplanClient(ctrl)
+	clients := &client.AggregatedClient{TestPlanClient: mockClient, Ctx: context.Background()}
+
+	mockClient.EXPECT().UpdateTestVariable(clients.Ctx, gomock.Any()).DoAndReturn(
+		func(ctx context.Context, args testplan.UpdateTestVariableArgs) (*testplan.TestVariable, error) {
+			require.Equal(t, 3, *args.TestVariableId)
+			return nil, errors.New("UpdateTestVariable() Failed")
+		}).Times(1)
+
+	d := getTestVariableResourceData(t)
+	d.SetId("3")
+	diags := resourceTestVariableUpdate(context.Background(), d, clients)
+	require.NotEmpty(t, diags)
+	require.Contains(t, diags[0].Summary, "UpdateTestVariable() Failed")
+}
+
+func TestTestVariable_Delete_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := azdosdkmocks.NewMockTestplanClient(ctrl)
+	clients := &client.AggregatedClient{TestPlanClient: mockClient, Ctx: context.Background()}
+
+	mockClient.EXPECT().DeleteTestVariable(clients.Ctx, gomock.Any()).Return(errors.New("DeleteTestVariable() Failed")).Times(1)
+
+	d := getTestVariableResourceData(t)
+	d.SetId("3")
+	diags := resourceTestVariableDelete(context.Background(), d, clients)
+	require.NotEmpty(t, diags)
+	require.Contains(t, diags[0].Summary, "DeleteTestVariable() Failed")
+}
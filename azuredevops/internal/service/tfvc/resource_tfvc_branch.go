@@ -0,0 +1,196 @@
+package tfvc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/tfvc"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
+)
+
+// ResourceTfvcBranch schema and implementation for TFVC branch resource
+func ResourceTfvcBranch() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceTfvcBranchCreate,
+		ReadContext:   resourceTfvcBranchRead,
+		DeleteContext: resourceTfvcBranchDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceTfvcBranchImport,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"path": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateServerPath,
+			},
+			"source_path": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateServerPath,
+			},
+			"comment": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"changeset_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceTfvcBranchCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+	projectID := d.Get("project_id").(string)
+	path := d.Get("path").(string)
+	sourcePath := d.Get("source_path").(string)
+
+	comment := fmt.Sprintf("Branched from %s", sourcePath)
+	if v, ok := d.GetOk("comment"); ok {
+		comment = v.(string)
+	}
+
+	changeset, err := clients.TfvcClient.CreateChangeset(clients.Ctx, tfvc.CreateChangesetArgs{
+		Project: converter.String(projectID),
+		Changeset: &git.TfvcChangeset{
+			Comment: converter.String(comment),
+			Changes: &[]git.TfvcChange{
+				{
+					ChangeType:       &git.VersionControlChangeTypeValues.Branch,
+					Item:             git.TfvcItem{Path: converter.String(path)},
+					SourceServerItem: converter.String(sourcePath),
+				},
+			},
+		},
+	})
+	if err != nil {
+		return diag.Errorf(" Creating TFVC branch %s from %s. Error: %+v", path, sourcePath, err)
+	}
+
+	d.SetId(path)
+	if changeset != nil && changeset.ChangesetId != nil {
+		d.Set("changeset_id", *changeset.ChangesetId)
+	}
+	return resourceTfvcBranchRead(ctx, d, m)
+}
+
+func resourceTfvcBranchRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+	projectID := d.Get("project_id").(string)
+
+	branch, err := clients.TfvcClient.GetBranch(clients.Ctx, tfvc.GetBranchArgs{
+		Path:          converter.String(d.Id()),
+		Project:       converter.String(projectID),
+		IncludeParent: converter.Bool(true),
+	})
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf(" Reading TFVC branch %s. Error: %+v", d.Id(), err)
+	}
+	if branch == nil || converter.ToBool(branch.IsDeleted, false) {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("path", converter.ToString(branch.Path, d.Id()))
+	// Folders branched from a folder that is not a branch have no parent
+	if branch.Parent != nil && branch.Parent.Path != nil {
+		d.Set("source_path", *branch.Parent.Path)
+	}
+	return nil
+}
+
+func resourceTfvcBranchDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+	projectID := d.Get("project_id").(string)
+
+	item, err := clients.TfvcClient.GetItem(clients.Ctx, tfvc.GetItemArgs{
+		Path:    converter.String(d.Id()),
+		Project: converter.String(projectID),
+	})
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf(" Reading TFVC item %s. Error: %+v", d.Id(), err)
+	}
+
+	_, err = clients.TfvcClient.CreateChangeset(clients.Ctx, tfvc.CreateChangesetArgs{
+		Project: converter.String(projectID),
+		Changeset: &git.TfvcChangeset{
+			Comment: converter.String(fmt.Sprintf("Deleted branch %s", d.Id())),
+			Changes: &[]git.TfvcChange{
+				{
+					ChangeType: &git.VersionControlChangeTypeValues.Delete,
+					Item: git.TfvcItem{
+						Path:    converter.String(d.Id()),
+						Version: item.Version,
+					},
+				},
+			},
+		},
+	})
+	if err != nil {
+		return diag.Errorf(" Deleting TFVC branch %s. Error: %+v", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceTfvcBranchImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	projectNameOrID, path, err := tfhelper.ParseImportedName(d.Id())
+	if err != nil {
+		return nil, fmt.Errorf("Parsing the import ID. Expected <project>/<path>: %+v", err)
+	}
+
+	projectID, err := tfhelper.GetRealProjectId(projectNameOrID, m)
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(path)
+	d.Set("project_id", projectID)
+	d.Set("path", path)
+	return []*schema.ResourceData{d}, nil
+}
+
+func validateServerPath(i interface{}, key string) ([]string, []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %q to be string", key)}
+	}
+	if !strings.HasPrefix(v, "$/") || len(v) <= 2 {
+		return nil, []error{fmt.Errorf("%q must be a server path starting with `$/`, got: %s", key, v)}
+	}
+	return nil, nil
+}
@@ -0,0 +1,160 @@
+package tfvc
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/tfvc"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var tfvcBranchProjectID = uuid.NewString()
+
+func TestTfvcBranch_Create_BranchesFromSource(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tfvcClient := azdosdkmocks.NewMockTfvcClient(ctrl)
+	clients := &client.AggregatedClient{TfvcClient: tfvcClient, Ctx: context.Background()}
+
+	gomock.InOrder(
+		tfvcClient.EXPECT().CreateChangeset(clients.Ctx, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, args tfvc.CreateChangesetArgs) (*git.TfvcChangesetRef, error) {
+				require.Equal(t, tfvcBranchProjectID, *args.Project)
+				require.Equal(t, "Branched from $/Project/Main", *args.Changeset.Comment)
+				changes := *args.Changeset.Changes
+				require.Len(t, changes, 1)
+				require.Equal(t, git.VersionControlChangeTypeValues.Branch, *changes[0].ChangeType)
+				require.Equal(t, "$/Project/Dev", *changes[0].Item.(git.TfvcItem).Path)
+				require.Equal(t, "$/Project/Main", *changes[0].SourceServerItem)
+				return &git.TfvcChangesetRef{ChangesetId: converter.Int(42)}, nil
+			}).Times(1),
+		tfvcClient.EXPECT().GetBranch(clients.Ctx, tfvc.GetBranchArgs{
+			Path:          converter.String("$/Project/Dev"),
+			Project:       converter.String(tfvcBranchProjectID),
+			IncludeParent: converter.Bool(true),
+		}).Return(&git.TfvcBranch{
+			Path:   converter.String("$/Project/Dev"),
+			Parent: &git.TfvcShallowBranchRef{Path: converter.String("$/Project/Main")},
+		}, nil).Times(1),
+	)
+
+	d := schema.TestResourceDataRaw(t, ResourceTfvcBranch().Schema, map[string]interface{}{
+		"project_id":  tfvcBranchProjectID,
+		"path":        "$/Project/Dev",
+		"source_path": "$/Project/Main",
+	})
+	require.Empty(t, resourceTfvcBranchCreate(context.Background(), d, clients))
+	assert.Equal(t, "$/Project/Dev", d.Id())
+	assert.Equal(t, 42, d.Get("changeset_id"))
+	assert.Equal(t, "$/Project/Main", d.Get("source_path"))
+}
+
+func TestTfvcBranch_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tfvcClient := azdosdkmocks.NewMockTfvcClient(ctrl)
+	clients := &client.AggregatedClient{TfvcClient: tfvcClient, Ctx: context.Background()}
+
+	tfvcClient.EXPECT().CreateChangeset(clients.Ctx, gomock.Any()).Return(nil, errors.New("CreateChangeset() Failed")).Times(1)
+
+	d := schema.TestResourceDataRaw(t, ResourceTfvcBranch().Schema, map[string]interface{}{
+		"project_id":  tfvcBranchProjectID,
+		"path":        "$/Project/Dev",
+		"source_path": "$/Project/Main",
+	})
+	diags := resourceTfvcBranchCreate(context.Background(), d, clients)
+	require.NotEmpty(t, diags)
+	require.Contains(t, diags[0].Summary, "CreateChangeset() Failed")
+	require.Empty(t, d.Id())
+}
+
+func TestTfvcBranch_Read_Deleted_ClearsID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tfvcClient := azdosdkmocks.NewMockTfvcClient(ctrl)
+	clients := &client.AggregatedClient{TfvcClient: tfvcClient, Ctx: context.Background()}
+
+	tfvcClient.EXPECT().GetBranch(clients.Ctx, gomock.Any()).Return(&git.TfvcBranch{
+		Path:      converter.String("$/Project/Dev"),
+		IsDeleted: converter.Bool(true),
+	}, nil).Times(1)
+
+	d := schema.TestResourceDataRaw(t, ResourceTfvcBranch().Schema, map[string]interface{}{
+		"project_id": tfvcBranchProjectID,
+	})
+	d.SetId("$/Project/Dev")
+	require.Empty(t, resourceTfvcBranchRead(context.Background(), d, clients))
+	assert.Empty(t, d.Id())
+}
+
+func TestTfvcBranch_Read_NotFound_ClearsID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tfvcClient := azdosdkmocks.NewMockTfvcClient(ctrl)
+	clients := &client.AggregatedClient{TfvcClient: tfvcClient, Ctx: context.Background()}
+
+	tfvcClient.EXPECT().GetBranch(clients.Ctx, gomock.Any()).Return(nil, azuredevops.WrappedError{
+		StatusCode: converter.Int(http.StatusNotFound),
+	}).Times(1)
+
+	d := schema.TestResourceDataRaw(t, ResourceTfvcBranch().Schema, map[string]interface{}{
+		"project_id": tfvcBranchProjectID,
+	})
+	d.SetId("$/Project/Dev")
+	require.Empty(t, resourceTfvcBranchRead(context.Background(), d, clients))
+	assert.Empty(t, d.Id())
+}
+
+func TestTfvcBranch_Delete_ChecksInDelete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tfvcClient := azdosdkmocks.NewMockTfvcClient(ctrl)
+	clients := &client.AggregatedClient{TfvcClient: tfvcClient, Ctx: context.Background()}
+
+	gomock.InOrder(
+		tfvcClient.EXPECT().GetItem(clients.Ctx, gomock.Any()).Return(&git.TfvcItem{
+			Path:    converter.String("$/Project/Dev"),
+			Version: converter.Int(42),
+		}, nil).Times(1),
+		tfvcClient.EXPECT().CreateChangeset(clients.Ctx, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, args tfvc.CreateChangesetArgs) (*git.TfvcChangesetRef, error) {
+				change := (*args.Changeset.Changes)[0]
+				require.Equal(t, git.VersionControlChangeTypeValues.Delete, *change.ChangeType)
+				require.Equal(t, 42, *change.Item.(git.TfvcItem).Version)
+				return &git.TfvcChangesetRef{ChangesetId: converter.Int(43)}, nil
+			}).Times(1),
+	)
+
+	d := schema.TestResourceDataRaw(t, ResourceTfvcBranch().Schema, map[string]interface{}{
+		"project_id": tfvcBranchProjectID,
+	})
+	d.SetId("$/Project/Dev")
+	require.Empty(t, resourceTfvcBranchDelete(context.Background(), d, clients))
+	assert.Empty(t, d.Id())
+}
+
+func TestTfvcBranch_ValidateServerPath(t *testing.T) {
+	_, errs := validateServerPath("$/Project/Dev", "path")
+	require.Empty(t, errs)
+
+	for _, path := range []string{"", "$/", "Project/Dev", "/Project/Dev"} {
+		_, errs := validateServerPath(path, "path")
+		require.NotEmpty(t, errs, path)
+	}
+}
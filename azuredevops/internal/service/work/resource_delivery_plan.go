@@ -0,0 +1,506 @@
+package work
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/work"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/suppress"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
+)
+
+// A delivery plan can display at most 15 team backlogs.
+const deliveryPlanMaxTeams = 15
+
+// ResourceDeliveryPlan schema and implementation for delivery plan resource
+func ResourceDeliveryPlan() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDeliveryPlanCreate,
+		ReadContext:   resourceDeliveryPlanRead,
+		UpdateContext: resourceDeliveryPlanUpdate,
+		DeleteContext: resourceDeliveryPlanDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(2 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Importer: tfhelper.ImportProjectQualifiedResourceUUID(),
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 256),
+			},
+
+			"team": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: deliveryPlanMaxTeams,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"team_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsUUID,
+						},
+						// The backlog category, e.g. `Microsoft.EpicCategory`, `Microsoft.FeatureCategory`
+						// or `Microsoft.RequirementCategory`.
+						"backlog_level": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+					},
+				},
+			},
+
+			"field_criteria": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"field_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+						"operator": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"logical_operator": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "AND",
+							ValidateFunc: validation.StringInSlice([]string{"AND", "OR"}, false),
+						},
+					},
+				},
+			},
+
+			"marker": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"date": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateFunc:     validation.IsRFC3339Time,
+							DiffSuppressFunc: suppress.RFC3339TimeDifference,
+						},
+						"label": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+						"color": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "#106ebe",
+							ValidateFunc: validation.StringMatch(regexp.MustCompile(`^#[0-9a-fA-F]{6}$`),
+								"color must be a hex color code, e.g. #106ebe"),
+						},
+					},
+				},
+			},
+
+			"card_settings": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"show_id": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"show_assigned_to": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"assigned_to_display_format": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  string(work.IdentityDisplayFormatValues.AvatarAndFullName),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(work.IdentityDisplayFormatValues.AvatarOnly),
+								string(work.IdentityDisplayFormatValues.FullName),
+								string(work.IdentityDisplayFormatValues.AvatarAndFullName),
+							}, false),
+						},
+						"show_state": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"show_tags": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"show_parent": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"show_child_rollup": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"show_empty_fields": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+					},
+				},
+			},
+
+			"revision": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDeliveryPlanCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+
+	properties, err := expandDeliveryPlanProperties(d)
+	if err != nil {
+		return diag.Errorf(" Expanding delivery plan properties. Error: %+v", err)
+	}
+
+	plan, err := clients.WorkClient.CreatePlan(clients.Ctx, work.CreatePlanArgs{
+		Project: converter.String(d.Get("project_id").(string)),
+		PostedPlan: &work.CreatePlan{
+			Name:        converter.String(d.Get("name").(string)),
+			Description: converter.String(d.Get("description").(string)),
+			Type:        &work.PlanTypeValues.DeliveryTimelineView,
+			Properties:  properties,
+		},
+	})
+	if err != nil {
+		return diag.Errorf(" Creating delivery plan. Error: %+v", err)
+	}
+
+	if plan == nil || plan.Id == nil {
+		return diag.Errorf(" Creating delivery plan. Error: the service returned no plan ID")
+	}
+
+	d.SetId(plan.Id.String())
+	return resourceDeliveryPlanRead(clients.Ctx, d, m)
+}
+
+func resourceDeliveryPlanRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+
+	plan, err := clients.WorkClient.GetPlan(clients.Ctx, work.GetPlanArgs{
+		Project: converter.String(d.Get("project_id").(string)),
+		Id:      converter.String(d.Id()),
+	})
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf(" Getting delivery plan with ID: %s. Error: %+v", d.Id(), err)
+	}
+
+	if plan.Name != nil {
+		d.Set("name", plan.Name)
+	}
+	d.Set("description", converter.ToString(plan.Description, ""))
+	if plan.Revision != nil {
+		d.Set("revision", *plan.Revision)
+	}
+
+	properties, err := getDeliveryPlanProperties(plan)
+	if err != nil {
+		return diag.Errorf(" Reading delivery plan properties. Error: %+v", err)
+	}
+
+	if err := d.Set("team", flattenDeliveryPlanTeams(properties.TeamBacklogMappings)); err != nil {
+		return diag.Errorf(" Setting team. Error: %+v", err)
+	}
+	if err := d.Set("field_criteria", flattenDeliveryPlanCriteria(properties.Criteria)); err != nil {
+		return diag.Errorf(" Setting field_criteria. Error: %+v", err)
+	}
+	if err := d.Set("marker", flattenDeliveryPlanMarkers(properties.Markers)); err != nil {
+		return diag.Errorf(" Setting marker. Error: %+v", err)
+	}
+	if err := d.Set("card_settings", flattenDeliveryPlanCardSettings(properties.CardSettings)); err != nil {
+		return diag.Errorf(" Setting card_settings. Error: %+v", err)
+	}
+	return nil
+}
+
+func resourceDeliveryPlanUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+
+	projectID := d.Get("project_id").(string)
+
+	// The service rejects updates that are not based on the latest revision, so the current revision is
+	// fetched right before the update rather than trusting the one in state.
+	existing, err := clients.WorkClient.GetPlan(clients.Ctx, work.GetPlanArgs{
+		Project: converter.String(projectID),
+		Id:      converter.String(d.Id()),
+	})
+	if err != nil {
+		return diag.Errorf(" Getting delivery plan with ID: %s. Error: %+v", d.Id(), err)
+	}
+
+	properties, err := expandDeliveryPlanProperties(d)
+	if err != nil {
+		return diag.Errorf(" Expanding delivery plan properties. Error: %+v", err)
+	}
+
+	existingProperties, err := getDeliveryPlanProperties(existing)
+	if err != nil {
+		return diag.Errorf(" Reading delivery plan properties. Error: %+v", err)
+	}
+	// Card styles are not managed by this resource, keep whatever has been configured in the UI.
+	properties.StyleSettings = existingProperties.StyleSettings
+	properties.TagStyleSettings = existingProperties.TagStyleSettings
+
+	_, err = clients.WorkClient.UpdatePlan(clients.Ctx, work.UpdatePlanArgs{
+		Project: converter.String(projectID),
+		Id:      converter.String(d.Id()),
+		UpdatedPlan: &work.UpdatePlan{
+			Name:        converter.String(d.Get("name").(string)),
+			Description: converter.String(d.Get("description").(string)),
+			Type:        &work.PlanTypeValues.DeliveryTimelineView,
+			Revision:    existing.Revision,
+			Properties:  properties,
+		},
+	})
+	if err != nil {
+		return diag.Errorf(" Updating delivery plan with ID: %s. Error: %+v", d.Id(), err)
+	}
+	return resourceDeliveryPlanRead(clients.Ctx, d, m)
+}
+
+func resourceDeliveryPlanDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+
+	err := clients.WorkClient.DeletePlan(clients.Ctx, work.DeletePlanArgs{
+		Project: converter.String(d.Get("project_id").(string)),
+		Id:      converter.String(d.Id()),
+	})
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			return nil
+		}
+		return diag.Errorf(" Deleting delivery plan with ID: %s. Error: %+v", d.Id(), err)
+	}
+	return nil
+}
+
+// getDeliveryPlanProperties converts the untyped plan properties returned by the service into a DeliveryViewPropertyCollection
+func getDeliveryPlanProperties(plan *work.Plan) (*work.DeliveryViewPropertyCollection, error) {
+	properties := work.DeliveryViewPropertyCollection{}
+	if plan == nil || plan.Properties == nil {
+		return &properties, nil
+	}
+
+	propertiesAsJSON, err := json.Marshal(plan.Properties)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(propertiesAsJSON, &properties); err != nil {
+		return nil, err
+	}
+	return &properties, nil
+}
+
+func expandDeliveryPlanProperties(d *schema.ResourceData) (*work.DeliveryViewPropertyCollection, error) {
+	teams := d.Get("team").([]interface{})
+	mappings := make([]work.TeamBacklogMapping, 0, len(teams))
+	for _, raw := range teams {
+		team := raw.(map[string]interface{})
+		teamID, err := uuid.Parse(team["team_id"].(string))
+		if err != nil {
+			return nil, fmt.Errorf("parsing team ID %q: %+v", team["team_id"], err)
+		}
+		mappings = append(mappings, work.TeamBacklogMapping{
+			TeamId:                &teamID,
+			CategoryReferenceName: converter.String(team["backlog_level"].(string)),
+		})
+	}
+
+	criteriaList := d.Get("field_criteria").([]interface{})
+	criteria := make([]work.FilterClause, 0, len(criteriaList))
+	for i, raw := range criteriaList {
+		clause := raw.(map[string]interface{})
+		criteria = append(criteria, work.FilterClause{
+			Index:           converter.Int(i),
+			FieldName:       converter.String(clause["field_name"].(string)),
+			Operator:        converter.String(clause["operator"].(string)),
+			Value:           converter.String(clause["value"].(string)),
+			LogicalOperator: converter.String(clause["logical_operator"].(string)),
+		})
+	}
+
+	markerList := d.Get("marker").([]interface{})
+	markers := make([]work.Marker, 0, len(markerList))
+	for _, raw := range markerList {
+		marker := raw.(map[string]interface{})
+		date, err := time.Parse(time.RFC3339, marker["date"].(string))
+		if err != nil {
+			return nil, fmt.Errorf("parsing marker date %q: %+v", marker["date"], err)
+		}
+		markers = append(markers, work.Marker{
+			Date:  &azuredevops.Time{Time: date},
+			Label: converter.String(marker["label"].(string)),
+			Color: converter.String(marker["color"].(string)),
+		})
+	}
+
+	return &work.DeliveryViewPropertyCollection{
+		TeamBacklogMappings: &mappings,
+		Criteria:            &criteria,
+		Markers:             &markers,
+		CardSettings:        expandDeliveryPlanCardSettings(d.Get("card_settings").([]interface{})),
+	}, nil
+}
+
+func expandDeliveryPlanCardSettings(input []interface{}) *work.CardSettings {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	settings := input[0].(map[string]interface{})
+	displayFormat := work.IdentityDisplayFormat(settings["assigned_to_display_format"].(string))
+	return &work.CardSettings{
+		Fields: &work.CardFieldSettings{
+			ShowId:                  converter.Bool(settings["show_id"].(bool)),
+			ShowAssignedTo:          converter.Bool(settings["show_assigned_to"].(bool)),
+			AssignedToDisplayFormat: &displayFormat,
+			ShowState:               converter.Bool(settings["show_state"].(bool)),
+			ShowTags:                converter.Bool(settings["show_tags"].(bool)),
+			ShowParent:              converter.Bool(settings["show_parent"].(bool)),
+			ShowChildRollup:         converter.Bool(settings["show_child_rollup"].(bool)),
+			ShowEmptyFields:         converter.Bool(settings["show_empty_fields"].(bool)),
+		},
+	}
+}
+
+func flattenDeliveryPlanTeams(mappings *[]work.TeamBacklogMapping) []interface{} {
+	if mappings == nil {
+		return nil
+	}
+
+	teams := make([]interface{}, 0, len(*mappings))
+	for _, mapping := range *mappings {
+		team := map[string]interface{}{
+			"backlog_level": converter.ToString(mapping.CategoryReferenceName, ""),
+		}
+		if mapping.TeamId != nil {
+			team["team_id"] = mapping.TeamId.String()
+		}
+		teams = append(teams, team)
+	}
+	return teams
+}
+
+func flattenDeliveryPlanCriteria(clauses *[]work.FilterClause) []interface{} {
+	if clauses == nil {
+		return nil
+	}
+
+	criteria := make([]interface{}, 0, len(*clauses))
+	for _, clause := range *clauses {
+		logicalOperator := converter.ToString(clause.LogicalOperator, "")
+		if logicalOperator == "" {
+			logicalOperator = "AND"
+		}
+		criteria = append(criteria, map[string]interface{}{
+			"field_name":       converter.ToString(clause.FieldName, ""),
+			"operator":         converter.ToString(clause.Operator, ""),
+			"value":            converter.ToString(clause.Value, ""),
+			"logical_operator": strings.ToUpper(logicalOperator),
+		})
+	}
+	return criteria
+}
+
+func flattenDeliveryPlanMarkers(markers *[]work.Marker) []interface{} {
+	if markers == nil {
+		return nil
+	}
+
+	results := make([]interface{}, 0, len(*markers))
+	for _, marker := range *markers {
+		result := map[string]interface{}{
+			"label": converter.ToString(marker.Label, ""),
+			"color": converter.ToString(marker.Color, ""),
+		}
+		if marker.Date != nil {
+			result["date"] = marker.Date.Time.UTC().Format(time.RFC3339)
+		}
+		results = append(results, result)
+	}
+	return results
+}
+
+func flattenDeliveryPlanCardSettings(settings *work.CardSettings) []interface{} {
+	if settings == nil || settings.Fields == nil {
+		return nil
+	}
+
+	fields := settings.Fields
+	displayFormat := string(work.IdentityDisplayFormatValues.AvatarAndFullName)
+	if fields.AssignedToDisplayFormat != nil {
+		displayFormat = string(*fields.AssignedToDisplayFormat)
+	}
+	return []interface{}{
+		map[string]interface{}{
+			"show_id":                    converter.ToBool(fields.ShowId, false),
+			"show_assigned_to":           converter.ToBool(fields.ShowAssignedTo, false),
+			"assigned_to_display_format": displayFormat,
+			"show_state":                 converter.ToBool(fields.ShowState, false),
+			"show_tags":                  converter.ToBool(fields.ShowTags, false),
+			"show_parent":                converter.ToBool(fields.ShowParent, false),
+			"show_child_rollup":          converter.ToBool(fields.ShowChildRollup, false),
+			"show_empty_fields":          converter.ToBool(fields.ShowEmptyFields, false),
+		},
+	}
+}
@@ -0,0 +1,251 @@
+package work
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/work"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var (
+	deliveryPlanProjectID = uuid.NewString()
+	deliveryPlanTeamID    = uuid.NewString()
+	deliveryPlanID        = uuid.New()
+)
+
+func getDeliveryPlanResourceData(t *testing.T) *schema.ResourceData {
+	return schema.TestResourceDataRaw(t, ResourceDeliveryPlan().Schema, map[string]interface{}{
+		"project_id": deliveryPlanProjectID,
+		"name":       "Program plan",
+		"team": []interface{}{
+			map[string]interface{}{
+				"team_id":       deliveryPlanTeamID,
+				"backlog_level": "Microsoft.FeatureCategory",
+			},
+		},
+		"field_criteria": []interface{}{
+			map[string]interface{}{
+				"field_name": "System.Tags",
+				"operator":   "Contains",
+				"value":      "release",
+			},
+		},
+		"marker": []interface{}{
+			map[string]interface{}{
+				"date":  "2025-03-01T00:00:00Z",
+				"label": "Code freeze",
+			},
+		},
+	})
+}
+
+// The service returns the plan properties as an untyped JSON object
+func getDeliveryPlanServiceProperties() map[string]interface{} {
+	return map[string]interface{}{
+		"teamBacklogMappings": []interface{}{
+			map[string]interface{}{
+				"teamId":                deliveryPlanTeamID,
+				"categoryReferenceName": "Microsoft.FeatureCategory",
+			},
+		},
+		"criteria": []interface{}{
+			map[string]interface{}{
+				"fieldName":       "System.Tags",
+				"operator":        "Contains",
+				"value":           "release",
+				"logicalOperator": "AND",
+				"index":           0,
+			},
+		},
+		"markers": []interface{}{
+			map[string]interface{}{
+				"date":  "2025-03-01T00:00:00Z",
+				"label": "Code freeze",
+				"color": "#106ebe",
+			},
+		},
+		"cardSettings": map[string]interface{}{
+			"fields": map[string]interface{}{
+				"showId":                  true,
+				"showAssignedTo":          true,
+				"assignedToDisplayFormat": "avatarAndFullName",
+				"showState":               true,
+				"showTags":                true,
+				"showParent":              false,
+				"showChildRollup":         false,
+				"showEmptyFields":         true,
+			},
+		},
+	}
+}
+
+func TestDeliveryPlan_Create_ExpandsProperties(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := azdosdkmocks.NewMockWorkClient(ctrl)
+	clients := &client.AggregatedClient{WorkClient: mockClient, Ctx: context.Background()}
+
+	mockClient.EXPECT().CreatePlan(clients.Ctx, gomock.Any()).DoAndReturn(
+		func(ctx context.Context, args work.CreatePlanArgs) (*work.Plan, error) {
+			require.Equal(t, deliveryPlanProjectID, *args.Project)
+			require.Equal(t, work.PlanTypeValues.DeliveryTimelineView, *args.PostedPlan.Type)
+
+			properties := args.PostedPlan.Properties.(*work.DeliveryViewPropertyCollection)
+			require.Len(t, *properties.TeamBacklogMappings, 1)
+			require.Equal(t, deliveryPlanTeamID, (*properties.TeamBacklogMappings)[0].TeamId.String())
+			require.Equal(t, "Microsoft.FeatureCategory", *(*properties.TeamBacklogMappings)[0].CategoryReferenceName)
+			require.Len(t, *properties.Criteria, 1)
+			require.Equal(t, "AND", *(*properties.Criteria)[0].LogicalOperator)
+			require.Equal(t, 0, *(*properties.Criteria)[0].Index)
+			require.Len(t, *properties.Markers, 1)
+			require.Equal(t, "#106ebe", *(*properties.Markers)[0].Color)
+			return &work.Plan{Id: &deliveryPlanID}, nil
+		}).Times(1)
+
+	mockClient.EXPECT().GetPlan(clients.Ctx, gomock.Any()).Return(&work.Plan{
+		Id:         &deliveryPlanID,
+		Name:       converter.String("Program plan"),
+		Revision:   converter.Int(1),
+		Properties: getDeliveryPlanServiceProperties(),
+	}, nil).Times(1)
+
+	d := getDeliveryPlanResourceData(t)
+	diags := resourceDeliveryPlanCreate(context.Background(), d, clients)
+	require.Empty(t, diags)
+	assert.Equal(t, deliveryPlanID.String(), d.Id())
+	assert.Equal(t, 1, d.Get("revision"))
+	assert.Equal(t, deliveryPlanTeamID, d.Get("team.0.team_id"))
+	assert.Equal(t, "Contains", d.Get("field_criteria.0.operator"))
+	assert.Equal(t, "2025-03-01T00:00:00Z", d.Get("marker.0.date"))
+	assert.Equal(t, "avatarAndFullName", d.Get("card_settings.0.assigned_to_display_format"))
+}
+
+// verifies that a marker date with a time zone offset does not produce a diff once the service returns it in UTC
+func TestDeliveryPlan_Read_MarkerDateWithOffset(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := azdosdkmocks.NewMockWorkClient(ctrl)
+	clients := &client.AggregatedClient{WorkClient: mockClient, Ctx: context.Background()}
+
+	serviceProperties := getDeliveryPlanServiceProperties()
+	serviceProperties["markers"].([]interface{})[0].(map[string]interface{})["date"] = "2025-02-28T22:00:00Z"
+	mockClient.EXPECT().GetPlan(clients.Ctx, gomock.Any()).Return(&work.Plan{
+		Id:         &deliveryPlanID,
+		Name:       converter.String("Program plan"),
+		Revision:   converter.Int(1),
+		Properties: serviceProperties,
+	}, nil).Times(1)
+
+	config := map[string]interface{}{
+		"project_id": deliveryPlanProjectID,
+		"name":       "Program plan",
+		"team": []interface{}{
+			map[string]interface{}{
+				"team_id":       deliveryPlanTeamID,
+				"backlog_level": "Microsoft.FeatureCategory",
+			},
+		},
+		"marker": []interface{}{
+			map[string]interface{}{
+				"date":  "2025-03-01T00:00:00+02:00",
+				"label": "Code freeze",
+			},
+		},
+	}
+	d := schema.TestResourceDataRaw(t, ResourceDeliveryPlan().Schema, config)
+	d.SetId(deliveryPlanID.String())
+	require.Empty(t, resourceDeliveryPlanRead(context.Background(), d, clients))
+	require.Equal(t, "2025-02-28T22:00:00Z", d.Get("marker.0.date"))
+
+	diff, err := ResourceDeliveryPlan().Diff(context.Background(), d.State(), terraform.NewResourceConfigRaw(config), clients)
+	require.Nil(t, err)
+	if diff != nil {
+		require.NotContains(t, diff.Attributes, "marker.0.date")
+	}
+}
+
+func TestDeliveryPlan_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := azdosdkmocks.NewMockWorkClient(ctrl)
+	clients := &client.AggregatedClient{WorkClient: mockClient, Ctx: context.Background()}
+
+	mockClient.EXPECT().CreatePlan(clients.Ctx, gomock.Any()).Return(nil, errors.New("CreatePlan() Failed")).Times(1)
+
+	d := getDeliveryPlanResourceData(t)
+	diags := resourceDeliveryPlanCreate(context.Background(), d, clients)
+	require.NotEmpty(t, diags)
+	require.Contains(t, diags[0].Summary, "CreatePlan() Failed")
+}
+
+func TestDeliveryPlan_Read_NotFound_ClearsID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := azdosdkmocks.NewMockWorkClient(ctrl)
+	clients := &client.AggregatedClient{WorkClient: mockClient, Ctx: context.Background()}
+
+	code := 404
+	mockClient.EXPECT().GetPlan(clients.Ctx, gomock.Any()).Return(nil, azuredevops.WrappedError{StatusCode: &code}).Times(1)
+
+	d := getDeliveryPlanResourceData(t)
+	d.SetId(deliveryPlanID.String())
+	diags := resourceDeliveryPlanRead(context.Background(), d, clients)
+	require.Empty(t, diags)
+	assert.Empty(t, d.Id())
+}
+
+func TestDeliveryPlan_Update_UsesLatestRevision(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := azdosdkmocks.NewMockWorkClient(ctrl)
+	clients := &client.AggregatedClient{WorkClient: mockClient, Ctx: context.Background()}
+
+	styleRuleName := "Blocked"
+	serviceProperties := getDeliveryPlanServiceProperties()
+	serviceProperties["styleSettings"] = []interface{}{
+		map[string]interface{}{"name": styleRuleName, "isEnabled": "true"},
+	}
+
+	existing := &work.Plan{
+		Id:         &deliveryPlanID,
+		Name:       converter.String("Program plan"),
+		Revision:   converter.Int(7),
+		Properties: serviceProperties,
+	}
+	gomock.InOrder(
+		mockClient.EXPECT().GetPlan(clients.Ctx, gomock.Any()).Return(existing, nil).Times(1),
+		mockClient.EXPECT().UpdatePlan(clients.Ctx, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, args work.UpdatePlanArgs) (*work.Plan, error) {
+				require.Equal(t, 7, *args.UpdatedPlan.Revision)
+				require.Equal(t, deliveryPlanID.String(), *args.Id)
+
+				properties := args.UpdatedPlan.Properties.(*work.DeliveryViewPropertyCollection)
+				require.NotNil(t, properties.StyleSettings)
+				require.Equal(t, styleRuleName, *(*properties.StyleSettings)[0].Name)
+				return existing, nil
+			}).Times(1),
+		mockClient.EXPECT().GetPlan(clients.Ctx, gomock.Any()).Return(existing, nil).Times(1),
+	)
+
+	d := getDeliveryPlanResourceData(t)
+	d.SetId(deliveryPlanID.String())
+	diags := resourceDeliveryPlanUpdate(context.Background(), d, clients)
+	require.Empty(t, diags)
+	assert.Equal(t, 7, d.Get("revision"))
+}
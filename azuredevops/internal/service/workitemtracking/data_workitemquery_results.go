@@ -0,0 +1,259 @@
+package workitemtracking
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/workitemtracking"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/sdk/workitemtrackingextras"
+)
+
+// DataWorkItemQueryResults schema and implementation for work item query results data source
+func DataWorkItemQueryResults() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceWorkItemQueryResultsRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"wiql": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 32000),
+				ExactlyOneOf: []string{"wiql", "query_id"},
+			},
+
+			"query_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsUUID,
+				ExactlyOneOf: []string{"wiql", "query_id"},
+			},
+
+			// The fields returned for every work item, defaults to the columns of the query
+			"fields": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotWhiteSpace,
+				},
+			},
+
+			// The value of 20000 matches the restrictions in Azure DevOps.
+			"top": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 20000),
+			},
+
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+
+			"count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"work_items": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"fields": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceWorkItemQueryResultsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+
+	projectID := d.Get("project_id").(string)
+	var top *int
+	if v, ok := d.GetOk("top"); ok {
+		top = converter.Int(v.(int))
+	}
+
+	var result *workitemtracking.WorkItemQueryResult
+	var err error
+	if queryID, ok := d.GetOk("query_id"); ok {
+		result, err = clients.WorkItemTrackingClient.QueryById(clients.Ctx, workitemtracking.QueryByIdArgs{
+			Id:      converter.UUID(queryID.(string)),
+			Project: converter.String(projectID),
+			Top:     top,
+		})
+		if err != nil {
+			return diag.Errorf(" Running query %s. Error: %+v", queryID.(string), err)
+		}
+	} else {
+		result, err = clients.WorkItemTrackingClient.QueryByWiql(clients.Ctx, workitemtracking.QueryByWiqlArgs{
+			Wiql: &workitemtracking.Wiql{
+				Query: converter.String(d.Get("wiql").(string)),
+			},
+			Project: converter.String(projectID),
+			Top:     top,
+		})
+		if err != nil {
+			return diag.Errorf(" Running WIQL query. Error: %+v", err)
+		}
+	}
+
+	ids := getWorkItemQueryResultIDs(result)
+
+	var fields []string
+	if v, ok := d.GetOk("fields"); ok {
+		for _, field := range v.([]interface{}) {
+			fields = append(fields, field.(string))
+		}
+	} else if result != nil && result.Columns != nil {
+		for _, column := range *result.Columns {
+			if column.ReferenceName != nil {
+				fields = append(fields, *column.ReferenceName)
+			}
+		}
+	}
+
+	workItems, err := getWorkItemQueryResultWorkItems(clients, projectID, ids, fields)
+	if err != nil {
+		return diag.Errorf(" Getting work items of the query result. Error: %+v", err)
+	}
+
+	var flattenedIDs []interface{}
+	var flattenedWorkItems []interface{}
+	for _, id := range ids {
+		flattenedIDs = append(flattenedIDs, id)
+
+		workItemFields := map[string]interface{}{}
+		if workItem, ok := workItems[id]; ok && workItem.Fields != nil {
+			for _, field := range fields {
+				if value, ok := (*workItem.Fields)[field]; ok {
+					workItemFields[field] = flattenWorkItemFieldValue(value)
+				}
+			}
+		}
+		flattenedWorkItems = append(flattenedWorkItems, map[string]interface{}{
+			"id":     id,
+			"fields": workItemFields,
+		})
+	}
+
+	h := sha256.New()
+	h.Write([]byte(strings.Join([]string{projectID, d.Get("wiql").(string), d.Get("query_id").(string), strings.Join(fields, ",")}, "#")))
+	d.SetId("workitemqueryresults#" + base64.URLEncoding.EncodeToString(h.Sum(nil)))
+	d.Set("ids", flattenedIDs)
+	d.Set("count", len(ids))
+	if err := d.Set("work_items", flattenedWorkItems); err != nil {
+		return diag.Errorf(" Setting work_items. Error: %+v", err)
+	}
+	return nil
+}
+
+// getWorkItemQueryResultIDs returns the IDs of the work items of a query result in order. For link queries
+// the IDs of the work items on both ends of the links are returned once.
+func getWorkItemQueryResultIDs(result *workitemtracking.WorkItemQueryResult) []int {
+	var ids []int
+	if result == nil {
+		return ids
+	}
+
+	seen := map[int]bool{}
+	add := func(reference *workitemtracking.WorkItemReference) {
+		if reference == nil || reference.Id == nil || seen[*reference.Id] {
+			return
+		}
+		seen[*reference.Id] = true
+		ids = append(ids, *reference.Id)
+	}
+
+	if result.WorkItems != nil {
+		for _, workItem := range *result.WorkItems {
+			add(&workItem)
+		}
+	}
+	if result.WorkItemRelations != nil {
+		for _, link := range *result.WorkItemRelations {
+			add(link.Source)
+			add(link.Target)
+		}
+	}
+	return ids
+}
+
+func getWorkItemQueryResultWorkItems(clients *client.AggregatedClient, projectID string, ids []int, fields []string) (map[int]workitemtracking.WorkItem, error) {
+	workItems := map[int]workitemtracking.WorkItem{}
+	if len(fields) == 0 {
+		return workItems, nil
+	}
+
+	for start := 0; start < len(ids); start += workitemtrackingextras.MaxBatchSize {
+		end := min(start+workitemtrackingextras.MaxBatchSize, len(ids))
+		chunk := ids[start:end]
+		result, err := clients.WorkItemTrackingClient.GetWorkItemsBatch(clients.Ctx, workitemtracking.GetWorkItemsBatchArgs{
+			WorkItemGetRequest: &workitemtracking.WorkItemBatchGetRequest{
+				Ids:         &chunk,
+				Fields:      &fields,
+				ErrorPolicy: &workitemtracking.WorkItemErrorPolicyValues.Omit,
+			},
+			Project: converter.String(projectID),
+		})
+		if err != nil {
+			return nil, err
+		}
+		if result == nil {
+			continue
+		}
+		for _, workItem := range *result {
+			if workItem.Id != nil {
+				workItems[*workItem.Id] = workItem
+			}
+		}
+	}
+	return workItems, nil
+}
+
+// flattenWorkItemFieldValue converts the value of a work item field to a string. Identity fields are
+// returned as objects by the service, they are converted to the unique name of the identity.
+func flattenWorkItemFieldValue(value interface{}) string {
+	if value == nil {
+		return ""
+	}
+	if identity, ok := value.(map[string]interface{}); ok {
+		if uniqueName, ok := identity["uniqueName"].(string); ok {
+			return uniqueName
+		}
+	}
+	return fmt.Sprintf("%v", value)
+}
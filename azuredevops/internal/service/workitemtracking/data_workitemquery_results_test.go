@@ -0,0 +1,116 @@
+package workitemtracking
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/workitemtracking"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestDataWorkItemQueryResults_Wiql_ReturnsColumns(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockClient := azdosdkmocks.NewMockWorkitemtrackingClient(ctrl)
+	clients := &client.AggregatedClient{WorkItemTrackingClient: mockClient, Ctx: context.Background()}
+
+	projectID := uuid.NewString()
+	wiql := "SELECT [System.Id], [System.AssignedTo] FROM WorkItems WHERE [Microsoft.VSTS.Common.Priority] = 0"
+
+	mockClient.EXPECT().QueryByWiql(clients.Ctx, gomock.Any()).DoAndReturn(
+		func(ctx context.Context, args workitemtracking.QueryByWiqlArgs) (*workitemtracking.WorkItemQueryResult, error) {
+			assert.Equal(t, wiql, *args.Wiql.Query)
+			assert.Equal(t, 10, *args.Top)
+			return &workitemtracking.WorkItemQueryResult{
+				Columns: &[]workitemtracking.WorkItemFieldReference{
+					{ReferenceName: converter.String("System.Id")},
+					{ReferenceName: converter.String("System.AssignedTo")},
+				},
+				WorkItems: &[]workitemtracking.WorkItemReference{{Id: converter.Int(2)}, {Id: converter.Int(1)}},
+			}, nil
+		}).Times(1)
+	mockClient.EXPECT().GetWorkItemsBatch(clients.Ctx, gomock.Any()).DoAndReturn(
+		func(ctx context.Context, args workitemtracking.GetWorkItemsBatchArgs) (*[]workitemtracking.WorkItem, error) {
+			assert.Equal(t, []int{2, 1}, *args.WorkItemGetRequest.Ids)
+			assert.Equal(t, []string{"System.Id", "System.AssignedTo"}, *args.WorkItemGetRequest.Fields)
+			return &[]workitemtracking.WorkItem{
+				{Id: converter.Int(1), Fields: &map[string]interface{}{"System.Id": float64(1)}},
+				{Id: converter.Int(2), Fields: &map[string]interface{}{
+					"System.Id":         float64(2),
+					"System.AssignedTo": map[string]interface{}{"displayName": "User", "uniqueName": "user@example.com"},
+				}},
+			}, nil
+		}).Times(1)
+
+	d := schema.TestResourceDataRaw(t, DataWorkItemQueryResults().Schema, map[string]interface{}{
+		"project_id": projectID,
+		"wiql":       wiql,
+		"top":        10,
+	})
+
+	diags := dataSourceWorkItemQueryResultsRead(context.Background(), d, clients)
+
+	require.Empty(t, diags)
+	assert.NotEmpty(t, d.Id())
+	assert.Equal(t, 2, d.Get("count"))
+	assert.Equal(t, []interface{}{2, 1}, d.Get("ids"))
+	assert.Equal(t, map[string]interface{}{"System.Id": "2", "System.AssignedTo": "user@example.com"}, d.Get("work_items.0.fields"))
+	assert.Equal(t, map[string]interface{}{"System.Id": "1"}, d.Get("work_items.1.fields"))
+}
+
+func TestDataWorkItemQueryResults_QueryID_LinkQuery(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockClient := azdosdkmocks.NewMockWorkitemtrackingClient(ctrl)
+	clients := &client.AggregatedClient{WorkItemTrackingClient: mockClient, Ctx: context.Background()}
+
+	queryID := uuid.NewString()
+
+	mockClient.EXPECT().QueryById(clients.Ctx, gomock.Any()).DoAndReturn(
+		func(ctx context.Context, args workitemtracking.QueryByIdArgs) (*workitemtracking.WorkItemQueryResult, error) {
+			assert.Equal(t, queryID, args.Id.String())
+			return &workitemtracking.WorkItemQueryResult{
+				WorkItemRelations: &[]workitemtracking.WorkItemLink{
+					{Target: &workitemtracking.WorkItemReference{Id: converter.Int(1)}},
+					{Source: &workitemtracking.WorkItemReference{Id: converter.Int(1)}, Target: &workitemtracking.WorkItemReference{Id: converter.Int(3)}},
+				},
+			}, nil
+		}).Times(1)
+
+	d := schema.TestResourceDataRaw(t, DataWorkItemQueryResults().Schema, map[string]interface{}{
+		"project_id": uuid.NewString(),
+		"query_id":   queryID,
+	})
+
+	diags := dataSourceWorkItemQueryResultsRead(context.Background(), d, clients)
+
+	require.Empty(t, diags)
+	assert.Equal(t, []interface{}{1, 3}, d.Get("ids"))
+	assert.Equal(t, 2, d.Get("count"))
+}
+
+func TestDataWorkItemQueryResults_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockClient := azdosdkmocks.NewMockWorkitemtrackingClient(ctrl)
+	clients := &client.AggregatedClient{WorkItemTrackingClient: mockClient, Ctx: context.Background()}
+
+	mockClient.EXPECT().QueryByWiql(clients.Ctx, gomock.Any()).Return(nil, errors.New("QueryByWiql() Failed")).Times(1)
+
+	d := schema.TestResourceDataRaw(t, DataWorkItemQueryResults().Schema, map[string]interface{}{
+		"project_id": uuid.NewString(),
+		"wiql":       "SELECT [System.Id] FROM WorkItems",
+	})
+
+	diags := dataSourceWorkItemQueryResultsRead(context.Background(), d, clients)
+	require.NotEmpty(t, diags)
+	assert.Contains(t, diags[0].Summary, "QueryByWiql() Failed")
+}
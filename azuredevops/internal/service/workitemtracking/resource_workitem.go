@@ -2,6 +2,11 @@ package workitemtracking
 
 import (
 	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -26,6 +31,24 @@ var systemFieldMapping = map[string]string{
 	"System.Parent": "parent_id",
 }
 
+const (
+	workItemRelationHyperlink    = "Hyperlink"
+	workItemRelationArtifactLink = "ArtifactLink"
+	workItemRelationAttachedFile = "AttachedFile"
+)
+
+// artifactLinkTypes maps the artifact link types to the URI prefix and the link name of the artifact
+var artifactLinkTypes = map[string]struct {
+	uriPrefix string
+	name      string
+}{
+	"commit":       {uriPrefix: "vstfs:///Git/Commit/", name: "Fixed in Commit"},
+	"pull_request": {uriPrefix: "vstfs:///Git/PullRequestId/", name: "Pull Request"},
+	"branch":       {uriPrefix: "vstfs:///Git/Ref/", name: "Branch"},
+	"build":        {uriPrefix: "vstfs:///Build/Build/", name: "Build"},
+	"wiki_page":    {uriPrefix: "vstfs:///Wiki/WikiPage/", name: "Wiki Page"},
+}
+
 var fieldMapping = map[string]string{
 	"title":          "System.Title",
 	"type":           "System.WorkItemType",
@@ -109,11 +132,121 @@ func ResourceWorkItem() *schema.Resource {
 				ValidateFunc: validation.IntAtLeast(1),
 			},
 
+			"hyperlink": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"url": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+						},
+						"comment": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"artifact_link": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"commit", "pull_request", "branch", "build", "wiki_page"}, false),
+						},
+						"repository_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsUUID,
+						},
+						"commit_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+						"pull_request_id": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"branch_name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+						"build_id": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"wiki_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsUUID,
+						},
+						"wiki_page_path": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+						"comment": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"attachment": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"file_path": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+						// Changing the hash of the content uploads the file again
+						"content_hash": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"comment": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"ignore_fields_changed_outside": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"url": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
 
+			"rev": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"attachment_urls": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
 			"relations": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -143,6 +276,18 @@ func resourceWorkItemCreate(d *schema.ResourceData, m interface{}) error {
 	operations = expandSystemFields(d, operations, orgName)
 	operations = expandCustomFields(d, operations)
 	operations = expandTags(d, operations, webapi.OperationValues.Add)
+	operations = expandHyperlinks(operations, d.Get("hyperlink").(*schema.Set))
+
+	operations, err := expandArtifactLinks(operations, d.Get("artifact_link").(*schema.Set), d.Get("project_id").(string))
+	if err != nil {
+		return err
+	}
+
+	attachmentURLs := map[string]interface{}{}
+	operations, err = uploadAttachments(clients, d, operations, d.Get("attachment").(*schema.Set), attachmentURLs)
+	if err != nil {
+		return err
+	}
 
 	args := workitemtracking.CreateWorkItemArgs{
 		Project:  converter.String(d.Get("project_id").(string)),
@@ -155,6 +300,7 @@ func resourceWorkItemCreate(d *schema.ResourceData, m interface{}) error {
 	}
 
 	d.SetId(strconv.Itoa(*workItem.Id))
+	d.Set("attachment_urls", attachmentURLs)
 	return resourceWorkItemRead(d, m)
 }
 
@@ -184,6 +330,9 @@ func resourceWorkItemRead(d *schema.ResourceData, m interface{}) error {
 			d.Set("url", *workItem.Url)
 			flattenFields(d, workItem.Fields)
 		}
+		if workItem.Rev != nil {
+			d.Set("rev", *workItem.Rev)
+		}
 
 		var relations []map[string]interface{}
 		if workItem.Relations != nil {
@@ -195,6 +344,7 @@ func resourceWorkItemRead(d *schema.ResourceData, m interface{}) error {
 			}
 		}
 		d.Set("relations", relations)
+		flattenRelations(d, workItem.Relations)
 	}
 	return nil
 }
@@ -211,9 +361,50 @@ func resourceWorkItemUpdate(d *schema.ResourceData, m interface{}) error {
 	orgName := strings.Split(clients.OrganizationURL, "/")[3]
 
 	var operations []webapi.JsonPatchOperation
+
+	// The update is rejected if the work item has been changed since it was last read, so concurrent
+	// edits are not overwritten.
+	rev := d.Get("rev").(int)
+	if rev > 0 {
+		operations = append(operations, webapi.JsonPatchOperation{
+			Op:    &webapi.OperationValues.Test,
+			Path:  converter.String("/rev"),
+			Value: rev,
+		})
+	}
+
+	operations, err = expandRelationRemovals(d, operations, project)
+	if err != nil {
+		return err
+	}
+
 	operations = expandSystemFields(d, operations, orgName)
 	operations = expandCustomFields(d, operations)
-	operations = expandTags(d, operations, webapi.OperationValues.Replace)
+	if !d.Get("ignore_fields_changed_outside").(bool) || d.HasChange("tags") {
+		operations = expandTags(d, operations, webapi.OperationValues.Replace)
+	}
+
+	oldHyperlinks, newHyperlinks := d.GetChange("hyperlink")
+	operations = expandHyperlinks(operations, newHyperlinks.(*schema.Set).Difference(oldHyperlinks.(*schema.Set)))
+
+	oldArtifactLinks, newArtifactLinks := d.GetChange("artifact_link")
+	operations, err = expandArtifactLinks(operations, newArtifactLinks.(*schema.Set).Difference(oldArtifactLinks.(*schema.Set)), project)
+	if err != nil {
+		return err
+	}
+
+	attachmentURLs := map[string]interface{}{}
+	for path, attachmentURL := range d.Get("attachment_urls").(map[string]interface{}) {
+		attachmentURLs[path] = attachmentURL
+	}
+	oldAttachments, newAttachments := d.GetChange("attachment")
+	for _, raw := range oldAttachments.(*schema.Set).Difference(newAttachments.(*schema.Set)).List() {
+		delete(attachmentURLs, raw.(map[string]interface{})["file_path"].(string))
+	}
+	operations, err = uploadAttachments(clients, d, operations, newAttachments.(*schema.Set).Difference(oldAttachments.(*schema.Set)), attachmentURLs)
+	if err != nil {
+		return err
+	}
 
 	args := workitemtracking.UpdateWorkItemArgs{
 		Id:       &id,
@@ -222,8 +413,12 @@ func resourceWorkItemUpdate(d *schema.ResourceData, m interface{}) error {
 	}
 	_, err = clients.WorkItemTrackingClient.UpdateWorkItem(clients.Ctx, args)
 	if err != nil {
+		if rev > 0 && (utils.ResponseWasStatusCode(err, http.StatusPreconditionFailed) || utils.ResponseWasStatusCode(err, http.StatusConflict)) {
+			return fmt.Errorf("Update work item. Project ID: %s, Work Item: %s. The work item has been changed since revision %d was read, refresh the state and try again. Error: %+v", project, d.Id(), rev, err)
+		}
 		return fmt.Errorf("Update work item. Project ID: %s, Work Item: %s, Error: %+v", project, d.Id(), err)
 	}
+	d.Set("attachment_urls", attachmentURLs)
 
 	return resourceWorkItemRead(d, m)
 }
@@ -250,8 +445,15 @@ func resourceWorkItemDelete(d *schema.ResourceData, m interface{}) error {
 }
 
 func expandCustomFields(d *schema.ResourceData, operations []webapi.JsonPatchOperation) []webapi.JsonPatchOperation {
+	oldCustomFields, _ := d.GetChange("custom_fields")
+	ignoreChangedOutside := !d.IsNewResource() && d.Get("ignore_fields_changed_outside").(bool)
+
 	customFields := d.Get("custom_fields").(map[string]interface{})
 	for customFieldName, customFieldValue := range customFields {
+		// Only the custom fields changed in the configuration are sent
+		if ignoreChangedOutside && oldCustomFields.(map[string]interface{})[customFieldName] == customFieldValue {
+			continue
+		}
 		operations = append(operations, webapi.JsonPatchOperation{
 			Op:    &webapi.OperationValues.Add,
 			From:  nil,
@@ -263,38 +465,26 @@ func expandCustomFields(d *schema.ResourceData, operations []webapi.JsonPatchOpe
 }
 
 func expandSystemFields(d *schema.ResourceData, operations []webapi.JsonPatchOperation, organizationName string) []webapi.JsonPatchOperation {
+	ignoreChangedOutside := !d.IsNewResource() && d.Get("ignore_fields_changed_outside").(bool)
 	for terraformProperty, apiName := range fieldMapping {
 		if terraformProperty == "parent_id" {
-			if d.HasChange("parent_id") {
-				oldParentId, newParentId := d.GetChange("parent_id")
-				if oldParentId.(int) > 0 {
-					relations := d.Get("relations").([]interface{})
-
-					// find the parent relationship and delete it
-					for idx, relation := range relations {
-						if v, ok := relation.(map[string]interface{})["rel"]; ok && strings.EqualFold(v.(string), "System.LinkTypes.Hierarchy-Reverse") {
-							operations = append(operations, webapi.JsonPatchOperation{
-								Op:   &webapi.OperationValues.Remove,
-								From: nil,
-								Path: converter.String(fmt.Sprintf("/relations/%d", idx)),
-							})
-						}
-					}
-				}
-
-				if newParentId.(int) > 0 {
-					operations = append(operations, webapi.JsonPatchOperation{
-						Op:   &webapi.OperationValues.Add,
-						From: nil,
-						Path: converter.String("/relations/-"),
-						Value: &map[string]string{
-							"rel": "System.LinkTypes.Hierarchy-Reverse",
-							"url": fmt.Sprintf("https://dev.azure.com/%s/_apis/wit/workItems/%d", organizationName, newParentId.(int)),
-						},
-					})
-				}
+			// The previous parent relationship is removed by expandRelationRemovals
+			if newParentId := d.Get("parent_id").(int); d.HasChange("parent_id") && newParentId > 0 {
+				operations = append(operations, webapi.JsonPatchOperation{
+					Op:   &webapi.OperationValues.Add,
+					From: nil,
+					Path: converter.String("/relations/-"),
+					Value: &map[string]string{
+						"rel": "System.LinkTypes.Hierarchy-Reverse",
+						"url": fmt.Sprintf("https://dev.azure.com/%s/_apis/wit/workItems/%d", organizationName, newParentId),
+					},
+				})
 			}
 		} else {
+			// Fields changed outside of Terraform are only overwritten if they are changed in the configuration
+			if ignoreChangedOutside && !d.HasChange(terraformProperty) {
+				continue
+			}
 			value := d.Get(terraformProperty).(string)
 			if value != "" {
 				operations = append(operations, webapi.JsonPatchOperation{
@@ -309,6 +499,257 @@ func expandSystemFields(d *schema.ResourceData, operations []webapi.JsonPatchOpe
 	return operations
 }
 
+// expandRelationRemovals removes the relations that are no longer configured. Relations are removed by
+// their index in the last read state, which is safe as the update is guarded by the revision of the work item.
+func expandRelationRemovals(d *schema.ResourceData, operations []webapi.JsonPatchOperation, projectID string) ([]webapi.JsonPatchOperation, error) {
+	removed := map[string]bool{}
+	if d.HasChange("parent_id") {
+		if oldParentId, _ := d.GetChange("parent_id"); oldParentId.(int) > 0 {
+			removed["System.LinkTypes.Hierarchy-Reverse"] = true
+		}
+	}
+
+	removedURLs := map[string]bool{}
+	oldHyperlinks, newHyperlinks := d.GetChange("hyperlink")
+	for _, raw := range oldHyperlinks.(*schema.Set).Difference(newHyperlinks.(*schema.Set)).List() {
+		removedURLs[workItemRelationHyperlink+"|"+raw.(map[string]interface{})["url"].(string)] = true
+	}
+
+	oldArtifactLinks, newArtifactLinks := d.GetChange("artifact_link")
+	for _, raw := range oldArtifactLinks.(*schema.Set).Difference(newArtifactLinks.(*schema.Set)).List() {
+		uri, _, err := buildArtifactLinkURI(raw.(map[string]interface{}), projectID)
+		if err != nil {
+			return nil, err
+		}
+		removedURLs[workItemRelationArtifactLink+"|"+strings.ToLower(uri)] = true
+	}
+
+	attachmentURLs := d.Get("attachment_urls").(map[string]interface{})
+	oldAttachments, newAttachments := d.GetChange("attachment")
+	for _, raw := range oldAttachments.(*schema.Set).Difference(newAttachments.(*schema.Set)).List() {
+		if attachmentURL, ok := attachmentURLs[raw.(map[string]interface{})["file_path"].(string)]; ok {
+			removedURLs[workItemRelationAttachedFile+"|"+attachmentURL.(string)] = true
+		}
+	}
+
+	var indexes []int
+	for idx, raw := range d.Get("relations").([]interface{}) {
+		relation := raw.(map[string]interface{})
+		rel, _ := relation["rel"].(string)
+		relationURL, _ := relation["url"].(string)
+		if rel == workItemRelationArtifactLink {
+			relationURL = strings.ToLower(relationURL)
+		}
+		if removed[rel] || removedURLs[rel+"|"+relationURL] {
+			indexes = append(indexes, idx)
+		}
+	}
+
+	// Relations are removed starting with the highest index, so the remaining indexes stay valid
+	sort.Sort(sort.Reverse(sort.IntSlice(indexes)))
+	for _, idx := range indexes {
+		operations = append(operations, webapi.JsonPatchOperation{
+			Op:   &webapi.OperationValues.Remove,
+			From: nil,
+			Path: converter.String(fmt.Sprintf("/relations/%d", idx)),
+		})
+	}
+	return operations, nil
+}
+
+func expandHyperlinks(operations []webapi.JsonPatchOperation, hyperlinks *schema.Set) []webapi.JsonPatchOperation {
+	for _, raw := range hyperlinks.List() {
+		hyperlink := raw.(map[string]interface{})
+		operations = append(operations, newRelationOperation(workItemRelationHyperlink, hyperlink["url"].(string), map[string]interface{}{
+			"comment": hyperlink["comment"].(string),
+		}))
+	}
+	return operations
+}
+
+func expandArtifactLinks(operations []webapi.JsonPatchOperation, artifactLinks *schema.Set, projectID string) ([]webapi.JsonPatchOperation, error) {
+	for _, raw := range artifactLinks.List() {
+		artifactLink := raw.(map[string]interface{})
+		uri, name, err := buildArtifactLinkURI(artifactLink, projectID)
+		if err != nil {
+			return nil, err
+		}
+		operations = append(operations, newRelationOperation(workItemRelationArtifactLink, uri, map[string]interface{}{
+			"name":    name,
+			"comment": artifactLink["comment"].(string),
+		}))
+	}
+	return operations, nil
+}
+
+// uploadAttachments uploads the files of the attachments and adds them to the work item. The URL of
+// every uploaded attachment is added to attachmentURLs, keyed by the path of the file.
+func uploadAttachments(clients *client.AggregatedClient, d *schema.ResourceData, operations []webapi.JsonPatchOperation, attachments *schema.Set, attachmentURLs map[string]interface{}) ([]webapi.JsonPatchOperation, error) {
+	for _, raw := range attachments.List() {
+		attachment := raw.(map[string]interface{})
+		filePath := attachment["file_path"].(string)
+
+		file, err := os.Open(filePath)
+		if err != nil {
+			return nil, fmt.Errorf("Opening attachment %s. Error: %+v", filePath, err)
+		}
+		reference, err := clients.WorkItemTrackingClient.CreateAttachment(clients.Ctx, workitemtracking.CreateAttachmentArgs{
+			UploadStream: file,
+			Project:      converter.String(d.Get("project_id").(string)),
+			FileName:     converter.String(filepath.Base(filePath)),
+		})
+		file.Close()
+		if err != nil {
+			return nil, fmt.Errorf("Uploading attachment %s. Error: %+v", filePath, err)
+		}
+		if reference == nil || reference.Url == nil {
+			return nil, fmt.Errorf("Uploading attachment %s. The service did not return the URL of the attachment", filePath)
+		}
+
+		attachmentURLs[filePath] = *reference.Url
+		operations = append(operations, newRelationOperation(workItemRelationAttachedFile, *reference.Url, map[string]interface{}{
+			"comment": attachment["comment"].(string),
+		}))
+	}
+	return operations, nil
+}
+
+func newRelationOperation(rel string, relationURL string, attributes map[string]interface{}) webapi.JsonPatchOperation {
+	return webapi.JsonPatchOperation{
+		Op:   &webapi.OperationValues.Add,
+		From: nil,
+		Path: converter.String("/relations/-"),
+		Value: map[string]interface{}{
+			"rel":        rel,
+			"url":        relationURL,
+			"attributes": attributes,
+		},
+	}
+}
+
+// buildArtifactLinkURI returns the artifact URI and the link name of an `artifact_link` block
+func buildArtifactLinkURI(artifactLink map[string]interface{}, projectID string) (string, string, error) {
+	linkType := artifactLink["type"].(string)
+	artifactType, ok := artifactLinkTypes[linkType]
+	if !ok {
+		return "", "", fmt.Errorf("Unsupported artifact link type %q", linkType)
+	}
+
+	require := func(names ...string) error {
+		for _, name := range names {
+			switch v := artifactLink[name].(type) {
+			case string:
+				if v == "" {
+					return fmt.Errorf("`%s` is required for artifact links of type %q", name, linkType)
+				}
+			case int:
+				if v == 0 {
+					return fmt.Errorf("`%s` is required for artifact links of type %q", name, linkType)
+				}
+			}
+		}
+		return nil
+	}
+	escape := func(value string) string {
+		return strings.ReplaceAll(url.PathEscape(value), "/", "%2F")
+	}
+
+	var id string
+	switch linkType {
+	case "commit":
+		if err := require("repository_id", "commit_id"); err != nil {
+			return "", "", err
+		}
+		id = fmt.Sprintf("%s%%2F%s%%2F%s", projectID, artifactLink["repository_id"].(string), artifactLink["commit_id"].(string))
+	case "pull_request":
+		if err := require("repository_id", "pull_request_id"); err != nil {
+			return "", "", err
+		}
+		id = fmt.Sprintf("%s%%2F%s%%2F%d", projectID, artifactLink["repository_id"].(string), artifactLink["pull_request_id"].(int))
+	case "branch":
+		if err := require("repository_id", "branch_name"); err != nil {
+			return "", "", err
+		}
+		id = fmt.Sprintf("%s%%2F%s%%2FGB%s", projectID, artifactLink["repository_id"].(string), escape(artifactLink["branch_name"].(string)))
+	case "build":
+		if err := require("build_id"); err != nil {
+			return "", "", err
+		}
+		id = strconv.Itoa(artifactLink["build_id"].(int))
+	case "wiki_page":
+		if err := require("wiki_id", "wiki_page_path"); err != nil {
+			return "", "", err
+		}
+		id = fmt.Sprintf("%s%%2F%s%%2F%s", projectID, artifactLink["wiki_id"].(string), escape(artifactLink["wiki_page_path"].(string)))
+	}
+	return artifactType.uriPrefix + id, artifactType.name, nil
+}
+
+// parseArtifactLinkURI parses an artifact URI into an `artifact_link` block. Artifacts of unsupported
+// types are ignored.
+func parseArtifactLinkURI(uri string) (map[string]interface{}, bool) {
+	for linkType, artifactType := range artifactLinkTypes {
+		if !strings.HasPrefix(strings.ToLower(uri), strings.ToLower(artifactType.uriPrefix)) {
+			continue
+		}
+		id := uri[len(artifactType.uriPrefix):]
+		id = strings.ReplaceAll(id, "%2f", "%2F")
+
+		artifactLink := map[string]interface{}{
+			"type":            linkType,
+			"repository_id":   "",
+			"commit_id":       "",
+			"pull_request_id": 0,
+			"branch_name":     "",
+			"build_id":        0,
+			"wiki_id":         "",
+			"wiki_page_path":  "",
+		}
+
+		if linkType == "build" {
+			buildID, err := strconv.Atoi(id)
+			if err != nil {
+				return nil, false
+			}
+			artifactLink["build_id"] = buildID
+			return artifactLink, true
+		}
+
+		// All other artifacts are identified by {project}%2F{container}%2F{artifact}
+		parts := strings.SplitN(id, "%2F", 3)
+		if len(parts) != 3 {
+			return nil, false
+		}
+		artifact, err := url.PathUnescape(parts[2])
+		if err != nil {
+			return nil, false
+		}
+		switch linkType {
+		case "commit":
+			artifactLink["repository_id"] = parts[1]
+			artifactLink["commit_id"] = artifact
+		case "pull_request":
+			pullRequestID, err := strconv.Atoi(artifact)
+			if err != nil {
+				return nil, false
+			}
+			artifactLink["repository_id"] = parts[1]
+			artifactLink["pull_request_id"] = pullRequestID
+		case "branch":
+			if !strings.HasPrefix(artifact, "GB") {
+				return nil, false
+			}
+			artifactLink["repository_id"] = parts[1]
+			artifactLink["branch_name"] = strings.TrimPrefix(artifact, "GB")
+		case "wiki_page":
+			artifactLink["wiki_id"] = parts[1]
+			artifactLink["wiki_page_path"] = artifact
+		}
+		return artifactLink, true
+	}
+	return nil, false
+}
+
 func expandTags(d *schema.ResourceData, operations []webapi.JsonPatchOperation, op webapi.Operation) []webapi.JsonPatchOperation {
 	tags := d.Get("tags").(*schema.Set).List()
 	if len(tags) == 0 {
@@ -331,6 +772,16 @@ func expandTags(d *schema.ResourceData, operations []webapi.JsonPatchOperation,
 }
 
 func flattenFields(d *schema.ResourceData, m *map[string]interface{}) {
+	// Fields changed outside of Terraform are not refreshed, only the values unknown to the state are filled in
+	if d.Get("ignore_fields_changed_outside").(bool) && d.Get("title").(string) != "" {
+		for key, value := range *m {
+			if v, ok := systemFieldMapping[key]; ok && (v == "parent_id" || d.Get(v) == "") {
+				d.Set(v, value)
+			}
+		}
+		return
+	}
+
 	customFields := make(map[string]interface{})
 	for key, value := range *m {
 		if v, ok := systemFieldMapping[key]; ok {
@@ -344,3 +795,57 @@ func flattenFields(d *schema.ResourceData, m *map[string]interface{}) {
 	}
 	d.Set("custom_fields", customFields)
 }
+
+func flattenRelations(d *schema.ResourceData, relations *[]workitemtracking.WorkItemRelation) {
+	attachmentURLs := d.Get("attachment_urls").(map[string]interface{})
+	existingURLs := map[string]bool{}
+
+	var hyperlinks, artifactLinks []interface{}
+	if relations != nil {
+		for _, relation := range *relations {
+			attributes := map[string]interface{}{}
+			if relation.Attributes != nil {
+				attributes = *relation.Attributes
+			}
+			comment, _ := attributes["comment"].(string)
+			relationURL := converter.ToString(relation.Url, "")
+
+			switch converter.ToString(relation.Rel, "") {
+			case workItemRelationHyperlink:
+				hyperlinks = append(hyperlinks, map[string]interface{}{
+					"url":     relationURL,
+					"comment": comment,
+				})
+			case workItemRelationArtifactLink:
+				if artifactLink, ok := parseArtifactLinkURI(relationURL); ok {
+					artifactLink["comment"] = comment
+					artifactLinks = append(artifactLinks, artifactLink)
+				}
+			case workItemRelationAttachedFile:
+				existingURLs[relationURL] = true
+			}
+		}
+	}
+	// Links are only refreshed if they are managed, so links added to work items that do not configure
+	// any links are left alone
+	if d.Get("hyperlink").(*schema.Set).Len() > 0 {
+		d.Set("hyperlink", hyperlinks)
+	}
+	if d.Get("artifact_link").(*schema.Set).Len() > 0 {
+		d.Set("artifact_link", artifactLinks)
+	}
+
+	// Attachments removed outside of Terraform are dropped from the state, so they are uploaded again
+	var attachments []interface{}
+	foundURLs := map[string]interface{}{}
+	for _, raw := range d.Get("attachment").(*schema.Set).List() {
+		attachment := raw.(map[string]interface{})
+		filePath := attachment["file_path"].(string)
+		if attachmentURL, ok := attachmentURLs[filePath]; ok && existingURLs[attachmentURL.(string)] {
+			attachments = append(attachments, attachment)
+			foundURLs[filePath] = attachmentURL
+		}
+	}
+	d.Set("attachment", attachments)
+	d.Set("attachment_urls", foundURLs)
+}
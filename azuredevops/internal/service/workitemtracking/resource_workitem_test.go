@@ -5,12 +5,38 @@
 package workitemtracking
 
 import (
+	"context"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/webapi"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/workitemtracking"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
 )
 
+const workItemProjectID = "e0b3ec26-0e47-4b3b-a8e3-d1a6a1f6c0d4"
+
+// getWorkItemResourceDataForUpdate returns the resource data of an update from the state to the new configuration
+func getWorkItemResourceDataForUpdate(t *testing.T, state map[string]interface{}, computed map[string]interface{}, config map[string]interface{}) *schema.ResourceData {
+	r := ResourceWorkItem()
+	old := schema.TestResourceDataRaw(t, r.Schema, state)
+	old.SetId("1")
+	for k, v := range computed {
+		require.NoError(t, old.Set(k, v))
+	}
+
+	diff, err := r.Diff(context.Background(), old.State(), terraform.NewResourceConfigRaw(config), nil)
+	require.NoError(t, err)
+	d, err := schema.InternalMap(r.Schema).Data(old.State(), diff)
+	require.NoError(t, err)
+	return d
+}
+
 func TestWorkItem_GetWorkItem(t *testing.T) {
 	r := ResourceWorkItem()
 	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{})
@@ -35,3 +61,186 @@ func TestWorkItem_GetWorkItem(t *testing.T) {
 	require.Equal(t, "SomeValue", custom_fields["SomeName"].(string))
 	require.Equal(t, "bar", custom_fields["foo"].(string))
 }
+
+func TestWorkItem_GetWorkItem_IgnoreFieldsChangedOutside(t *testing.T) {
+	r := ResourceWorkItem()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"title":                         "TestTitle",
+		"tags":                          []interface{}{"tag1"},
+		"ignore_fields_changed_outside": true,
+	})
+	input := map[string]interface{}{
+		"System.State":    "Active",
+		"System.Title":    "Changed by someone else",
+		"System.Tags":     "tag1; tag2",
+		"Custom.SomeName": "SomeValue",
+	}
+	flattenFields(d, &input)
+
+	require.Equal(t, "TestTitle", d.Get("title").(string))
+	require.Equal(t, "Active", d.Get("state").(string))
+	require.Equal(t, 1, len(d.Get("tags").(*schema.Set).List()))
+	require.Empty(t, d.Get("custom_fields").(map[string]interface{}))
+}
+
+func TestWorkItem_ArtifactLinkURI_RoundTrip(t *testing.T) {
+	repositoryID := "7e3f1e0c-0d75-4e3c-a3b2-1d1fa0d1ad01"
+	wikiID := "4a6d4e8e-5e8b-4c7b-8c0f-3c7b1f5e2a11"
+	cases := []struct {
+		link map[string]interface{}
+		uri  string
+	}{
+		{
+			link: map[string]interface{}{"type": "commit", "repository_id": repositoryID, "commit_id": "abc123"},
+			uri:  "vstfs:///Git/Commit/" + workItemProjectID + "%2F" + repositoryID + "%2Fabc123",
+		},
+		{
+			link: map[string]interface{}{"type": "pull_request", "repository_id": repositoryID, "pull_request_id": 42},
+			uri:  "vstfs:///Git/PullRequestId/" + workItemProjectID + "%2F" + repositoryID + "%2F42",
+		},
+		{
+			link: map[string]interface{}{"type": "branch", "repository_id": repositoryID, "branch_name": "feature/links"},
+			uri:  "vstfs:///Git/Ref/" + workItemProjectID + "%2F" + repositoryID + "%2FGBfeature%2Flinks",
+		},
+		{
+			link: map[string]interface{}{"type": "build", "build_id": 7},
+			uri:  "vstfs:///Build/Build/7",
+		},
+		{
+			link: map[string]interface{}{"type": "wiki_page", "wiki_id": wikiID, "wiki_page_path": "/Home/Release notes"},
+			uri:  "vstfs:///Wiki/WikiPage/" + workItemProjectID + "%2F" + wikiID + "%2F%2FHome%2FRelease%20notes",
+		},
+	}
+
+	for _, tc := range cases {
+		link := map[string]interface{}{
+			"repository_id":   "",
+			"commit_id":       "",
+			"pull_request_id": 0,
+			"branch_name":     "",
+			"build_id":        0,
+			"wiki_id":         "",
+			"wiki_page_path":  "",
+		}
+		for k, v := range tc.link {
+			link[k] = v
+		}
+
+		uri, _, err := buildArtifactLinkURI(link, workItemProjectID)
+		require.NoError(t, err)
+		require.Equal(t, tc.uri, uri)
+
+		parsed, ok := parseArtifactLinkURI(uri)
+		require.True(t, ok)
+		require.Equal(t, link, parsed)
+	}
+}
+
+func TestWorkItem_ArtifactLinkURI_MissingArguments(t *testing.T) {
+	_, _, err := buildArtifactLinkURI(map[string]interface{}{
+		"type":          "commit",
+		"repository_id": "7e3f1e0c-0d75-4e3c-a3b2-1d1fa0d1ad01",
+		"commit_id":     "",
+	}, workItemProjectID)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "commit_id")
+}
+
+func TestWorkItem_Update_TestsRevisionAndRemovesRelationsInReverseOrder(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := azdosdkmocks.NewMockWorkitemtrackingClient(ctrl)
+	clients := &client.AggregatedClient{
+		WorkItemTrackingClient: mockClient,
+		OrganizationURL:        "https://dev.azure.com/org",
+		Ctx:                    context.Background(),
+	}
+
+	base := map[string]interface{}{
+		"title":      "Title",
+		"project_id": workItemProjectID,
+		"type":       "Issue",
+		"parent_id":  10,
+		"hyperlink": []interface{}{
+			map[string]interface{}{"url": "https://example.com/a"},
+		},
+	}
+	config := map[string]interface{}{
+		"title":      "Title",
+		"project_id": workItemProjectID,
+		"type":       "Issue",
+	}
+	d := getWorkItemResourceDataForUpdate(t, base, map[string]interface{}{
+		"rev": 5,
+		"relations": []interface{}{
+			map[string]interface{}{"rel": "System.LinkTypes.Hierarchy-Reverse", "url": "https://dev.azure.com/org/_apis/wit/workItems/10"},
+			map[string]interface{}{"rel": "System.LinkTypes.Related", "url": "https://dev.azure.com/org/_apis/wit/workItems/11"},
+			map[string]interface{}{"rel": "Hyperlink", "url": "https://example.com/a"},
+		},
+	}, config)
+
+	mockClient.EXPECT().UpdateWorkItem(clients.Ctx, gomock.Any()).DoAndReturn(
+		func(ctx context.Context, args workitemtracking.UpdateWorkItemArgs) (*workitemtracking.WorkItem, error) {
+			operations := *args.Document
+			require.Equal(t, webapi.OperationValues.Test, *operations[0].Op)
+			require.Equal(t, "/rev", *operations[0].Path)
+			require.Equal(t, 5, operations[0].Value)
+			require.Equal(t, "/relations/2", *operations[1].Path)
+			require.Equal(t, webapi.OperationValues.Remove, *operations[1].Op)
+			require.Equal(t, "/relations/0", *operations[2].Path)
+			require.Equal(t, webapi.OperationValues.Remove, *operations[2].Op)
+			return &workitemtracking.WorkItem{Id: converter.Int(1)}, nil
+		}).Times(1)
+	mockClient.EXPECT().GetWorkItem(clients.Ctx, gomock.Any()).Return(&workitemtracking.WorkItem{
+		Id:  converter.Int(1),
+		Rev: converter.Int(6),
+	}, nil).Times(1)
+
+	require.NoError(t, resourceWorkItemUpdate(d, clients))
+	require.Equal(t, 6, d.Get("rev"))
+}
+
+func TestWorkItem_Update_IgnoreFieldsChangedOutside_OnlySendsChanges(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := azdosdkmocks.NewMockWorkitemtrackingClient(ctrl)
+	clients := &client.AggregatedClient{
+		WorkItemTrackingClient: mockClient,
+		OrganizationURL:        "https://dev.azure.com/org",
+		Ctx:                    context.Background(),
+	}
+
+	state := map[string]interface{}{
+		"title":                         "Title",
+		"project_id":                    workItemProjectID,
+		"type":                          "Issue",
+		"state":                         "Active",
+		"area_path":                     "project",
+		"ignore_fields_changed_outside": true,
+	}
+	config := map[string]interface{}{
+		"title":                         "New title",
+		"project_id":                    workItemProjectID,
+		"type":                          "Issue",
+		"ignore_fields_changed_outside": true,
+	}
+	d := getWorkItemResourceDataForUpdate(t, state, map[string]interface{}{"rev": 2}, config)
+
+	mockClient.EXPECT().UpdateWorkItem(clients.Ctx, gomock.Any()).DoAndReturn(
+		func(ctx context.Context, args workitemtracking.UpdateWorkItemArgs) (*workitemtracking.WorkItem, error) {
+			paths := map[string]interface{}{}
+			for _, operation := range *args.Document {
+				paths[*operation.Path] = operation.Value
+			}
+			require.Equal(t, map[string]interface{}{
+				"/rev":                 2,
+				"/fields/System.Title": "New title",
+			}, paths)
+			return &workitemtracking.WorkItem{Id: converter.Int(1)}, nil
+		}).Times(1)
+	mockClient.EXPECT().GetWorkItem(clients.Ctx, gomock.Any()).Return(&workitemtracking.WorkItem{Id: converter.Int(1)}, nil).Times(1)
+
+	require.NoError(t, resourceWorkItemUpdate(d, clients))
+}
@@ -2,6 +2,9 @@ package workitemtracking
 
 import (
 	"context"
+	"fmt"
+	"regexp"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -11,6 +14,13 @@ import (
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/sdk/workitemtrackingextras"
+)
+
+var (
+	wiqlSelectRegex  = regexp.MustCompile(`(?is)^\s*SELECT\s+.+?\s+FROM\s`)
+	wiqlOrderByRegex = regexp.MustCompile(`(?is)\s+ORDER\s+BY\s+.+?(\s+(?:ASOF|MODE)\b.*)?$`)
+	wiqlTailRegex    = regexp.MustCompile(`(?is)\s+(?:ASOF|MODE)\b.*$`)
 )
 
 func ResourceQuery() *schema.Resource {
@@ -65,6 +75,97 @@ func ResourceQuery() *schema.Resource {
 				// The value of 32000 matches the restrictions in Azure DevOps.
 				ValidateFunc: validation.StringLenBetween(1, 32000),
 			},
+
+			// The columns and the sort order are part of the WIQL, if specified they replace
+			// the SELECT and ORDER BY clauses of 'wiql'.
+			"columns": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotWhiteSpace,
+				},
+			},
+
+			"sort_column": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"field": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+						"descending": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+
+			"chart": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"title": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+						"chart_type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"pieChart", "barChart", "columnChart", "stackBarChart", "stackColumnChart",
+								"areaChart", "stackAreaChart", "lineChart", "pivotTable",
+							}, false),
+						},
+						"group_by": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+						"series": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+						"aggregation": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "count",
+							ValidateFunc: validation.StringInSlice([]string{"count", "sum"}, false),
+						},
+						"aggregation_field": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+						"order_by": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "value",
+							ValidateFunc: validation.StringInSlice([]string{"value", "label"}, false),
+						},
+						"order_direction": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "descending",
+							ValidateFunc: validation.StringInSlice([]string{"ascending", "descending"}, false),
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -84,7 +185,7 @@ func resourceQueryCreate(ctx context.Context, d *schema.ResourceData, m interfac
 		Query:   &parent,
 		PostedQuery: &workitemtracking.QueryHierarchyItem{
 			Name:     converter.String(d.Get("name").(string)),
-			Wiql:     converter.String(d.Get("wiql").(string)),
+			Wiql:     converter.String(expandQueryWiql(d)),
 			IsFolder: converter.Bool(false),
 		},
 	}
@@ -104,6 +205,10 @@ func resourceQueryCreate(ctx context.Context, d *schema.ResourceData, m interfac
 
 	d.SetId(resp.Id.String())
 
+	if err := updateQueryCharts(clients, d); err != nil {
+		return diag.Errorf(" Creating query charts. Error: %+v", err)
+	}
+
 	return resourceQueryRead(clients.Ctx, d, m)
 }
 
@@ -119,6 +224,7 @@ func resourceQueryRead(ctx context.Context, d *schema.ResourceData, m interface{
 	params := workitemtracking.GetQueryArgs{
 		Project: converter.String(d.Get("project_id").(string)),
 		Query:   &id,
+		Expand:  &workitemtracking.QueryExpandValues.All,
 	}
 
 	resp, err := clients.WorkItemTrackingClient.GetQuery(clients.Ctx, params)
@@ -141,6 +247,22 @@ func resourceQueryRead(ctx context.Context, d *schema.ResourceData, m interface{
 		return diag.Errorf(" The query with id: %s is a folder. Expected a query.", id)
 	}
 
+	d.Set("columns", flattenQueryColumns(resp.Columns))
+	d.Set("sort_column", flattenQuerySortColumns(resp.SortColumns))
+
+	// Charts are only read if they are managed, the chart API is not part of the public REST API
+	if len(d.Get("chart").([]interface{})) > 0 {
+		charts, err := clients.WorkItemTrackingClientExtras.GetChartConfigurations(clients.Ctx, workitemtrackingextras.GetChartConfigurationsArgs{
+			Project:  converter.String(d.Get("project_id").(string)),
+			Scope:    converter.String(workitemtrackingextras.ChartScopeQueries),
+			GroupKey: converter.String(id),
+		})
+		if err != nil {
+			return diag.Errorf(" Getting charts of query with id: %s. Error: %+v", id, err)
+		}
+		d.Set("chart", flattenQueryCharts(d.Get("chart").([]interface{}), charts))
+	}
+
 	return nil
 }
 
@@ -165,8 +287,8 @@ func resourceQueryUpdate(ctx context.Context, d *schema.ResourceData, m interfac
 		QueryUpdate: existing,
 	}
 
-	if d.HasChange("wiql") {
-		updateArgs.QueryUpdate.Wiql = converter.String(d.Get("wiql").(string))
+	if d.HasChanges("wiql", "columns", "sort_column") {
+		updateArgs.QueryUpdate.Wiql = converter.String(expandQueryWiql(d))
 	}
 
 	if d.HasChange("name") {
@@ -178,6 +300,12 @@ func resourceQueryUpdate(ctx context.Context, d *schema.ResourceData, m interfac
 		return diag.Errorf(" Updating query with ID: %s. Error detail: %+v", id, err)
 	}
 
+	if d.HasChange("chart") {
+		if err := updateQueryCharts(clients, d); err != nil {
+			return diag.Errorf(" Updating charts of query with ID: %s. Error detail: %+v", id, err)
+		}
+	}
+
 	return resourceQueryRead(clients.Ctx, d, m)
 }
 
@@ -197,3 +325,273 @@ func resourceQueryDelete(ctx context.Context, d *schema.ResourceData, m interfac
 	}
 	return nil
 }
+
+// expandQueryWiql returns the WIQL of the query, with the SELECT and ORDER BY clauses replaced by the
+// configured columns and sort columns.
+func expandQueryWiql(d *schema.ResourceData) string {
+	wiql := d.Get("wiql").(string)
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsNull() {
+		return wiql
+	}
+
+	if !rawConfig.GetAttr("columns").IsNull() {
+		var columns []string
+		for _, column := range d.Get("columns").([]interface{}) {
+			columns = append(columns, fmt.Sprintf("[%s]", column.(string)))
+		}
+		if len(columns) > 0 {
+			wiql = wiqlSelectRegex.ReplaceAllLiteralString(wiql, fmt.Sprintf("SELECT %s FROM ", strings.Join(columns, ", ")))
+		}
+	}
+
+	if !rawConfig.GetAttr("sort_column").IsNull() {
+		var sortColumns []string
+		for _, raw := range d.Get("sort_column").([]interface{}) {
+			sortColumn := raw.(map[string]interface{})
+			direction := "ASC"
+			if sortColumn["descending"].(bool) {
+				direction = "DESC"
+			}
+			sortColumns = append(sortColumns, fmt.Sprintf("[%s] %s", sortColumn["field"].(string), direction))
+		}
+		wiql = replaceWiqlOrderBy(wiql, sortColumns)
+	}
+	return wiql
+}
+
+// replaceWiqlOrderBy replaces the ORDER BY clause of a WIQL, keeping a trailing ASOF or MODE clause
+func replaceWiqlOrderBy(wiql string, sortColumns []string) string {
+	orderBy := ""
+	if len(sortColumns) > 0 {
+		orderBy = " ORDER BY " + strings.Join(sortColumns, ", ")
+	}
+
+	if match := wiqlOrderByRegex.FindStringSubmatchIndex(wiql); match != nil {
+		tail := ""
+		if match[2] >= 0 {
+			tail = wiql[match[2]:match[3]]
+		}
+		return wiql[:match[0]] + orderBy + tail
+	}
+	if match := wiqlTailRegex.FindStringIndex(wiql); match != nil {
+		return wiql[:match[0]] + orderBy + wiql[match[0]:]
+	}
+	return strings.TrimRight(wiql, " \t\r\n") + orderBy
+}
+
+// updateQueryCharts creates, updates and deletes the charts of the query. Charts are matched by their title.
+func updateQueryCharts(clients *client.AggregatedClient, d *schema.ResourceData) error {
+	projectID := d.Get("project_id").(string)
+	oldCharts, newCharts := d.GetChange("chart")
+	newList := newCharts.([]interface{})
+	chartIDs, deleteIDs := matchQueryCharts(oldCharts.([]interface{}), newList)
+
+	var charts []interface{}
+	for idx, raw := range newList {
+		chart := expandQueryChart(raw.(map[string]interface{}), d.Id())
+
+		var result *workitemtrackingextras.ChartConfiguration
+		var err error
+		if chartIDs[idx] != "" {
+			chart.ChartId = converter.String(chartIDs[idx])
+			result, err = clients.WorkItemTrackingClientExtras.UpdateChartConfiguration(clients.Ctx, workitemtrackingextras.UpdateChartConfigurationArgs{
+				Project: converter.String(projectID),
+				Chart:   chart,
+			})
+		} else {
+			result, err = clients.WorkItemTrackingClientExtras.CreateChartConfiguration(clients.Ctx, workitemtrackingextras.CreateChartConfigurationArgs{
+				Project: converter.String(projectID),
+				Chart:   chart,
+			})
+		}
+		if err != nil {
+			return err
+		}
+		if result != nil && result.ChartId != nil {
+			chart.ChartId = result.ChartId
+		}
+		charts = append(charts, flattenQueryChart(*chart))
+	}
+
+	for _, chartID := range deleteIDs {
+		err := clients.WorkItemTrackingClientExtras.DeleteChartConfiguration(clients.Ctx, workitemtrackingextras.DeleteChartConfigurationArgs{
+			Project: converter.String(projectID),
+			ChartId: converter.String(chartID),
+		})
+		if err != nil && !utils.ResponseWasNotFound(err) {
+			return err
+		}
+	}
+
+	d.Set("chart", charts)
+	return nil
+}
+
+// matchQueryCharts returns the ID of the existing chart for every configured chart, or an empty string if the chart
+// has to be created, and the IDs of the existing charts that are no longer configured. Charts are matched by their
+// title, the ID of a configured chart is taken from the chart at the same position and cannot be relied upon.
+func matchQueryCharts(oldList []interface{}, newList []interface{}) ([]string, []string) {
+	used := make([]bool, len(oldList))
+	chartIDs := make([]string, len(newList))
+	for idx, raw := range newList {
+		title := raw.(map[string]interface{})["title"].(string)
+		for oldIdx, oldRaw := range oldList {
+			oldChart, ok := oldRaw.(map[string]interface{})
+			if !ok || used[oldIdx] || oldChart["title"].(string) != title || oldChart["id"].(string) == "" {
+				continue
+			}
+			used[oldIdx] = true
+			chartIDs[idx] = oldChart["id"].(string)
+			break
+		}
+	}
+
+	var deleteIDs []string
+	for oldIdx, oldRaw := range oldList {
+		oldChart, ok := oldRaw.(map[string]interface{})
+		if !ok || used[oldIdx] || oldChart["id"].(string) == "" {
+			continue
+		}
+		deleteIDs = append(deleteIDs, oldChart["id"].(string))
+	}
+	return chartIDs, deleteIDs
+}
+
+func expandQueryChart(input map[string]interface{}, queryID string) *workitemtrackingextras.ChartConfiguration {
+	measure := &workitemtrackingextras.ChartMeasure{
+		Aggregation: converter.String(input["aggregation"].(string)),
+	}
+	if v := input["aggregation_field"].(string); v != "" {
+		measure.PropertyName = converter.String(v)
+	}
+
+	transformOptions := &workitemtrackingextras.ChartTransformOptions{
+		Filter:  converter.String(queryID),
+		GroupBy: converter.String(input["group_by"].(string)),
+		OrderBy: &workitemtrackingextras.ChartOrderBy{
+			Direction:    converter.String(input["order_direction"].(string)),
+			PropertyName: converter.String(input["order_by"].(string)),
+		},
+		Measure: measure,
+	}
+	if v := input["series"].(string); v != "" {
+		transformOptions.Series = converter.String(v)
+	}
+
+	return &workitemtrackingextras.ChartConfiguration{
+		ChartType:        converter.String(input["chart_type"].(string)),
+		GroupKey:         converter.String(queryID),
+		Scope:            converter.String(workitemtrackingextras.ChartScopeQueries),
+		Title:            converter.String(input["title"].(string)),
+		TransformOptions: transformOptions,
+		UserColors:       &[]interface{}{},
+	}
+}
+
+func flattenQueryColumns(columns *[]workitemtracking.WorkItemFieldReference) []interface{} {
+	var result []interface{}
+	if columns == nil {
+		return result
+	}
+	for _, column := range *columns {
+		if column.ReferenceName != nil {
+			result = append(result, *column.ReferenceName)
+		}
+	}
+	return result
+}
+
+func flattenQuerySortColumns(sortColumns *[]workitemtracking.WorkItemQuerySortColumn) []interface{} {
+	var result []interface{}
+	if sortColumns == nil {
+		return result
+	}
+	for _, sortColumn := range *sortColumns {
+		if sortColumn.Field == nil || sortColumn.Field.ReferenceName == nil {
+			continue
+		}
+		result = append(result, map[string]interface{}{
+			"field":      *sortColumn.Field.ReferenceName,
+			"descending": converter.ToBool(sortColumn.Descending, false),
+		})
+	}
+	return result
+}
+
+// flattenQueryCharts returns the charts in the order of the configured charts, which are matched by their ID or
+// title. Charts that are not configured, e.g. created outside of Terraform, are appended in the order of the service.
+func flattenQueryCharts(configured []interface{}, charts *[]workitemtrackingextras.ChartConfiguration) []interface{} {
+	var result []interface{}
+	if charts == nil {
+		return result
+	}
+
+	used := make([]bool, len(*charts))
+	findChart := func(match func(chart workitemtrackingextras.ChartConfiguration) bool) int {
+		for idx, chart := range *charts {
+			if !used[idx] && match(chart) {
+				return idx
+			}
+		}
+		return -1
+	}
+	for _, raw := range configured {
+		configuredChart, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		chartID, _ := configuredChart["id"].(string)
+		title, _ := configuredChart["title"].(string)
+
+		idx := -1
+		if chartID != "" {
+			idx = findChart(func(chart workitemtrackingextras.ChartConfiguration) bool {
+				return strings.EqualFold(converter.ToString(chart.ChartId, ""), chartID)
+			})
+		}
+		if idx < 0 {
+			idx = findChart(func(chart workitemtrackingextras.ChartConfiguration) bool {
+				return converter.ToString(chart.Title, "") == title
+			})
+		}
+		if idx >= 0 {
+			used[idx] = true
+			result = append(result, flattenQueryChart((*charts)[idx]))
+		}
+	}
+
+	for idx, chart := range *charts {
+		if !used[idx] {
+			result = append(result, flattenQueryChart(chart))
+		}
+	}
+	return result
+}
+
+func flattenQueryChart(chart workitemtrackingextras.ChartConfiguration) map[string]interface{} {
+	result := map[string]interface{}{
+		"id":                converter.ToString(chart.ChartId, ""),
+		"title":             converter.ToString(chart.Title, ""),
+		"chart_type":        converter.ToString(chart.ChartType, ""),
+		"group_by":          "",
+		"series":            "",
+		"aggregation":       "count",
+		"aggregation_field": "",
+		"order_by":          "value",
+		"order_direction":   "descending",
+	}
+	if options := chart.TransformOptions; options != nil {
+		result["group_by"] = converter.ToString(options.GroupBy, "")
+		result["series"] = converter.ToString(options.Series, "")
+		if options.Measure != nil {
+			result["aggregation"] = converter.ToString(options.Measure.Aggregation, "count")
+			result["aggregation_field"] = converter.ToString(options.Measure.PropertyName, "")
+		}
+		if options.OrderBy != nil {
+			result["order_by"] = converter.ToString(options.OrderBy.PropertyName, "value")
+			result["order_direction"] = converter.ToString(options.OrderBy.Direction, "descending")
+		}
+	}
+	return result
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/sdk/workitemtrackingextras"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 )
@@ -255,3 +256,181 @@ func TestResourceQuery_Update_Error_OnUpdateFailure(t *testing.T) {
 	assert.Len(t, diags, 1)
 	assert.Contains(t, diags[0].Summary, "Updating query")
 }
+
+// Test the ORDER BY clause is replaced, keeping trailing clauses.
+func TestResourceQuery_ReplaceWiqlOrderBy(t *testing.T) {
+	sortColumns := []string{"[System.ChangedDate] DESC", "[System.Id] ASC"}
+	cases := map[string]string{
+		"SELECT [System.Id] FROM WorkItems":                                               "SELECT [System.Id] FROM WorkItems ORDER BY [System.ChangedDate] DESC, [System.Id] ASC",
+		"SELECT [System.Id] FROM WorkItems ORDER BY [System.Title]":                       "SELECT [System.Id] FROM WorkItems ORDER BY [System.ChangedDate] DESC, [System.Id] ASC",
+		"SELECT [System.Id] FROM WorkItems order by [System.Title] asc ASOF '01-01-2025'": "SELECT [System.Id] FROM WorkItems ORDER BY [System.ChangedDate] DESC, [System.Id] ASC ASOF '01-01-2025'",
+		"SELECT [System.Id] FROM WorkItemLinks WHERE [System.Id] > 0 MODE (Recursive)":    "SELECT [System.Id] FROM WorkItemLinks WHERE [System.Id] > 0 ORDER BY [System.ChangedDate] DESC, [System.Id] ASC MODE (Recursive)",
+	}
+	for wiql, expected := range cases {
+		assert.Equal(t, expected, replaceWiqlOrderBy(wiql, sortColumns))
+	}
+}
+
+// Test read sets the columns and the sort columns of the query.
+func TestResourceQuery_Read_SetsColumns(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockClient := azdosdkmocks.NewMockWorkitemtrackingClient(ctrl)
+	clients := &client.AggregatedClient{WorkItemTrackingClient: mockClient, Ctx: context.Background()}
+
+	queryID := uuid.NewString()
+	d := getQueryResourceData(t, map[string]interface{}{
+		"name":       "MyQuery",
+		"wiql":       "SELECT [System.Id], [System.Title] FROM WorkItems ORDER BY [System.Id] DESC",
+		"area":       "Shared Queries",
+		"project_id": uuid.NewString(),
+	})
+	d.SetId(queryID)
+
+	mockClient.EXPECT().GetQuery(clients.Ctx, gomock.Any()).DoAndReturn(
+		func(ctx context.Context, args workitemtracking.GetQueryArgs) (*workitemtracking.QueryHierarchyItem, error) {
+			assert.Equal(t, workitemtracking.QueryExpandValues.All, *args.Expand)
+			return &workitemtracking.QueryHierarchyItem{
+				Id:       converter.UUID(queryID),
+				Name:     converter.String("MyQuery"),
+				IsFolder: converter.Bool(false),
+				Columns: &[]workitemtracking.WorkItemFieldReference{
+					{ReferenceName: converter.String("System.Id")},
+					{ReferenceName: converter.String("System.Title")},
+				},
+				SortColumns: &[]workitemtracking.WorkItemQuerySortColumn{
+					{Field: &workitemtracking.WorkItemFieldReference{ReferenceName: converter.String("System.Id")}, Descending: converter.Bool(true)},
+				},
+			}, nil
+		},
+	).Times(1)
+
+	diags := resourceQueryRead(context.Background(), d, clients)
+
+	assert.Empty(t, diags)
+	assert.Equal(t, []interface{}{"System.Id", "System.Title"}, d.Get("columns"))
+	assert.Equal(t, "System.Id", d.Get("sort_column.0.field"))
+	assert.Equal(t, true, d.Get("sort_column.0.descending"))
+}
+
+// Test create also creates the charts of the query.
+func TestResourceQuery_Create_CreatesCharts(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockClient := azdosdkmocks.NewMockWorkitemtrackingClient(ctrl)
+	extrasClient := azdosdkmocks.NewMockWorkitemtrackingextrasClient(ctrl)
+	clients := &client.AggregatedClient{
+		WorkItemTrackingClient:       mockClient,
+		WorkItemTrackingClientExtras: extrasClient,
+		Ctx:                          context.Background(),
+	}
+
+	projectID := uuid.NewString()
+	queryID := uuid.NewString()
+	chartID := uuid.NewString()
+
+	mockClient.EXPECT().CreateQuery(clients.Ctx, gomock.Any()).Return(&workitemtracking.QueryHierarchyItem{Id: converter.UUID(queryID)}, nil).Times(1)
+	extrasClient.EXPECT().CreateChartConfiguration(clients.Ctx, gomock.Any()).DoAndReturn(
+		func(ctx context.Context, args workitemtrackingextras.CreateChartConfigurationArgs) (*workitemtrackingextras.ChartConfiguration, error) {
+			assert.Equal(t, projectID, *args.Project)
+			assert.Equal(t, queryID, *args.Chart.GroupKey)
+			assert.Equal(t, queryID, *args.Chart.TransformOptions.Filter)
+			assert.Equal(t, workitemtrackingextras.ChartScopeQueries, *args.Chart.Scope)
+			assert.Equal(t, "System.State", *args.Chart.TransformOptions.GroupBy)
+			assert.Equal(t, "count", *args.Chart.TransformOptions.Measure.Aggregation)
+			chart := *args.Chart
+			chart.ChartId = converter.String(chartID)
+			return &chart, nil
+		},
+	).Times(1)
+	mockClient.EXPECT().GetQuery(clients.Ctx, gomock.Any()).Return(&workitemtracking.QueryHierarchyItem{
+		Id:       converter.UUID(queryID),
+		Name:     converter.String("MyQuery"),
+		IsFolder: converter.Bool(false),
+	}, nil).Times(1)
+	extrasClient.EXPECT().GetChartConfigurations(clients.Ctx, gomock.Any()).Return(&[]workitemtrackingextras.ChartConfiguration{
+		{
+			ChartId:   converter.String(chartID),
+			ChartType: converter.String("pieChart"),
+			Title:     converter.String("By state"),
+			TransformOptions: &workitemtrackingextras.ChartTransformOptions{
+				GroupBy: converter.String("System.State"),
+				Measure: &workitemtrackingextras.ChartMeasure{Aggregation: converter.String("count")},
+				OrderBy: &workitemtrackingextras.ChartOrderBy{Direction: converter.String("descending"), PropertyName: converter.String("value")},
+			},
+		},
+	}, nil).Times(1)
+
+	d := getQueryResourceData(t, map[string]interface{}{
+		"name":       "MyQuery",
+		"wiql":       "SELECT [System.Id] FROM WorkItems",
+		"area":       "Shared Queries",
+		"project_id": projectID,
+		"chart": []interface{}{
+			map[string]interface{}{
+				"title":      "By state",
+				"chart_type": "pieChart",
+				"group_by":   "System.State",
+			},
+		},
+	})
+
+	diags := resourceQueryCreate(context.Background(), d, clients)
+
+	assert.Empty(t, diags)
+	assert.Equal(t, chartID, d.Get("chart.0.id"))
+	assert.Equal(t, "System.State", d.Get("chart.0.group_by"))
+}
+
+// Test charts are matched by title, so reordering the charts updates the right chart.
+func TestResourceQuery_MatchCharts_ByTitle(t *testing.T) {
+	oldList := []interface{}{
+		map[string]interface{}{"id": "1", "title": "By state"},
+		map[string]interface{}{"id": "2", "title": "By owner"},
+		map[string]interface{}{"id": "3", "title": "Removed"},
+	}
+	newList := []interface{}{
+		// The ID is taken from the chart at the same position in the state
+		map[string]interface{}{"id": "1", "title": "By owner"},
+		map[string]interface{}{"id": "2", "title": "By state"},
+		map[string]interface{}{"id": "3", "title": "Added"},
+	}
+
+	chartIDs, deleteIDs := matchQueryCharts(oldList, newList)
+
+	assert.Equal(t, []string{"2", "1", ""}, chartIDs)
+	assert.Equal(t, []string{"3"}, deleteIDs)
+}
+
+// Test charts are read in the configured order, whatever order the service returns them in.
+func TestResourceQuery_Read_ChartsInConfiguredOrder(t *testing.T) {
+	chart := func(id, title string) workitemtrackingextras.ChartConfiguration {
+		return workitemtrackingextras.ChartConfiguration{
+			ChartId:   converter.String(id),
+			ChartType: converter.String("pieChart"),
+			Title:     converter.String(title),
+		}
+	}
+	charts := &[]workitemtrackingextras.ChartConfiguration{
+		chart("3", "Created outside of Terraform"),
+		chart("2", "By owner"),
+		chart("1", "Renamed outside of Terraform"),
+		chart("4", "By state"),
+	}
+	configured := []interface{}{
+		map[string]interface{}{"id": "1", "title": "By priority"},
+		map[string]interface{}{"id": "", "title": "By state"},
+		map[string]interface{}{"id": "2", "title": "By owner"},
+	}
+
+	var titles []interface{}
+	for _, flattened := range flattenQueryCharts(configured, charts) {
+		titles = append(titles, flattened.(map[string]interface{})["title"])
+	}
+	assert.Equal(t, []interface{}{
+		"Renamed outside of Terraform",
+		"By state",
+		"By owner",
+		"Created outside of Terraform",
+	}, titles)
+}
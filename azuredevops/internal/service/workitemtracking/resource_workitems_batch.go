@@ -0,0 +1,866 @@
+package workitemtracking
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/webapi"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/workitemtracking"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/sdk/workitemtrackingextras"
+)
+
+const (
+	workItemRelationParent  = "System.LinkTypes.Hierarchy-Reverse"
+	workItemRelationRelated = "System.LinkTypes.Related"
+)
+
+// batchWorkItem is the expanded form of a `work_item` block
+type batchWorkItem struct {
+	Key           string
+	Type          string
+	Title         string
+	State         string
+	AreaPath      string
+	IterationPath string
+	Tags          []string
+	Fields        map[string]string
+	ParentKey     string
+	ParentID      int
+	RelatedKeys   []string
+}
+
+// ResourceWorkItemsBatch schema and implementation for a batch of work items
+func ResourceWorkItemsBatch() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceWorkItemsBatchCreate,
+		ReadContext:   resourceWorkItemsBatchRead,
+		UpdateContext: resourceWorkItemsBatchUpdate,
+		DeleteContext: resourceWorkItemsBatchDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+			if !d.NewValueKnown("work_item") {
+				return nil
+			}
+			_, err := getBatchWorkItemCreationLevels(expandBatchWorkItems(d.Get("work_item").([]interface{})), nil)
+			return err
+		},
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"work_item": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						// The local key is only known to Terraform, it is used to reference work items
+						// of the same batch and to keep track of the ID of each work item.
+						"key": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+						"title": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+						"state": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+						"area_path": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+						"iteration_path": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+						"tags": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Set:      schema.HashString,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringIsNotWhiteSpace,
+							},
+						},
+						// Additional fields keyed by their reference name, e.g. `System.Description`.
+						"fields": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"parent_key": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+						"parent_id": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"related_keys": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Set:      schema.HashString,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringIsNotWhiteSpace,
+							},
+						},
+					},
+				},
+			},
+
+			"work_item_ids": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+		},
+	}
+}
+
+func resourceWorkItemsBatchCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+
+	projectID := d.Get("project_id").(string)
+	items := expandBatchWorkItems(d.Get("work_item").([]interface{}))
+
+	ids := map[string]int{}
+	err := createBatchWorkItems(clients, projectID, items, ids)
+
+	// Work items that have been created are tracked even if the batch failed part way, so they are
+	// not orphaned.
+	if len(ids) > 0 {
+		d.SetId(uuid.New().String())
+		d.Set("work_item_ids", flattenBatchWorkItemIDs(ids))
+	}
+	if err != nil {
+		return diag.Errorf(" Creating work items. Error: %+v", err)
+	}
+
+	if err := updateBatchWorkItems(clients, items, nil, ids); err != nil {
+		return diag.Errorf(" Linking work items. Error: %+v", err)
+	}
+	return resourceWorkItemsBatchRead(clients.Ctx, d, m)
+}
+
+func resourceWorkItemsBatchRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+
+	ids := expandBatchWorkItemIDs(d.Get("work_item_ids").(map[string]interface{}))
+	workItems, err := getBatchWorkItems(clients, d.Get("project_id").(string), ids)
+	if err != nil {
+		return diag.Errorf(" Reading work items. Error: %+v", err)
+	}
+
+	keysByID := map[int]string{}
+	for key, id := range ids {
+		if _, ok := workItems[id]; ok {
+			keysByID[id] = key
+		}
+	}
+
+	foundIDs := map[string]int{}
+	var flattened []interface{}
+	for _, item := range expandBatchWorkItems(d.Get("work_item").([]interface{})) {
+		id, ok := ids[item.Key]
+		if !ok {
+			continue
+		}
+		// Work items removed outside of Terraform are dropped from the state, so they are created again
+		workItem, ok := workItems[id]
+		if !ok {
+			continue
+		}
+		foundIDs[item.Key] = id
+		flattened = append(flattened, flattenBatchWorkItem(item, workItem, keysByID))
+	}
+
+	if len(foundIDs) == 0 {
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("work_item", flattened); err != nil {
+		return diag.Errorf(" Setting work_item. Error: %+v", err)
+	}
+	d.Set("work_item_ids", flattenBatchWorkItemIDs(foundIDs))
+	return nil
+}
+
+func resourceWorkItemsBatchUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+
+	projectID := d.Get("project_id").(string)
+	ids := expandBatchWorkItemIDs(d.Get("work_item_ids").(map[string]interface{}))
+
+	oldRaw, newRaw := d.GetChange("work_item")
+	oldItems := map[string]batchWorkItem{}
+	for _, item := range expandBatchWorkItems(oldRaw.([]interface{})) {
+		oldItems[item.Key] = item
+	}
+	items := expandBatchWorkItems(newRaw.([]interface{}))
+
+	// Work items that are no longer configured are deleted. A work item whose type changed is
+	// replaced, as the type of an existing work item cannot be changed through the API.
+	newItems := map[string]batchWorkItem{}
+	for _, item := range items {
+		newItems[item.Key] = item
+	}
+	var deleteIDs []int
+	for key, id := range ids {
+		item, ok := newItems[key]
+		if !ok || !strings.EqualFold(item.Type, oldItems[key].Type) {
+			deleteIDs = append(deleteIDs, id)
+			delete(ids, key)
+			delete(oldItems, key)
+		}
+	}
+
+	err := deleteBatchWorkItems(clients, projectID, deleteIDs)
+	if err == nil {
+		err = createBatchWorkItems(clients, projectID, items, ids)
+	}
+	d.Set("work_item_ids", flattenBatchWorkItemIDs(ids))
+	if err != nil {
+		return diag.Errorf(" Updating work items. Error: %+v", err)
+	}
+
+	if err := updateBatchWorkItems(clients, items, oldItems, ids); err != nil {
+		return diag.Errorf(" Updating work items. Error: %+v", err)
+	}
+	return resourceWorkItemsBatchRead(clients.Ctx, d, m)
+}
+
+func resourceWorkItemsBatchDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+
+	var deleteIDs []int
+	for _, id := range expandBatchWorkItemIDs(d.Get("work_item_ids").(map[string]interface{})) {
+		deleteIDs = append(deleteIDs, id)
+	}
+
+	if err := deleteBatchWorkItems(clients, d.Get("project_id").(string), deleteIDs); err != nil {
+		return diag.Errorf(" Deleting work items. Error: %+v", err)
+	}
+	return nil
+}
+
+// createBatchWorkItems creates the work items that have no ID yet. Parents are created before their
+// children, each level of the hierarchy is sent as one or more $batch requests. The IDs of the created
+// work items are added to ids.
+func createBatchWorkItems(clients *client.AggregatedClient, projectID string, items []batchWorkItem, ids map[string]int) error {
+	levels, err := getBatchWorkItemCreationLevels(items, ids)
+	if err != nil {
+		return err
+	}
+
+	for _, level := range levels {
+		requests := make([]workitemtrackingextras.BatchRequest, 0, len(level))
+		for _, item := range level {
+			operations := expandBatchWorkItemFields(item, nil)
+			if parentID := getBatchWorkItemParentID(item, ids); parentID > 0 {
+				operations = append(operations, newWorkItemRelationOperation(clients, workItemRelationParent, parentID))
+			}
+			requests = append(requests, workitemtrackingextras.NewCreateWorkItemRequest(projectID, item.Type, operations))
+		}
+
+		responses, err := sendWorkItemBatch(clients, requests)
+		var errs []string
+		for i, response := range responses {
+			if !response.IsSuccess() {
+				errs = append(errs, fmt.Sprintf("%s: %s", level[i].Key, response.Error()))
+				continue
+			}
+			workItem, err := response.WorkItem()
+			if err != nil || workItem.Id == nil {
+				errs = append(errs, fmt.Sprintf("%s: unable to decode the created work item: %v", level[i].Key, err))
+				continue
+			}
+			ids[level[i].Key] = *workItem.Id
+		}
+		if err != nil {
+			return err
+		}
+		if len(errs) > 0 {
+			return fmt.Errorf("failed to create %d work item(s):\n%s", len(errs), strings.Join(errs, "\n"))
+		}
+	}
+	return nil
+}
+
+// updateBatchWorkItems brings the fields and links of all work items in line with the configuration.
+// oldItems holds the previous configuration of the work items that existed before, it is used to find
+// out which fields and related links are no longer managed.
+func updateBatchWorkItems(clients *client.AggregatedClient, items []batchWorkItem, oldItems map[string]batchWorkItem, ids map[string]int) error {
+	workItems, err := getBatchWorkItems(clients, "", ids)
+	if err != nil {
+		return err
+	}
+
+	idSet := map[int]bool{}
+	for _, id := range ids {
+		idSet[id] = true
+	}
+
+	// Related links are symmetric, every pair of work items is linked once from the work item
+	// with the lower ID.
+	wantPairs := getBatchWorkItemRelatedPairs(items, ids)
+	var oldList []batchWorkItem
+	for _, item := range oldItems {
+		oldList = append(oldList, item)
+	}
+	oldPairs := getBatchWorkItemRelatedPairs(oldList, ids)
+
+	var requests []workitemtrackingextras.BatchRequest
+	var keys []string
+	for _, item := range items {
+		id := ids[item.Key]
+		workItem, ok := workItems[id]
+		if !ok {
+			continue
+		}
+
+		var operations []webapi.JsonPatchOperation
+		oldParentID := 0
+		if oldItem, existed := oldItems[item.Key]; existed {
+			operations = expandBatchWorkItemFields(item, &oldItem)
+			oldParentID = getBatchWorkItemParentID(oldItem, ids)
+		}
+
+		// Only the parent recorded in the previous state is managed, a parent added outside of
+		// Terraform is kept unless it is replaced by a configured parent.
+		parentID := getBatchWorkItemParentID(item, ids)
+		var removeIndexes []int
+		currentParentID := 0
+		related := map[int]int{}
+		if workItem.Relations != nil {
+			for idx, relation := range *workItem.Relations {
+				targetID := getWorkItemIDFromURL(converter.ToString(relation.Url, ""))
+				switch converter.ToString(relation.Rel, "") {
+				case workItemRelationParent:
+					currentParentID = targetID
+					if targetID != parentID && targetID == oldParentID {
+						removeIndexes = append(removeIndexes, idx)
+					}
+				case workItemRelationRelated:
+					related[targetID] = idx
+				}
+			}
+		}
+
+		for targetID, idx := range related {
+			pair := newWorkItemPair(id, targetID)
+			if idSet[targetID] && id < targetID && oldPairs[pair] && !wantPairs[pair] {
+				removeIndexes = append(removeIndexes, idx)
+			}
+		}
+
+		// Relations are removed by index, starting with the highest index so the remaining indexes stay valid.
+		// The removals come before the additions, so the work item never has two parents.
+		sort.Sort(sort.Reverse(sort.IntSlice(removeIndexes)))
+		for _, idx := range removeIndexes {
+			operations = append(operations, webapi.JsonPatchOperation{
+				Op:   &webapi.OperationValues.Remove,
+				Path: converter.String(fmt.Sprintf("/relations/%d", idx)),
+			})
+		}
+
+		if parentID > 0 && parentID != currentParentID {
+			operations = append(operations, newWorkItemRelationOperation(clients, workItemRelationParent, parentID))
+		}
+
+		for pair := range wantPairs {
+			if pair[0] != id {
+				continue
+			}
+			if _, linked := related[pair[1]]; !linked {
+				operations = append(operations, newWorkItemRelationOperation(clients, workItemRelationRelated, pair[1]))
+			}
+		}
+
+		if len(operations) > 0 {
+			requests = append(requests, workitemtrackingextras.NewUpdateWorkItemRequest(id, operations))
+			keys = append(keys, item.Key)
+		}
+	}
+
+	responses, err := sendWorkItemBatch(clients, requests)
+	var errs []string
+	for i, response := range responses {
+		if !response.IsSuccess() {
+			errs = append(errs, fmt.Sprintf("%s: %s", keys[i], response.Error()))
+		}
+	}
+	if err != nil {
+		return err
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("failed to update %d work item(s):\n%s", len(errs), strings.Join(errs, "\n"))
+	}
+	return nil
+}
+
+func deleteBatchWorkItems(clients *client.AggregatedClient, projectID string, ids []int) error {
+	for start := 0; start < len(ids); start += workitemtrackingextras.MaxBatchSize {
+		end := min(start+workitemtrackingextras.MaxBatchSize, len(ids))
+		chunk := ids[start:end]
+		result, err := clients.WorkItemTrackingClient.DeleteWorkItems(clients.Ctx, workitemtracking.DeleteWorkItemsArgs{
+			Project: converter.String(projectID),
+			DeleteRequest: &workitemtracking.WorkItemDeleteBatchRequest{
+				Ids: &chunk,
+			},
+		})
+		if err != nil {
+			return err
+		}
+		if result == nil || result.Results == nil {
+			continue
+		}
+
+		var errs []string
+		for _, deleted := range *result.Results {
+			// Work items that are already gone are ignored
+			if deleted.Code != nil && *deleted.Code >= 300 && *deleted.Code != 404 {
+				id := 0
+				if deleted.Id != nil {
+					id = *deleted.Id
+				}
+				errs = append(errs, fmt.Sprintf("%d: %s", id, converter.ToString(deleted.Message, "")))
+			}
+		}
+		if len(errs) > 0 {
+			return fmt.Errorf("failed to delete %d work item(s):\n%s", len(errs), strings.Join(errs, "\n"))
+		}
+	}
+	return nil
+}
+
+// getBatchWorkItems returns the work items with their relations, keyed by ID. Work items that do not
+// exist anymore are omitted.
+func getBatchWorkItems(clients *client.AggregatedClient, projectID string, ids map[string]int) (map[int]workitemtracking.WorkItem, error) {
+	idList := make([]int, 0, len(ids))
+	for _, id := range ids {
+		idList = append(idList, id)
+	}
+	sort.Ints(idList)
+
+	workItems := map[int]workitemtracking.WorkItem{}
+	for start := 0; start < len(idList); start += workitemtrackingextras.MaxBatchSize {
+		end := min(start+workitemtrackingextras.MaxBatchSize, len(idList))
+		chunk := idList[start:end]
+		args := workitemtracking.GetWorkItemsBatchArgs{
+			WorkItemGetRequest: &workitemtracking.WorkItemBatchGetRequest{
+				Ids:         &chunk,
+				Expand:      &workitemtracking.WorkItemExpandValues.All,
+				ErrorPolicy: &workitemtracking.WorkItemErrorPolicyValues.Omit,
+			},
+		}
+		if projectID != "" {
+			args.Project = converter.String(projectID)
+		}
+
+		result, err := clients.WorkItemTrackingClient.GetWorkItemsBatch(clients.Ctx, args)
+		if err != nil {
+			return nil, err
+		}
+		if result == nil {
+			continue
+		}
+		for _, workItem := range *result {
+			// With the `Omit` error policy, missing work items are returned as null entries
+			if workItem.Id != nil {
+				workItems[*workItem.Id] = workItem
+			}
+		}
+	}
+	return workItems, nil
+}
+
+func sendWorkItemBatch(clients *client.AggregatedClient, requests []workitemtrackingextras.BatchRequest) ([]workitemtrackingextras.BatchResponse, error) {
+	var responses []workitemtrackingextras.BatchResponse
+	for start := 0; start < len(requests); start += workitemtrackingextras.MaxBatchSize {
+		end := min(start+workitemtrackingextras.MaxBatchSize, len(requests))
+		chunk := requests[start:end]
+		result, err := clients.WorkItemTrackingClientExtras.SendBatch(clients.Ctx, workitemtrackingextras.SendBatchArgs{
+			Requests: &chunk,
+		})
+		if err != nil {
+			return responses, err
+		}
+		if result == nil || len(*result) != len(chunk) {
+			return responses, fmt.Errorf("the batch returned an unexpected number of responses, expected %d", len(chunk))
+		}
+		responses = append(responses, *result...)
+	}
+	return responses, nil
+}
+
+// getBatchWorkItemCreationLevels validates the work items and groups the work items without an ID in ids
+// by their depth in the hierarchy of the batch, so every work item is created after its parent.
+func getBatchWorkItemCreationLevels(items []batchWorkItem, ids map[string]int) ([][]batchWorkItem, error) {
+	byKey := map[string]batchWorkItem{}
+	for _, item := range items {
+		if _, ok := byKey[item.Key]; ok {
+			return nil, fmt.Errorf("the work item key %q is used more than once", item.Key)
+		}
+		byKey[item.Key] = item
+	}
+
+	for _, item := range items {
+		if item.ParentKey != "" && item.ParentID > 0 {
+			return nil, fmt.Errorf("work item %q: only one of `parent_key` and `parent_id` can be specified", item.Key)
+		}
+		if item.ParentKey != "" {
+			if _, ok := byKey[item.ParentKey]; !ok {
+				return nil, fmt.Errorf("work item %q: the parent key %q does not exist in the batch", item.Key, item.ParentKey)
+			}
+		}
+		for _, relatedKey := range item.RelatedKeys {
+			if relatedKey == item.Key {
+				return nil, fmt.Errorf("work item %q: a work item cannot be related to itself", item.Key)
+			}
+			if _, ok := byKey[relatedKey]; !ok {
+				return nil, fmt.Errorf("work item %q: the related key %q does not exist in the batch", item.Key, relatedKey)
+			}
+		}
+	}
+
+	depths := map[string]int{}
+	var getDepth func(key string, visiting map[string]bool) (int, error)
+	getDepth = func(key string, visiting map[string]bool) (int, error) {
+		if depth, ok := depths[key]; ok {
+			return depth, nil
+		}
+		if visiting[key] {
+			return 0, fmt.Errorf("work item %q: the parent hierarchy contains a cycle", key)
+		}
+		visiting[key] = true
+
+		depth := 0
+		if parentKey := byKey[key].ParentKey; parentKey != "" {
+			parentDepth, err := getDepth(parentKey, visiting)
+			if err != nil {
+				return 0, err
+			}
+			depth = parentDepth + 1
+		}
+		depths[key] = depth
+		return depth, nil
+	}
+
+	var levels [][]batchWorkItem
+	for _, item := range items {
+		depth, err := getDepth(item.Key, map[string]bool{})
+		if err != nil {
+			return nil, err
+		}
+		if _, exists := ids[item.Key]; exists {
+			continue
+		}
+		for len(levels) <= depth {
+			levels = append(levels, nil)
+		}
+		levels[depth] = append(levels[depth], item)
+	}
+
+	var result [][]batchWorkItem
+	for _, level := range levels {
+		if len(level) > 0 {
+			result = append(result, level)
+		}
+	}
+	return result, nil
+}
+
+// getBatchWorkItemRelatedPairs returns the related links between work items with a known ID, as ordered ID pairs
+func getBatchWorkItemRelatedPairs(items []batchWorkItem, ids map[string]int) map[[2]int]bool {
+	pairs := map[[2]int]bool{}
+	for _, item := range items {
+		id, ok := ids[item.Key]
+		if !ok {
+			continue
+		}
+		for _, relatedKey := range item.RelatedKeys {
+			if relatedID, ok := ids[relatedKey]; ok {
+				pairs[newWorkItemPair(id, relatedID)] = true
+			}
+		}
+	}
+	return pairs
+}
+
+func newWorkItemPair(a, b int) [2]int {
+	if a > b {
+		return [2]int{b, a}
+	}
+	return [2]int{a, b}
+}
+
+func getBatchWorkItemParentID(item batchWorkItem, ids map[string]int) int {
+	if item.ParentKey != "" {
+		return ids[item.ParentKey]
+	}
+	return item.ParentID
+}
+
+func newWorkItemRelationOperation(clients *client.AggregatedClient, rel string, targetID int) webapi.JsonPatchOperation {
+	return webapi.JsonPatchOperation{
+		Op:   &webapi.OperationValues.Add,
+		Path: converter.String("/relations/-"),
+		Value: &map[string]string{
+			"rel": rel,
+			"url": fmt.Sprintf("%s/_apis/wit/workItems/%d", strings.TrimRight(clients.OrganizationURL, "/"), targetID),
+		},
+	}
+}
+
+func getWorkItemIDFromURL(url string) int {
+	idx := strings.LastIndex(url, "/")
+	if idx < 0 {
+		return 0
+	}
+	id, err := strconv.Atoi(url[idx+1:])
+	if err != nil {
+		return 0
+	}
+	return id
+}
+
+// expandBatchWorkItemFields returns the patch operations for the fields of a work item. If old is set,
+// only the fields that changed are returned and fields that are no longer configured are removed.
+func expandBatchWorkItemFields(item batchWorkItem, old *batchWorkItem) []webapi.JsonPatchOperation {
+	var operations []webapi.JsonPatchOperation
+	addField := func(name string, value, oldValue string, isNew bool) {
+		if value == "" || (!isNew && value == oldValue) {
+			return
+		}
+		operations = append(operations, webapi.JsonPatchOperation{
+			Op:    &webapi.OperationValues.Add,
+			Path:  converter.String("/fields/" + name),
+			Value: value,
+		})
+	}
+
+	isNew := old == nil
+	if isNew {
+		old = &batchWorkItem{}
+	}
+
+	addField("System.Title", item.Title, old.Title, isNew)
+	addField("System.State", item.State, old.State, isNew)
+	addField("System.AreaPath", item.AreaPath, old.AreaPath, isNew)
+	addField("System.IterationPath", item.IterationPath, old.IterationPath, isNew)
+
+	tags := strings.Join(item.Tags, "; ")
+	if tags != strings.Join(old.Tags, "; ") {
+		operations = append(operations, webapi.JsonPatchOperation{
+			Op:    &webapi.OperationValues.Add,
+			Path:  converter.String("/fields/System.Tags"),
+			Value: tags,
+		})
+	}
+
+	fieldNames := make([]string, 0, len(item.Fields))
+	for name := range item.Fields {
+		fieldNames = append(fieldNames, name)
+	}
+	sort.Strings(fieldNames)
+	for _, name := range fieldNames {
+		value := item.Fields[name]
+		oldValue, existed := old.Fields[name]
+		addField(name, value, oldValue, isNew || !existed)
+	}
+	for name := range old.Fields {
+		if _, ok := item.Fields[name]; !ok {
+			operations = append(operations, webapi.JsonPatchOperation{
+				Op:   &webapi.OperationValues.Remove,
+				Path: converter.String("/fields/" + name),
+			})
+		}
+	}
+	return operations
+}
+
+func expandBatchWorkItems(input []interface{}) []batchWorkItem {
+	items := make([]batchWorkItem, 0, len(input))
+	for _, raw := range input {
+		if raw == nil {
+			continue
+		}
+		data := raw.(map[string]interface{})
+
+		fields := map[string]string{}
+		if v, ok := data["fields"].(map[string]interface{}); ok {
+			for name, value := range v {
+				fields[name] = value.(string)
+			}
+		}
+
+		var tags, relatedKeys []string
+		if v, ok := data["tags"].(*schema.Set); ok {
+			tags = tfhelper.ExpandStringSet(v)
+			sort.Strings(tags)
+		}
+		if v, ok := data["related_keys"].(*schema.Set); ok {
+			relatedKeys = tfhelper.ExpandStringSet(v)
+			sort.Strings(relatedKeys)
+		}
+
+		items = append(items, batchWorkItem{
+			Key:           data["key"].(string),
+			Type:          data["type"].(string),
+			Title:         data["title"].(string),
+			State:         data["state"].(string),
+			AreaPath:      data["area_path"].(string),
+			IterationPath: data["iteration_path"].(string),
+			Tags:          tags,
+			Fields:        fields,
+			ParentKey:     data["parent_key"].(string),
+			ParentID:      data["parent_id"].(int),
+			RelatedKeys:   relatedKeys,
+		})
+	}
+	return items
+}
+
+func expandBatchWorkItemIDs(input map[string]interface{}) map[string]int {
+	ids := map[string]int{}
+	for key, value := range input {
+		switch v := value.(type) {
+		case int:
+			ids[key] = v
+		case string:
+			if id, err := strconv.Atoi(v); err == nil {
+				ids[key] = id
+			}
+		}
+	}
+	return ids
+}
+
+func flattenBatchWorkItemIDs(ids map[string]int) map[string]interface{} {
+	result := map[string]interface{}{}
+	for key, id := range ids {
+		result[key] = id
+	}
+	return result
+}
+
+// flattenBatchWorkItem builds the state of a work item. Only the additional fields and related links
+// that are already tracked are refreshed, the service returns many more of both. The parent is only
+// refreshed if the work item has a parent, a parent added outside of Terraform is not managed.
+func flattenBatchWorkItem(item batchWorkItem, workItem workitemtracking.WorkItem, keysByID map[int]string) map[string]interface{} {
+	serviceFields := map[string]interface{}{}
+	if workItem.Fields != nil {
+		serviceFields = *workItem.Fields
+	}
+	getField := func(name string) string {
+		return flattenWorkItemFieldValue(serviceFields[name])
+	}
+
+	fields := map[string]interface{}{}
+	for name := range item.Fields {
+		fields[name] = getField(name)
+	}
+
+	var tags []interface{}
+	for _, tag := range strings.Split(getField("System.Tags"), ";") {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			tags = append(tags, tag)
+		}
+	}
+
+	parentKey := ""
+	parentID := 0
+	hasParent := item.ParentKey != "" || item.ParentID > 0
+	linked := map[string]bool{}
+	if workItem.Relations != nil {
+		for _, relation := range *workItem.Relations {
+			targetID := getWorkItemIDFromURL(converter.ToString(relation.Url, ""))
+			switch converter.ToString(relation.Rel, "") {
+			case workItemRelationParent:
+				if !hasParent {
+					continue
+				}
+				if key, ok := keysByID[targetID]; ok {
+					parentKey = key
+				} else {
+					parentID = targetID
+				}
+			case workItemRelationRelated:
+				if key, ok := keysByID[targetID]; ok {
+					linked[key] = true
+				}
+			}
+		}
+	}
+
+	var relatedKeys []interface{}
+	for _, key := range item.RelatedKeys {
+		if linked[key] {
+			relatedKeys = append(relatedKeys, key)
+		}
+	}
+
+	// The service returns the canonical name of the work item type
+	workItemType := getField("System.WorkItemType")
+	if strings.EqualFold(workItemType, item.Type) {
+		workItemType = item.Type
+	}
+
+	return map[string]interface{}{
+		"key":            item.Key,
+		"type":           workItemType,
+		"title":          getField("System.Title"),
+		"state":          getField("System.State"),
+		"area_path":      getField("System.AreaPath"),
+		"iteration_path": getField("System.IterationPath"),
+		"tags":           schema.NewSet(schema.HashString, tags),
+		"fields":         fields,
+		"parent_key":     parentKey,
+		"parent_id":      parentID,
+		"related_keys":   schema.NewSet(schema.HashString, relatedKeys),
+	}
+}
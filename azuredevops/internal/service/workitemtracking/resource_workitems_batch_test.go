@@ -0,0 +1,399 @@
+package workitemtracking
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/webapi"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/workitemtracking"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/sdk/workitemtrackingextras"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var workItemsBatchProjectID = uuid.NewString()
+
+const workItemsBatchOrganizationURL = "https://dev.azure.com/org"
+
+func getWorkItemsBatchResourceData(t *testing.T) *schema.ResourceData {
+	return schema.TestResourceDataRaw(t, ResourceWorkItemsBatch().Schema, map[string]interface{}{
+		"project_id": workItemsBatchProjectID,
+		"work_item": []interface{}{
+			map[string]interface{}{
+				"key":          "story",
+				"type":         "User Story",
+				"title":        "Story",
+				"parent_key":   "epic",
+				"related_keys": []interface{}{"bug"},
+			},
+			map[string]interface{}{
+				"key":   "epic",
+				"type":  "Epic",
+				"title": "Epic",
+				"fields": map[string]interface{}{
+					"System.Description": "Top level",
+				},
+			},
+			map[string]interface{}{
+				"key":   "bug",
+				"type":  "Bug",
+				"title": "Bug",
+				"tags":  []interface{}{"imported"},
+			},
+		},
+	})
+}
+
+func newWorkItemsBatchResponse(t *testing.T, id int) workitemtrackingextras.BatchResponse {
+	body, err := json.Marshal(workitemtracking.WorkItem{Id: converter.Int(id)})
+	require.NoError(t, err)
+	return workitemtrackingextras.BatchResponse{
+		Code: converter.Int(http.StatusOK),
+		Body: converter.String(string(body)),
+	}
+}
+
+func newWorkItemsBatchWorkItem(id int, workItemType, title string, relations ...workitemtracking.WorkItemRelation) workitemtracking.WorkItem {
+	return workitemtracking.WorkItem{
+		Id: converter.Int(id),
+		Fields: &map[string]interface{}{
+			"System.WorkItemType":  workItemType,
+			"System.Title":         title,
+			"System.State":         "New",
+			"System.AreaPath":      "project",
+			"System.IterationPath": "project",
+			"System.Description":   "Top level",
+			"System.Tags":          "imported",
+		},
+		Relations: &relations,
+	}
+}
+
+func newWorkItemsBatchRelation(rel string, id int) workitemtracking.WorkItemRelation {
+	return workitemtracking.WorkItemRelation{
+		Rel: converter.String(rel),
+		Url: converter.String(fmt.Sprintf("%s/_apis/wit/workItems/%d", workItemsBatchOrganizationURL, id)),
+	}
+}
+
+func TestWorkItemsBatch_CustomizeDiff_Validation(t *testing.T) {
+	cases := []struct {
+		name  string
+		items []batchWorkItem
+		err   string
+	}{
+		{
+			name:  "duplicate key",
+			items: []batchWorkItem{{Key: "a"}, {Key: "a"}},
+			err:   "used more than once",
+		},
+		{
+			name:  "unknown parent",
+			items: []batchWorkItem{{Key: "a", ParentKey: "b"}},
+			err:   "does not exist",
+		},
+		{
+			name:  "parent key and id",
+			items: []batchWorkItem{{Key: "a", ParentKey: "b", ParentID: 1}, {Key: "b"}},
+			err:   "only one of",
+		},
+		{
+			name:  "self related",
+			items: []batchWorkItem{{Key: "a", RelatedKeys: []string{"a"}}},
+			err:   "related to itself",
+		},
+		{
+			name:  "cycle",
+			items: []batchWorkItem{{Key: "a", ParentKey: "b"}, {Key: "b", ParentKey: "a"}},
+			err:   "cycle",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := getBatchWorkItemCreationLevels(tc.items, nil)
+			require.Error(t, err)
+			require.Contains(t, err.Error(), tc.err)
+		})
+	}
+}
+
+func TestWorkItemsBatch_CreationLevels_ParentsFirst(t *testing.T) {
+	levels, err := getBatchWorkItemCreationLevels([]batchWorkItem{
+		{Key: "task", ParentKey: "story"},
+		{Key: "story", ParentKey: "epic"},
+		{Key: "epic"},
+		{Key: "existing"},
+	}, map[string]int{"existing": 1})
+	require.NoError(t, err)
+	require.Len(t, levels, 3)
+	assert.Equal(t, "epic", levels[0][0].Key)
+	assert.Equal(t, "story", levels[1][0].Key)
+	assert.Equal(t, "task", levels[2][0].Key)
+}
+
+func TestWorkItemsBatch_Create_CreatesInDependencyOrder(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	witClient := azdosdkmocks.NewMockWorkitemtrackingClient(ctrl)
+	extrasClient := azdosdkmocks.NewMockWorkitemtrackingextrasClient(ctrl)
+	clients := &client.AggregatedClient{
+		WorkItemTrackingClient:       witClient,
+		WorkItemTrackingClientExtras: extrasClient,
+		OrganizationURL:              workItemsBatchOrganizationURL,
+		Ctx:                          context.Background(),
+	}
+
+	gomock.InOrder(
+		extrasClient.EXPECT().SendBatch(clients.Ctx, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, args workitemtrackingextras.SendBatchArgs) (*[]workitemtrackingextras.BatchResponse, error) {
+				// The story depends on the epic, so the first batch holds the epic and the bug
+				require.Len(t, *args.Requests, 2)
+				require.Contains(t, *(*args.Requests)[0].Uri, "$Epic")
+				require.Contains(t, *(*args.Requests)[1].Uri, "$Bug")
+				return &[]workitemtrackingextras.BatchResponse{
+					newWorkItemsBatchResponse(t, 1),
+					newWorkItemsBatchResponse(t, 3),
+				}, nil
+			}).Times(1),
+		extrasClient.EXPECT().SendBatch(clients.Ctx, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, args workitemtrackingextras.SendBatchArgs) (*[]workitemtrackingextras.BatchResponse, error) {
+				require.Len(t, *args.Requests, 1)
+				require.Contains(t, *(*args.Requests)[0].Uri, "$User%20Story")
+
+				var parentURL string
+				for _, operation := range *(*args.Requests)[0].Body {
+					if *operation.Path == "/relations/-" {
+						parentURL = (*operation.Value.(*map[string]string))["url"]
+					}
+				}
+				require.Equal(t, workItemsBatchOrganizationURL+"/_apis/wit/workItems/1", parentURL)
+				return &[]workitemtrackingextras.BatchResponse{newWorkItemsBatchResponse(t, 2)}, nil
+			}).Times(1),
+		witClient.EXPECT().GetWorkItemsBatch(clients.Ctx, gomock.Any()).Return(&[]workitemtracking.WorkItem{
+			newWorkItemsBatchWorkItem(1, "Epic", "Epic"),
+			newWorkItemsBatchWorkItem(2, "User Story", "Story", newWorkItemsBatchRelation(workItemRelationParent, 1)),
+			newWorkItemsBatchWorkItem(3, "Bug", "Bug"),
+		}, nil).Times(1),
+		extrasClient.EXPECT().SendBatch(clients.Ctx, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, args workitemtrackingextras.SendBatchArgs) (*[]workitemtrackingextras.BatchResponse, error) {
+				// The related link is added once, from the work item with the lower ID
+				require.Len(t, *args.Requests, 1)
+				require.Equal(t, "/_apis/wit/workitems/2?api-version=7.1", *(*args.Requests)[0].Uri)
+				value := (*(*args.Requests)[0].Body)[0].Value.(*map[string]string)
+				require.Equal(t, workItemRelationRelated, (*value)["rel"])
+				return &[]workitemtrackingextras.BatchResponse{newWorkItemsBatchResponse(t, 2)}, nil
+			}).Times(1),
+		witClient.EXPECT().GetWorkItemsBatch(clients.Ctx, gomock.Any()).Return(&[]workitemtracking.WorkItem{
+			newWorkItemsBatchWorkItem(1, "Epic", "Epic"),
+			newWorkItemsBatchWorkItem(2, "User Story", "Story",
+				newWorkItemsBatchRelation(workItemRelationParent, 1),
+				newWorkItemsBatchRelation(workItemRelationRelated, 3)),
+			newWorkItemsBatchWorkItem(3, "Bug", "Bug", newWorkItemsBatchRelation(workItemRelationRelated, 2)),
+		}, nil).Times(1),
+	)
+
+	d := getWorkItemsBatchResourceData(t)
+	diags := resourceWorkItemsBatchCreate(context.Background(), d, clients)
+	require.Empty(t, diags)
+	require.NotEmpty(t, d.Id())
+	assert.Equal(t, map[string]interface{}{"epic": 1, "story": 2, "bug": 3}, d.Get("work_item_ids"))
+	assert.Equal(t, "epic", d.Get("work_item.0.parent_key"))
+	assert.Equal(t, 1, d.Get("work_item.0.related_keys.#"))
+	assert.Equal(t, "Top level", d.Get("work_item.1.fields").(map[string]interface{})["System.Description"])
+	assert.Equal(t, 1, d.Get("work_item.2.tags.#"))
+}
+
+func TestWorkItemsBatch_Create_PartialFailure_TracksCreatedWorkItems(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	extrasClient := azdosdkmocks.NewMockWorkitemtrackingextrasClient(ctrl)
+	clients := &client.AggregatedClient{
+		WorkItemTrackingClientExtras: extrasClient,
+		OrganizationURL:              workItemsBatchOrganizationURL,
+		Ctx:                          context.Background(),
+	}
+
+	extrasClient.EXPECT().SendBatch(clients.Ctx, gomock.Any()).Return(&[]workitemtrackingextras.BatchResponse{
+		newWorkItemsBatchResponse(t, 1),
+		{
+			Code: converter.Int(http.StatusBadRequest),
+			Body: converter.String(`{"message":"TF401320: Rule Error for field Title."}`),
+		},
+	}, nil).Times(1)
+
+	d := getWorkItemsBatchResourceData(t)
+	diags := resourceWorkItemsBatchCreate(context.Background(), d, clients)
+	require.NotEmpty(t, diags)
+	require.Contains(t, diags[0].Summary, "bug: status code 400: TF401320")
+	require.NotEmpty(t, d.Id())
+	assert.Equal(t, map[string]interface{}{"epic": 1}, d.Get("work_item_ids"))
+}
+
+func TestWorkItemsBatch_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	extrasClient := azdosdkmocks.NewMockWorkitemtrackingextrasClient(ctrl)
+	clients := &client.AggregatedClient{WorkItemTrackingClientExtras: extrasClient, Ctx: context.Background()}
+
+	extrasClient.EXPECT().SendBatch(clients.Ctx, gomock.Any()).Return(nil, errors.New("SendBatch() Failed")).Times(1)
+
+	d := getWorkItemsBatchResourceData(t)
+	diags := resourceWorkItemsBatchCreate(context.Background(), d, clients)
+	require.NotEmpty(t, diags)
+	require.Contains(t, diags[0].Summary, "SendBatch() Failed")
+	assert.Empty(t, d.Id())
+}
+
+func TestWorkItemsBatch_Read_DropsDeletedWorkItems(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	witClient := azdosdkmocks.NewMockWorkitemtrackingClient(ctrl)
+	clients := &client.AggregatedClient{WorkItemTrackingClient: witClient, Ctx: context.Background()}
+
+	witClient.EXPECT().GetWorkItemsBatch(clients.Ctx, gomock.Any()).DoAndReturn(
+		func(ctx context.Context, args workitemtracking.GetWorkItemsBatchArgs) (*[]workitemtracking.WorkItem, error) {
+			require.Equal(t, []int{1, 2, 3}, *args.WorkItemGetRequest.Ids)
+			require.Equal(t, workitemtracking.WorkItemErrorPolicyValues.Omit, *args.WorkItemGetRequest.ErrorPolicy)
+			return &[]workitemtracking.WorkItem{
+				newWorkItemsBatchWorkItem(1, "Epic", "Epic"),
+				{},
+				newWorkItemsBatchWorkItem(3, "Bug", "Bug"),
+			}, nil
+		}).Times(1)
+
+	d := getWorkItemsBatchResourceData(t)
+	d.SetId(uuid.NewString())
+	d.Set("work_item_ids", map[string]interface{}{"epic": 1, "story": 2, "bug": 3})
+
+	diags := resourceWorkItemsBatchRead(context.Background(), d, clients)
+	require.Empty(t, diags)
+	assert.Equal(t, map[string]interface{}{"epic": 1, "bug": 3}, d.Get("work_item_ids"))
+	assert.Equal(t, 2, d.Get("work_item.#"))
+	assert.Equal(t, "epic", d.Get("work_item.0.key"))
+	assert.Equal(t, "bug", d.Get("work_item.1.key"))
+}
+
+func TestWorkItemsBatch_Read_AllDeleted_ClearsID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	witClient := azdosdkmocks.NewMockWorkitemtrackingClient(ctrl)
+	clients := &client.AggregatedClient{WorkItemTrackingClient: witClient, Ctx: context.Background()}
+
+	witClient.EXPECT().GetWorkItemsBatch(clients.Ctx, gomock.Any()).Return(&[]workitemtracking.WorkItem{}, nil).Times(1)
+
+	d := getWorkItemsBatchResourceData(t)
+	d.SetId(uuid.NewString())
+	d.Set("work_item_ids", map[string]interface{}{"epic": 1, "story": 2, "bug": 3})
+
+	diags := resourceWorkItemsBatchRead(context.Background(), d, clients)
+	require.Empty(t, diags)
+	assert.Empty(t, d.Id())
+}
+
+// verifies that a parent added outside of Terraform is neither tracked nor removed
+func TestWorkItemsBatch_Update_KeepsUnmanagedParent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	witClient := azdosdkmocks.NewMockWorkitemtrackingClient(ctrl)
+	extrasClient := azdosdkmocks.NewMockWorkitemtrackingextrasClient(ctrl)
+	clients := &client.AggregatedClient{
+		WorkItemTrackingClient:       witClient,
+		WorkItemTrackingClientExtras: extrasClient,
+		OrganizationURL:              workItemsBatchOrganizationURL,
+		Ctx:                          context.Background(),
+	}
+
+	bug := newWorkItemsBatchWorkItem(3, "Bug", "Bug", newWorkItemsBatchRelation(workItemRelationParent, 99))
+	witClient.EXPECT().GetWorkItemsBatch(clients.Ctx, gomock.Any()).Return(&[]workitemtracking.WorkItem{bug}, nil).Times(1)
+	extrasClient.EXPECT().SendBatch(gomock.Any(), gomock.Any()).Times(0)
+
+	item := batchWorkItem{Key: "bug", Type: "Bug", Title: "Bug"}
+	err := updateBatchWorkItems(clients, []batchWorkItem{item}, map[string]batchWorkItem{"bug": item}, map[string]int{"bug": 3})
+	require.NoError(t, err)
+
+	flattened := flattenBatchWorkItem(item, bug, map[int]string{3: "bug"})
+	assert.Equal(t, 0, flattened["parent_id"])
+	assert.Equal(t, "", flattened["parent_key"])
+}
+
+// verifies that the managed parent is removed before the configured parent is added
+func TestWorkItemsBatch_Update_ReplacesManagedParent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	witClient := azdosdkmocks.NewMockWorkitemtrackingClient(ctrl)
+	extrasClient := azdosdkmocks.NewMockWorkitemtrackingextrasClient(ctrl)
+	clients := &client.AggregatedClient{
+		WorkItemTrackingClient:       witClient,
+		WorkItemTrackingClientExtras: extrasClient,
+		OrganizationURL:              workItemsBatchOrganizationURL,
+		Ctx:                          context.Background(),
+	}
+
+	witClient.EXPECT().GetWorkItemsBatch(clients.Ctx, gomock.Any()).Return(&[]workitemtracking.WorkItem{
+		newWorkItemsBatchWorkItem(1, "Epic", "Epic"),
+		newWorkItemsBatchWorkItem(2, "User Story", "Story", newWorkItemsBatchRelation(workItemRelationParent, 1)),
+	}, nil).Times(1)
+	extrasClient.EXPECT().SendBatch(clients.Ctx, gomock.Any()).DoAndReturn(
+		func(ctx context.Context, args workitemtrackingextras.SendBatchArgs) (*[]workitemtrackingextras.BatchResponse, error) {
+			require.Len(t, *args.Requests, 1)
+			require.Equal(t, "/_apis/wit/workitems/2?api-version=7.1", *(*args.Requests)[0].Uri)
+			operations := *(*args.Requests)[0].Body
+			require.Len(t, operations, 2)
+			require.Equal(t, webapi.OperationValues.Remove, *operations[0].Op)
+			require.Equal(t, "/relations/0", *operations[0].Path)
+			require.Equal(t, webapi.OperationValues.Add, *operations[1].Op)
+			require.Equal(t, workItemsBatchOrganizationURL+"/_apis/wit/workItems/50", (*operations[1].Value.(*map[string]string))["url"])
+			return &[]workitemtrackingextras.BatchResponse{newWorkItemsBatchResponse(t, 2)}, nil
+		}).Times(1)
+
+	epic := batchWorkItem{Key: "epic", Type: "Epic", Title: "Epic"}
+	oldStory := batchWorkItem{Key: "story", Type: "User Story", Title: "Story", ParentKey: "epic"}
+	story := batchWorkItem{Key: "story", Type: "User Story", Title: "Story", ParentID: 50}
+	err := updateBatchWorkItems(clients,
+		[]batchWorkItem{epic, story},
+		map[string]batchWorkItem{"epic": epic, "story": oldStory},
+		map[string]int{"epic": 1, "story": 2})
+	require.NoError(t, err)
+}
+
+func TestWorkItemsBatch_ExpandFields_OnlyChanges(t *testing.T) {
+	old := batchWorkItem{
+		Title:  "Title",
+		Tags:   []string{"a"},
+		Fields: map[string]string{"System.Description": "old", "Custom.Removed": "x"},
+	}
+	item := batchWorkItem{
+		Title:  "Title",
+		Tags:   []string{"a", "b"},
+		Fields: map[string]string{"System.Description": "new"},
+	}
+
+	operations := expandBatchWorkItemFields(item, &old)
+	paths := map[string]webapi.Operation{}
+	for _, operation := range operations {
+		paths[*operation.Path] = *operation.Op
+	}
+	assert.Equal(t, map[string]webapi.Operation{
+		"/fields/System.Tags":        webapi.OperationValues.Add,
+		"/fields/System.Description": webapi.OperationValues.Add,
+		"/fields/Custom.Removed":     webapi.OperationValues.Remove,
+	}, paths)
+}
@@ -0,0 +1,21 @@
+package suppress
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// RFC3339TimeDifference reports whether old and new, interpreted as RFC3339 timestamps,
+// describe the same instant, e.g. when the service returns a time in a different time zone.
+func RFC3339TimeDifference(_, old, new string, _ *schema.ResourceData) bool {
+	oldTime, err := time.Parse(time.RFC3339, old)
+	if err != nil {
+		return false
+	}
+	newTime, err := time.Parse(time.RFC3339, new)
+	if err != nil {
+		return false
+	}
+	return oldTime.Equal(newTime)
+}
@@ -0,0 +1,54 @@
+//go:build all || utils || time
+// +build all utils time
+
+package suppress
+
+import "testing"
+
+func TestRFC3339TimeDifference(t *testing.T) {
+	cases := []struct {
+		Name     string
+		TimeA    string
+		TimeB    string
+		Suppress bool
+	}{
+		{
+			Name:     "empty",
+			TimeA:    "",
+			TimeB:    "",
+			Suppress: false,
+		},
+		{
+			Name:     "empty vs time",
+			TimeA:    "2025-03-01T00:00:00Z",
+			TimeB:    "",
+			Suppress: false,
+		},
+		{
+			Name:     "same time",
+			TimeA:    "2025-03-01T00:00:00Z",
+			TimeB:    "2025-03-01T00:00:00Z",
+			Suppress: true,
+		},
+		{
+			Name:     "same time different zone",
+			TimeA:    "2025-03-01T00:00:00Z",
+			TimeB:    "2025-03-01T02:00:00+02:00",
+			Suppress: true,
+		},
+		{
+			Name:     "different time",
+			TimeA:    "2025-03-01T00:00:00Z",
+			TimeB:    "2025-03-02T00:00:00Z",
+			Suppress: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			if RFC3339TimeDifference("test", tc.TimeA, tc.TimeB, nil) != tc.Suppress {
+				t.Fatalf("Expected RFC3339TimeDifference to return %t for '%q' == '%q'", tc.Suppress, tc.TimeA, tc.TimeB)
+			}
+		})
+	}
+}
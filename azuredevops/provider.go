@@ -15,6 +15,7 @@ import (
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/service"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/service/advancedsecurity"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/service/approvalsandchecks"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/service/build"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/service/core"
@@ -32,7 +33,10 @@ import (
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/service/serviceendpoint"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/service/servicehook"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/service/taskagent"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/service/testplan"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/service/tfvc"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/service/wiki"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/service/work"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/service/workitemtracking"
 )
 
@@ -42,10 +46,13 @@ func Provider() *schema.Provider {
 		ResourcesMap: map[string]*schema.Resource{
 			"azuredevops_agent_pool":                                  taskagent.ResourceAgentPool(),
 			"azuredevops_agent_queue":                                 taskagent.ResourceAgentQueue(),
+			"azuredevops_advanced_security":                           advancedsecurity.ResourceAdvancedSecurity(),
 			"azuredevops_area_permissions":                            permissions.ResourceAreaPermissions(),
 			"azuredevops_branch_policy_auto_reviewers":                branch.ResourceBranchPolicyAutoReviewers(),
 			"azuredevops_branch_policy_build_validation":              branch.ResourceBranchPolicyBuildValidation(),
+			"azuredevops_branch_policy_bypass":                        permissions.ResourceBranchPolicyBypass(),
 			"azuredevops_branch_policy_comment_resolution":            branch.ResourceBranchPolicyCommentResolution(),
+			"azuredevops_branch_policy_generic":                       branch.ResourceBranchPolicyGeneric(),
 			"azuredevops_branch_policy_merge_types":                   branch.ResourceBranchPolicyMergeTypes(),
 			"azuredevops_branch_policy_min_reviewers":                 branch.ResourceBranchPolicyMinReviewers(),
 			"azuredevops_branch_policy_status_check":                  branch.ResourceBranchPolicyStatusCheck(),
@@ -61,6 +68,8 @@ func Provider() *schema.Provider {
 			"azuredevops_check_required_template":                     approvalsandchecks.ResourceCheckRequiredTemplate(),
 			"azuredevops_check_rest_api":                              approvalsandchecks.ResourceCheckRestAPI(),
 			"azuredevops_dashboard":                                   dashboard.ResourceDashboard(),
+			"azuredevops_delivery_plan":                               work.ResourceDeliveryPlan(),
+			"azuredevops_delivery_plan_permissions":                   permissions.ResourceDeliveryPlanPermissions(),
 			"azuredevops_elastic_pool":                                taskagent.ResourceAgentPoolVMSS(),
 			"azuredevops_environment":                                 taskagent.ResourceEnvironment(),
 			"azuredevops_environment_resource_kubernetes":             taskagent.ResourceEnvironmentKubernetes(),
@@ -71,7 +80,13 @@ func Provider() *schema.Provider {
 			"azuredevops_git_permissions":                             permissions.ResourceGitPermissions(),
 			"azuredevops_git_repository":                              git.ResourceGitRepository(),
 			"azuredevops_git_repository_branch":                       git.ResourceGitRepositoryBranch(),
+			"azuredevops_git_repository_branch_lock":                  git.ResourceGitRepositoryBranchLock(),
+			"azuredevops_git_repository_tag":                          git.ResourceGitRepositoryTag(),
 			"azuredevops_git_repository_file":                         git.ResourceGitRepositoryFile(),
+			"azuredevops_git_repository_files":                        git.ResourceGitRepositoryFiles(),
+			"azuredevops_git_repository_import":                       git.ResourceGitRepositoryImport(),
+			"azuredevops_git_repository_settings":                     git.ResourceGitRepositorySettings(),
+			"azuredevops_git_pull_request":                            git.ResourceGitPullRequest(),
 			"azuredevops_group":                                       graph.ResourceGroup(),
 			"azuredevops_group_entitlement":                           memberentitlementmanagement.ResourceGroupEntitlement(),
 			"azuredevops_group_membership":                            graph.ResourceGroupMembership(),
@@ -87,6 +102,7 @@ func Provider() *schema.Provider {
 			"azuredevops_repository_policy_case_enforcement":          repository.ResourceRepositoryEnforceConsistentCase(),
 			"azuredevops_repository_policy_check_credentials":         repository.ResourceRepositoryPolicyCheckCredentials(),
 			"azuredevops_repository_policy_file_path_pattern":         repository.ResourceRepositoryFilePathPatterns(),
+			"azuredevops_repository_policy_blocked_file_types":        repository.ResourceRepositoryPolicyBlockedFileTypes(),
 			"azuredevops_repository_policy_max_file_size":             repository.ResourceRepositoryMaxFileSize(),
 			"azuredevops_repository_policy_max_path_length":           repository.ResourceRepositoryMaxPathLength(),
 			"azuredevops_repository_policy_reserved_names":            repository.ResourceRepositoryReservedNames(),
@@ -143,54 +159,71 @@ func Provider() *schema.Provider {
 			"azuredevops_team":                                        core.ResourceTeam(),
 			"azuredevops_team_administrators":                         core.ResourceTeamAdministrators(),
 			"azuredevops_team_members":                                core.ResourceTeamMembers(),
+			"azuredevops_test_configuration":                          testplan.ResourceTestConfiguration(),
+			"azuredevops_test_plan":                                   testplan.ResourceTestPlan(),
+			"azuredevops_test_suite":                                  testplan.ResourceTestSuite(),
+			"azuredevops_test_variable":                               testplan.ResourceTestVariable(),
+			"azuredevops_tfvc_branch":                                 tfvc.ResourceTfvcBranch(),
 			"azuredevops_user_entitlement":                            memberentitlementmanagement.ResourceUserEntitlement(),
 			"azuredevops_variable_group":                              taskagent.ResourceVariableGroup(),
 			"azuredevops_variable_group_permissions":                  permissions.ResourceVariableGroupPermissions(),
 			"azuredevops_wiki":                                        wiki.ResourceWiki(),
 			"azuredevops_wiki_page":                                   wiki.ResourceWikiPage(),
 			"azuredevops_workitem":                                    workitemtracking.ResourceWorkItem(),
+			"azuredevops_workitems_batch":                             workitemtracking.ResourceWorkItemsBatch(),
 			"azuredevops_workitemquery_permissions":                   permissions.ResourceWorkItemQueryPermissions(),
 			"azuredevops_workitemquery":                               workitemtracking.ResourceQuery(),
 			"azuredevops_workitemquery_folder":                        workitemtracking.ResourceQueryFolder(),
 		},
 		DataSourcesMap: map[string]*schema.Resource{
-			"azuredevops_agent_pool":                     taskagent.DataAgentPool(),
-			"azuredevops_agent_pools":                    taskagent.DataAgentPools(),
-			"azuredevops_agent_queue":                    taskagent.DataAgentQueue(),
-			"azuredevops_area":                           workitemtracking.DataArea(),
-			"azuredevops_build_definition":               build.DataBuildDefinition(),
-			"azuredevops_client_config":                  service.DataClientConfig(),
-			"azuredevops_descriptor":                     graph.DataDescriptor(),
-			"azuredevops_environment":                    taskagent.DataEnvironment(),
-			"azuredevops_feed":                           feed.DataFeed(),
-			"azuredevops_git_repositories":               git.DataGitRepositories(),
-			"azuredevops_git_repository":                 git.DataGitRepository(),
-			"azuredevops_git_repository_file":            git.DataGitRepositoryFile(),
-			"azuredevops_group":                          graph.DataGroup(),
-			"azuredevops_group_membership":               graph.DataGroupMembership(),
-			"azuredevops_groups":                         graph.DataGroups(),
-			"azuredevops_identity_group":                 identity.DataIdentityGroup(),
-			"azuredevops_identity_groups":                identity.DataIdentityGroups(),
-			"azuredevops_identity_user":                  identity.DataIdentityUser(),
-			"azuredevops_iteration":                      workitemtracking.DataIteration(),
-			"azuredevops_project":                        core.DataProject(),
-			"azuredevops_projects":                       core.DataProjects(),
-			"azuredevops_securityrole_definitions":       securityroles.DataSecurityRoleDefinitions(),
-			"azuredevops_serviceendpoint_generic_v2":     serviceendpoint.DataServiceEndpointGenericV2(),
-			"azuredevops_serviceendpoint_azurecr":        serviceendpoint.DataResourceServiceEndpointAzureCR(),
-			"azuredevops_serviceendpoint_azurerm":        serviceendpoint.DataServiceEndpointAzureRM(),
-			"azuredevops_serviceendpoint_bitbucket":      serviceendpoint.DataResourceServiceEndpointBitbucket(),
-			"azuredevops_serviceendpoint_dockerregistry": serviceendpoint.DataResourceServiceEndpointDockerRegistry(),
-			"azuredevops_serviceendpoint_github":         serviceendpoint.DataServiceEndpointGithub(),
-			"azuredevops_serviceendpoint_npm":            serviceendpoint.DataResourceServiceEndpointNpm(),
-			"azuredevops_serviceendpoint_sonarcloud":     serviceendpoint.DataResourceServiceEndpointSonarCloud(),
-			"azuredevops_service_principal":              graph.DataServicePrincipal(),
-			"azuredevops_storage_key":                    graph.DataStorageKey(),
-			"azuredevops_team":                           core.DataTeam(),
-			"azuredevops_teams":                          core.DataTeams(),
-			"azuredevops_user":                           graph.DataUser(),
-			"azuredevops_users":                          graph.DataUsers(),
-			"azuredevops_variable_group":                 taskagent.DataVariableGroup(),
+			"azuredevops_agent_pool":                           taskagent.DataAgentPool(),
+			"azuredevops_agent_pools":                          taskagent.DataAgentPools(),
+			"azuredevops_agent_queue":                          taskagent.DataAgentQueue(),
+			"azuredevops_advanced_security_alerts":             advancedsecurity.DataAdvancedSecurityAlerts(),
+			"azuredevops_area":                                 workitemtracking.DataArea(),
+			"azuredevops_branch_policies":                      branch.DataBranchPolicies(),
+			"azuredevops_branch_policy_bypass_principals":      permissions.DataBranchPolicyBypassPrincipals(),
+			"azuredevops_build_definition":                     build.DataBuildDefinition(),
+			"azuredevops_client_config":                        service.DataClientConfig(),
+			"azuredevops_descriptor":                           graph.DataDescriptor(),
+			"azuredevops_environment":                          taskagent.DataEnvironment(),
+			"azuredevops_feed":                                 feed.DataFeed(),
+			"azuredevops_git_repositories":                     git.DataGitRepositories(),
+			"azuredevops_git_repository":                       git.DataGitRepository(),
+			"azuredevops_git_repository_file":                  git.DataGitRepositoryFile(),
+			"azuredevops_git_repository_refs":                  git.DataGitRepositoryRefs(),
+			"azuredevops_git_repository_tree":                  git.DataGitRepositoryTree(),
+			"azuredevops_git_repository_commit":                git.DataGitRepositoryCommit(),
+			"azuredevops_git_repository_pull_request_statuses": git.DataGitRepositoryPullRequestStatuses(),
+			"azuredevops_group":                                graph.DataGroup(),
+			"azuredevops_group_membership":                     graph.DataGroupMembership(),
+			"azuredevops_groups":                               graph.DataGroups(),
+			"azuredevops_identity_group":                       identity.DataIdentityGroup(),
+			"azuredevops_identity_groups":                      identity.DataIdentityGroups(),
+			"azuredevops_identity_user":                        identity.DataIdentityUser(),
+			"azuredevops_iteration":                            workitemtracking.DataIteration(),
+			"azuredevops_policy_types":                         branch.DataPolicyTypes(),
+			"azuredevops_project":                              core.DataProject(),
+			"azuredevops_projects":                             core.DataProjects(),
+			"azuredevops_pull_request_policy_evaluations":      branch.DataPullRequestPolicyEvaluations(),
+			"azuredevops_repository_policies":                  repository.DataRepositoryPolicies(),
+			"azuredevops_securityrole_definitions":             securityroles.DataSecurityRoleDefinitions(),
+			"azuredevops_serviceendpoint_generic_v2":           serviceendpoint.DataServiceEndpointGenericV2(),
+			"azuredevops_serviceendpoint_azurecr":              serviceendpoint.DataResourceServiceEndpointAzureCR(),
+			"azuredevops_serviceendpoint_azurerm":              serviceendpoint.DataServiceEndpointAzureRM(),
+			"azuredevops_serviceendpoint_bitbucket":            serviceendpoint.DataResourceServiceEndpointBitbucket(),
+			"azuredevops_serviceendpoint_dockerregistry":       serviceendpoint.DataResourceServiceEndpointDockerRegistry(),
+			"azuredevops_serviceendpoint_github":               serviceendpoint.DataServiceEndpointGithub(),
+			"azuredevops_serviceendpoint_npm":                  serviceendpoint.DataResourceServiceEndpointNpm(),
+			"azuredevops_serviceendpoint_sonarcloud":           serviceendpoint.DataResourceServiceEndpointSonarCloud(),
+			"azuredevops_service_principal":                    graph.DataServicePrincipal(),
+			"azuredevops_storage_key":                          graph.DataStorageKey(),
+			"azuredevops_team":                                 core.DataTeam(),
+			"azuredevops_teams":                                core.DataTeams(),
+			"azuredevops_user":                                 graph.DataUser(),
+			"azuredevops_users":                                graph.DataUsers(),
+			"azuredevops_variable_group":                       taskagent.DataVariableGroup(),
+			"azuredevops_workitemquery_results":                workitemtracking.DataWorkItemQueryResults(),
 		},
 		Schema: map[string]*schema.Schema{
 			"org_service_url": {
@@ -12,10 +12,13 @@ func TestProvider_HasChildResources(t *testing.T) {
 	expectedResources := []string{
 		"azuredevops_agent_pool",
 		"azuredevops_agent_queue",
+		"azuredevops_advanced_security",
 		"azuredevops_area_permissions",
 		"azuredevops_branch_policy_auto_reviewers",
 		"azuredevops_branch_policy_build_validation",
+		"azuredevops_branch_policy_bypass",
 		"azuredevops_branch_policy_comment_resolution",
+		"azuredevops_branch_policy_generic",
 		"azuredevops_branch_policy_merge_types",
 		"azuredevops_branch_policy_min_reviewers",
 		"azuredevops_branch_policy_status_check",
@@ -31,6 +34,8 @@ func TestProvider_HasChildResources(t *testing.T) {
 		"azuredevops_check_required_template",
 		"azuredevops_check_rest_api",
 		"azuredevops_dashboard",
+		"azuredevops_delivery_plan",
+		"azuredevops_delivery_plan_permissions",
 		"azuredevops_elastic_pool",
 		"azuredevops_environment",
 		"azuredevops_environment_resource_kubernetes",
@@ -41,7 +46,13 @@ func TestProvider_HasChildResources(t *testing.T) {
 		"azuredevops_git_permissions",
 		"azuredevops_git_repository",
 		"azuredevops_git_repository_branch",
+		"azuredevops_git_repository_branch_lock",
+		"azuredevops_git_repository_tag",
 		"azuredevops_git_repository_file",
+		"azuredevops_git_repository_files",
+		"azuredevops_git_repository_import",
+		"azuredevops_git_repository_settings",
+		"azuredevops_git_pull_request",
 		"azuredevops_group",
 		"azuredevops_group_entitlement",
 		"azuredevops_group_membership",
@@ -57,6 +68,7 @@ func TestProvider_HasChildResources(t *testing.T) {
 		"azuredevops_repository_policy_case_enforcement",
 		"azuredevops_repository_policy_check_credentials",
 		"azuredevops_repository_policy_file_path_pattern",
+		"azuredevops_repository_policy_blocked_file_types",
 		"azuredevops_repository_policy_max_file_size",
 		"azuredevops_repository_policy_max_path_length",
 		"azuredevops_repository_policy_reserved_names",
@@ -113,12 +125,18 @@ func TestProvider_HasChildResources(t *testing.T) {
 		"azuredevops_team",
 		"azuredevops_team_administrators",
 		"azuredevops_team_members",
+		"azuredevops_test_configuration",
+		"azuredevops_test_plan",
+		"azuredevops_test_suite",
+		"azuredevops_test_variable",
+		"azuredevops_tfvc_branch",
 		"azuredevops_user_entitlement",
 		"azuredevops_variable_group",
 		"azuredevops_variable_group_permissions",
 		"azuredevops_wiki",
 		"azuredevops_wiki_page",
 		"azuredevops_workitem",
+		"azuredevops_workitems_batch",
 		"azuredevops_workitemquery",
 		"azuredevops_workitemquery_folder",
 		"azuredevops_workitemquery_permissions",
@@ -138,7 +156,10 @@ func TestProvider_HasChildDataSources(t *testing.T) {
 		"azuredevops_agent_pool",
 		"azuredevops_agent_pools",
 		"azuredevops_agent_queue",
+		"azuredevops_advanced_security_alerts",
 		"azuredevops_area",
+		"azuredevops_branch_policies",
+		"azuredevops_branch_policy_bypass_principals",
 		"azuredevops_build_definition",
 		"azuredevops_client_config",
 		"azuredevops_descriptor",
@@ -147,6 +168,10 @@ func TestProvider_HasChildDataSources(t *testing.T) {
 		"azuredevops_git_repositories",
 		"azuredevops_git_repository",
 		"azuredevops_git_repository_file",
+		"azuredevops_git_repository_refs",
+		"azuredevops_git_repository_tree",
+		"azuredevops_git_repository_commit",
+		"azuredevops_git_repository_pull_request_statuses",
 		"azuredevops_group",
 		"azuredevops_group_membership",
 		"azuredevops_groups",
@@ -154,8 +179,11 @@ func TestProvider_HasChildDataSources(t *testing.T) {
 		"azuredevops_identity_groups",
 		"azuredevops_identity_user",
 		"azuredevops_iteration",
+		"azuredevops_policy_types",
 		"azuredevops_project",
 		"azuredevops_projects",
+		"azuredevops_pull_request_policy_evaluations",
+		"azuredevops_repository_policies",
 		"azuredevops_securityrole_definitions",
 		"azuredevops_serviceendpoint_generic_v2",
 		"azuredevops_serviceendpoint_azurecr",
@@ -172,6 +200,7 @@ func TestProvider_HasChildDataSources(t *testing.T) {
 		"azuredevops_user",
 		"azuredevops_users",
 		"azuredevops_variable_group",
+		"azuredevops_workitemquery_results",
 	}
 
 	dataSources := azuredevops.Provider().DataSourcesMap
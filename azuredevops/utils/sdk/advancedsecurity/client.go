@@ -0,0 +1,255 @@
+// The Advanced Security endpoints are not part of the generated SDK, they are served by a separate host and documented at
+// https://learn.microsoft.com/en-us/rest/api/azure/devops/advancedsecurity/?view=azure-devops-rest-7.2
+
+// This file cannot be under "internal", because azdosdkmocks/advancedsecurity_sdk_mock.go depends on it.
+
+package advancedsecurity
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
+)
+
+const enablementApiVersion = "7.2-preview.1"
+
+const alertsApiVersion = "7.2-preview.1"
+
+// continuationTokenHeader the response header of the token of the next page of alerts
+const continuationTokenHeader = "x-ms-continuationtoken"
+
+type Client interface {
+	// Get the Advanced Security enablement of a project.
+	GetProjectEnablement(context.Context, GetProjectEnablementArgs) (*ProjectEnablement, error)
+	// Update the Advanced Security enablement of a project.
+	UpdateProjectEnablement(context.Context, UpdateProjectEnablementArgs) error
+	// Get the Advanced Security enablement of a repository.
+	GetRepositoryEnablement(context.Context, GetRepositoryEnablementArgs) (*RepositoryEnablement, error)
+	// Update the Advanced Security enablement of a repository.
+	UpdateRepositoryEnablement(context.Context, UpdateRepositoryEnablementArgs) error
+	// Get a page of the Advanced Security alerts of a repository.
+	GetAlerts(context.Context, GetAlertsArgs) (*GetAlertsResponseValue, error)
+}
+
+type ClientImpl struct {
+	Client  azuredevops.Client
+	BaseUrl string
+}
+
+func NewClient(ctx context.Context, connection *azuredevops.Connection) Client {
+	client := connection.GetClientByUrl(connection.BaseUrl)
+	return &ClientImpl{
+		Client:  *client,
+		BaseUrl: advSecBaseUrl(connection.BaseUrl),
+	}
+}
+
+// advSecBaseUrl returns the URL of the Advanced Security host of an organization, e.g. https://advsec.dev.azure.com/org
+// for both https://dev.azure.com/org and https://org.visualstudio.com
+func advSecBaseUrl(organizationUrl string) string {
+	organizationUrl = strings.TrimRight(organizationUrl, "/")
+	u, err := url.Parse(organizationUrl)
+	if err != nil {
+		return organizationUrl
+	}
+
+	host := strings.ToLower(u.Host)
+	switch {
+	case host == "dev.azure.com":
+		return fmt.Sprintf("%s://advsec.dev.azure.com%s", u.Scheme, u.Path)
+	case strings.HasSuffix(host, ".visualstudio.com"):
+		return fmt.Sprintf("%s://advsec.dev.azure.com/%s", u.Scheme, strings.TrimSuffix(host, ".visualstudio.com"))
+	}
+	return organizationUrl
+}
+
+// GetProjectEnablement returns the Advanced Security enablement of a project
+func (client *ClientImpl) GetProjectEnablement(ctx context.Context, args GetProjectEnablementArgs) (*ProjectEnablement, error) {
+	if args.Project == nil || *args.Project == "" {
+		return nil, &azuredevops.ArgumentNilOrEmptyError{ArgumentName: "args.Project"}
+	}
+
+	fullUrl := fmt.Sprintf("%s/%s/_apis/management/enablement?api-version=%s", client.BaseUrl, url.PathEscape(*args.Project), enablementApiVersion)
+	var responseValue ProjectEnablement
+	if _, err := client.send(ctx, http.MethodGet, fullUrl, nil, &responseValue); err != nil {
+		return nil, err
+	}
+	return &responseValue, nil
+}
+
+// Arguments for the GetProjectEnablement function
+type GetProjectEnablementArgs struct {
+	// (required) Project ID or project name
+	Project *string
+}
+
+// UpdateProjectEnablement updates the Advanced Security enablement of a project
+func (client *ClientImpl) UpdateProjectEnablement(ctx context.Context, args UpdateProjectEnablementArgs) error {
+	if args.Project == nil || *args.Project == "" {
+		return &azuredevops.ArgumentNilOrEmptyError{ArgumentName: "args.Project"}
+	}
+	if args.Enablement == nil {
+		return &azuredevops.ArgumentNilError{ArgumentName: "args.Enablement"}
+	}
+
+	fullUrl := fmt.Sprintf("%s/%s/_apis/management/enablement?api-version=%s", client.BaseUrl, url.PathEscape(*args.Project), enablementApiVersion)
+	_, err := client.send(ctx, http.MethodPatch, fullUrl, args.Enablement, nil)
+	return err
+}
+
+// Arguments for the UpdateProjectEnablement function
+type UpdateProjectEnablementArgs struct {
+	// (required) Project ID or project name
+	Project *string
+	// (required) The enablement of the project
+	Enablement *ProjectEnablement
+}
+
+// GetRepositoryEnablement returns the Advanced Security enablement of a repository
+func (client *ClientImpl) GetRepositoryEnablement(ctx context.Context, args GetRepositoryEnablementArgs) (*RepositoryEnablement, error) {
+	if args.Project == nil || *args.Project == "" {
+		return nil, &azuredevops.ArgumentNilOrEmptyError{ArgumentName: "args.Project"}
+	}
+	if args.RepositoryId == nil || *args.RepositoryId == "" {
+		return nil, &azuredevops.ArgumentNilOrEmptyError{ArgumentName: "args.RepositoryId"}
+	}
+
+	fullUrl := fmt.Sprintf("%s/%s/_apis/management/repositories/%s/enablement?api-version=%s", client.BaseUrl, url.PathEscape(*args.Project), url.PathEscape(*args.RepositoryId), enablementApiVersion)
+	var responseValue RepositoryEnablement
+	if _, err := client.send(ctx, http.MethodGet, fullUrl, nil, &responseValue); err != nil {
+		return nil, err
+	}
+	return &responseValue, nil
+}
+
+// Arguments for the GetRepositoryEnablement function
+type GetRepositoryEnablementArgs struct {
+	// (required) Project ID or project name
+	Project *string
+	// (required) The ID or name of the repository
+	RepositoryId *string
+}
+
+// UpdateRepositoryEnablement updates the Advanced Security enablement of a repository
+func (client *ClientImpl) UpdateRepositoryEnablement(ctx context.Context, args UpdateRepositoryEnablementArgs) error {
+	if args.Project == nil || *args.Project == "" {
+		return &azuredevops.ArgumentNilOrEmptyError{ArgumentName: "args.Project"}
+	}
+	if args.RepositoryId == nil || *args.RepositoryId == "" {
+		return &azuredevops.ArgumentNilOrEmptyError{ArgumentName: "args.RepositoryId"}
+	}
+	if args.Enablement == nil {
+		return &azuredevops.ArgumentNilError{ArgumentName: "args.Enablement"}
+	}
+
+	fullUrl := fmt.Sprintf("%s/%s/_apis/management/repositories/%s/enablement?api-version=%s", client.BaseUrl, url.PathEscape(*args.Project), url.PathEscape(*args.RepositoryId), enablementApiVersion)
+	_, err := client.send(ctx, http.MethodPatch, fullUrl, args.Enablement, nil)
+	return err
+}
+
+// Arguments for the UpdateRepositoryEnablement function
+type UpdateRepositoryEnablementArgs struct {
+	// (required) Project ID or project name
+	Project *string
+	// (required) The ID or name of the repository
+	RepositoryId *string
+	// (required) The enablement of the repository
+	Enablement *RepositoryEnablement
+}
+
+// GetAlerts returns a page of the alerts of a repository matching the criteria
+func (client *ClientImpl) GetAlerts(ctx context.Context, args GetAlertsArgs) (*GetAlertsResponseValue, error) {
+	if args.Project == nil || *args.Project == "" {
+		return nil, &azuredevops.ArgumentNilOrEmptyError{ArgumentName: "args.Project"}
+	}
+	if args.RepositoryId == nil || *args.RepositoryId == "" {
+		return nil, &azuredevops.ArgumentNilOrEmptyError{ArgumentName: "args.RepositoryId"}
+	}
+
+	queryParams := url.Values{}
+	if args.AlertType != nil {
+		queryParams.Add("criteria.alertType", *args.AlertType)
+	}
+	if args.States != nil {
+		for _, state := range *args.States {
+			queryParams.Add("criteria.states", state)
+		}
+	}
+	if args.Top != nil {
+		queryParams.Add("top", strconv.Itoa(*args.Top))
+	}
+	if args.ContinuationToken != nil {
+		queryParams.Add("continuationToken", *args.ContinuationToken)
+	}
+	queryParams.Add("api-version", alertsApiVersion)
+	fullUrl := fmt.Sprintf("%s/%s/_apis/alert/repositories/%s/alerts?%s", client.BaseUrl, url.PathEscape(*args.Project), url.PathEscape(*args.RepositoryId), queryParams.Encode())
+
+	var responseValue alertCollection
+	resp, err := client.send(ctx, http.MethodGet, fullUrl, nil, &responseValue)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &GetAlertsResponseValue{
+		ContinuationToken: resp.Header.Get(continuationTokenHeader),
+	}
+	if responseValue.Value != nil {
+		result.Value = *responseValue.Value
+	}
+	return result, nil
+}
+
+// Arguments for the GetAlerts function
+type GetAlertsArgs struct {
+	// (required) Project ID or project name
+	Project *string
+	// (required) The ID or name of the repository
+	RepositoryId *string
+	// (optional) Only return alerts of this type, `code`, `secret` or `dependency`
+	AlertType *string
+	// (optional) Only return alerts in these states, e.g. `active`
+	States *[]string
+	// (optional) The maximum number of alerts to return
+	Top *int
+	// (optional) The continuation token of the previous page
+	ContinuationToken *string
+}
+
+// Return type for the GetAlerts function
+type GetAlertsResponseValue struct {
+	Value             []Alert
+	ContinuationToken string
+}
+
+func (client *ClientImpl) send(ctx context.Context, method string, fullUrl string, body interface{}, responseValue interface{}) (*http.Response, error) {
+	var req *http.Request
+	var err error
+	if body != nil {
+		data, marshalErr := json.Marshal(body)
+		if marshalErr != nil {
+			return nil, marshalErr
+		}
+		req, err = client.Client.CreateRequestMessage(ctx, method, fullUrl, "", bytes.NewReader(data), "application/json", "application/json", nil)
+	} else {
+		req, err = client.Client.CreateRequestMessage(ctx, method, fullUrl, "", nil, "", "application/json", nil)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Client.SendRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	if responseValue == nil {
+		return resp, nil
+	}
+	return resp, client.Client.UnmarshalBody(resp, responseValue)
+}
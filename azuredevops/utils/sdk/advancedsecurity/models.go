@@ -0,0 +1,50 @@
+package advancedsecurity
+
+import "github.com/microsoft/azure-devops-go-api/azuredevops/v7"
+
+// The Advanced Security enablement of a repository
+type RepositoryEnablement struct {
+	// Whether Advanced Security is enabled
+	AdvSecEnabled *bool `json:"advSecEnabled,omitempty"`
+	// Whether pushes containing secrets are blocked
+	BlockPushes *bool `json:"blockPushes,omitempty"`
+	// Whether the dependency scanning task is injected into the pipelines of the repository
+	DependencyScanningInjectionEnabled *bool `json:"dependencyScanningInjectionEnabled,omitempty"`
+	// Whether CodeQL default setup is enabled
+	CodeQLEnabled *bool `json:"codeQLEnabled,omitempty"`
+	// The date the enablement has been changed last
+	AdvSecEnablementLastChangedDate *azuredevops.Time `json:"advSecEnablementLastChangedDate,omitempty"`
+}
+
+// The Advanced Security enablement of a project
+type ProjectEnablement struct {
+	// Whether Advanced Security is enabled for all repositories of the project
+	AdvSecEnabled *bool `json:"advSecEnabled,omitempty"`
+	// Whether Advanced Security is enabled for new repositories of the project
+	EnableOnCreate *bool `json:"enableOnCreate,omitempty"`
+	// Whether pushes containing secrets are blocked
+	BlockPushes *bool `json:"blockPushes,omitempty"`
+	// Whether the dependency scanning task is injected into the pipelines of the project
+	DependencyScanningInjectionEnabled *bool `json:"dependencyScanningInjectionEnabled,omitempty"`
+	// Whether CodeQL default setup is enabled
+	CodeQLEnabled *bool `json:"codeQLEnabled,omitempty"`
+}
+
+// An Advanced Security alert
+type Alert struct {
+	// The ID of the alert
+	AlertId *int `json:"alertId,omitempty"`
+	// The type of the alert, `code`, `secret` or `dependency`
+	AlertType *string `json:"alertType,omitempty"`
+	// The severity of the alert, e.g. `critical`, `high`, `medium` or `low`
+	Severity *string `json:"severity,omitempty"`
+	// The state of the alert, e.g. `active`, `dismissed` or `fixed`
+	State *string `json:"state,omitempty"`
+	// The title of the alert
+	Title *string `json:"title,omitempty"`
+}
+
+type alertCollection struct {
+	Count *int     `json:"count,omitempty"`
+	Value *[]Alert `json:"value,omitempty"`
+}
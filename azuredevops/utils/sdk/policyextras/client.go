@@ -0,0 +1,103 @@
+// The generated policy client does not send the continuation token of the policy configurations endpoint, so only
+// the first page of the policy configurations of a project can be listed with it. The endpoint is documented at
+// https://learn.microsoft.com/en-us/rest/api/azure/devops/policy/configurations/list?view=azure-devops-rest-7.1
+
+// This file cannot be under "internal", because azdosdkmocks/policyextras_sdk_mock.go depends on it.
+
+package policyextras
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/policy"
+)
+
+type Client interface {
+	// [Preview API] Get a page of the policy configurations in a project.
+	GetPolicyConfigurations(context.Context, GetPolicyConfigurationsArgs) (*policy.GetPolicyConfigurationsResponseValue, error)
+}
+
+type ClientImpl struct {
+	Client azuredevops.Client
+}
+
+func NewClient(ctx context.Context, connection *azuredevops.Connection) (Client, error) {
+	client, err := connection.GetClientByResourceAreaId(ctx, policy.ResourceAreaId)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientImpl{
+		Client: *client,
+	}, nil
+}
+
+// [Preview API] Get a page of the policy configurations in a project.
+func (client *ClientImpl) GetPolicyConfigurations(ctx context.Context, args GetPolicyConfigurationsArgs) (*policy.GetPolicyConfigurationsResponseValue, error) {
+	routeValues := make(map[string]string)
+	if args.Project == nil || *args.Project == "" {
+		return nil, &azuredevops.ArgumentNilOrEmptyError{ArgumentName: "args.Project"}
+	}
+	routeValues["project"] = *args.Project
+
+	queryParams := url.Values{}
+	if args.Scope != nil {
+		queryParams.Add("scope", *args.Scope)
+	}
+	if args.PolicyType != nil {
+		queryParams.Add("policyType", (*args.PolicyType).String())
+	}
+	if args.Top != nil {
+		queryParams.Add("$top", strconv.Itoa(*args.Top))
+	}
+	if args.ContinuationToken != nil {
+		queryParams.Add("continuationToken", *args.ContinuationToken)
+	}
+	locationId, _ := uuid.Parse("dad91cbe-d183-45f8-9c6e-9c1164472121")
+	resp, err := client.Client.Send(ctx, http.MethodGet, locationId, "7.1-preview.1", routeValues, queryParams, nil, "", "application/json", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseValue policy.GetPolicyConfigurationsResponseValue
+	responseValue.ContinuationToken = resp.Header.Get(azuredevops.HeaderKeyContinuationToken)
+	err = client.Client.UnmarshalCollectionBody(resp, &responseValue.Value)
+	return &responseValue, err
+}
+
+// Arguments for the GetPolicyConfigurations function
+type GetPolicyConfigurationsArgs struct {
+	// (required) Project ID or project name
+	Project *string
+	// (optional) [Provided for legacy reasons] The scope on which a subset of policies is defined.
+	Scope *string
+	// (optional) Filter returned policies to only this type
+	PolicyType *uuid.UUID
+	// (optional) Maximum number of policies to return.
+	Top *int
+	// (optional) The continuation token returned by the previous page.
+	ContinuationToken *string
+}
+
+// ListPolicyConfigurations returns the policy configurations of all pages
+func ListPolicyConfigurations(ctx context.Context, client Client, args GetPolicyConfigurationsArgs) ([]policy.PolicyConfiguration, error) {
+	var configs []policy.PolicyConfiguration
+	for {
+		resp, err := client.GetPolicyConfigurations(ctx, args)
+		if err != nil {
+			return nil, err
+		}
+		if resp == nil {
+			return configs, nil
+		}
+		configs = append(configs, resp.Value...)
+		if resp.ContinuationToken == "" {
+			return configs, nil
+		}
+		args.ContinuationToken = &resp.ContinuationToken
+	}
+}
@@ -0,0 +1,260 @@
+// The work item tracking $batch endpoint is not part of the generated SDK, it is documented at
+// https://learn.microsoft.com/en-us/rest/api/azure/devops/wit/work-items/update?view=azure-devops-rest-7.1 (Batch)
+// The chart configurations of work item queries are stored by the (undocumented) Reporting/ChartConfiguration
+// endpoint, which is the one used by the web UI.
+
+// This file cannot be under "internal", because azdosdkmocks/workitemtrackingextras_sdk_mock.go depends on it.
+
+package workitemtrackingextras
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/webapi"
+)
+
+// MaxBatchSize the maximum number of requests the service accepts in a single batch
+const MaxBatchSize = 200
+
+const batchApiVersion = "7.1"
+
+const chartApiVersion = "7.1-preview.1"
+
+// ChartScopeQueries the scope of the chart configurations of work item queries
+const ChartScopeQueries = "WorkitemTracking.Queries"
+
+type Client interface {
+	// Send a batch of work item requests in a single round trip.
+	SendBatch(context.Context, SendBatchArgs) (*[]BatchResponse, error)
+	// Create a chart configuration.
+	CreateChartConfiguration(context.Context, CreateChartConfigurationArgs) (*ChartConfiguration, error)
+	// Get the chart configurations of a group, e.g. of a work item query.
+	GetChartConfigurations(context.Context, GetChartConfigurationsArgs) (*[]ChartConfiguration, error)
+	// Update a chart configuration.
+	UpdateChartConfiguration(context.Context, UpdateChartConfigurationArgs) (*ChartConfiguration, error)
+	// Delete a chart configuration.
+	DeleteChartConfiguration(context.Context, DeleteChartConfigurationArgs) error
+}
+
+type ClientImpl struct {
+	Client  azuredevops.Client
+	BaseUrl string
+}
+
+func NewClient(ctx context.Context, connection *azuredevops.Connection) Client {
+	client := connection.GetClientByUrl(connection.BaseUrl)
+	return &ClientImpl{
+		Client:  *client,
+		BaseUrl: strings.TrimRight(connection.BaseUrl, "/"),
+	}
+}
+
+// SendBatch sends a batch of work item requests. The service executes the requests in order, a failing request
+// does not stop the execution of the remaining ones, so callers need to check the code of every response.
+func (client *ClientImpl) SendBatch(ctx context.Context, args SendBatchArgs) (*[]BatchResponse, error) {
+	if args.Requests == nil {
+		return nil, &azuredevops.ArgumentNilError{ArgumentName: "args.Requests"}
+	}
+	if len(*args.Requests) > MaxBatchSize {
+		return nil, fmt.Errorf("a batch can contain at most %d requests, got %d", MaxBatchSize, len(*args.Requests))
+	}
+
+	body, marshalErr := json.Marshal(*args.Requests)
+	if marshalErr != nil {
+		return nil, marshalErr
+	}
+
+	fullUrl := fmt.Sprintf("%s/_apis/wit/$batch?api-version=%s", client.BaseUrl, batchApiVersion)
+	req, err := client.Client.CreateRequestMessage(ctx, http.MethodPost, fullUrl, "", bytes.NewReader(body), "application/json", "application/json", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Client.SendRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseValue batchResponseCollection
+	err = client.Client.UnmarshalBody(resp, &responseValue)
+	if err != nil {
+		return nil, err
+	}
+	if responseValue.Value == nil {
+		return &[]BatchResponse{}, nil
+	}
+	return responseValue.Value, nil
+}
+
+// Arguments for the SendBatch function
+type SendBatchArgs struct {
+	// (required) The requests to execute, in order
+	Requests *[]BatchRequest
+}
+
+// NewCreateWorkItemRequest creates a batch request that creates a work item of the given type
+func NewCreateWorkItemRequest(project string, workItemType string, document []webapi.JsonPatchOperation) BatchRequest {
+	return newPatchRequest(fmt.Sprintf("/%s/_apis/wit/workitems/$%s?api-version=%s", url.PathEscape(project), url.PathEscape(workItemType), batchApiVersion), document)
+}
+
+// NewUpdateWorkItemRequest creates a batch request that updates an existing work item
+func NewUpdateWorkItemRequest(id int, document []webapi.JsonPatchOperation) BatchRequest {
+	return newPatchRequest(fmt.Sprintf("/_apis/wit/workitems/%d?api-version=%s", id, batchApiVersion), document)
+}
+
+func newPatchRequest(uri string, document []webapi.JsonPatchOperation) BatchRequest {
+	method := http.MethodPatch
+	return BatchRequest{
+		Method:  &method,
+		Uri:     &uri,
+		Headers: &map[string]string{"Content-Type": "application/json-patch+json"},
+		Body:    &document,
+	}
+}
+
+// CreateChartConfiguration creates a chart configuration
+func (client *ClientImpl) CreateChartConfiguration(ctx context.Context, args CreateChartConfigurationArgs) (*ChartConfiguration, error) {
+	if args.Project == nil || *args.Project == "" {
+		return nil, &azuredevops.ArgumentNilOrEmptyError{ArgumentName: "args.Project"}
+	}
+	if args.Chart == nil {
+		return nil, &azuredevops.ArgumentNilError{ArgumentName: "args.Chart"}
+	}
+
+	fullUrl := fmt.Sprintf("%s/%s/_apis/Reporting/ChartConfiguration?api-version=%s", client.BaseUrl, url.PathEscape(*args.Project), chartApiVersion)
+	var responseValue ChartConfiguration
+	if err := client.sendChartRequest(ctx, http.MethodPost, fullUrl, args.Chart, &responseValue); err != nil {
+		return nil, err
+	}
+	return &responseValue, nil
+}
+
+// Arguments for the CreateChartConfiguration function
+type CreateChartConfigurationArgs struct {
+	// (required) Project ID or project name
+	Project *string
+	// (required) The chart configuration to create
+	Chart *ChartConfiguration
+}
+
+// GetChartConfigurations returns the chart configurations of a group
+func (client *ClientImpl) GetChartConfigurations(ctx context.Context, args GetChartConfigurationsArgs) (*[]ChartConfiguration, error) {
+	if args.Project == nil || *args.Project == "" {
+		return nil, &azuredevops.ArgumentNilOrEmptyError{ArgumentName: "args.Project"}
+	}
+	if args.Scope == nil || *args.Scope == "" {
+		return nil, &azuredevops.ArgumentNilOrEmptyError{ArgumentName: "args.Scope"}
+	}
+	if args.GroupKey == nil || *args.GroupKey == "" {
+		return nil, &azuredevops.ArgumentNilOrEmptyError{ArgumentName: "args.GroupKey"}
+	}
+
+	queryParams := url.Values{}
+	queryParams.Add("scope", *args.Scope)
+	queryParams.Add("groupKey", *args.GroupKey)
+	queryParams.Add("api-version", chartApiVersion)
+	fullUrl := fmt.Sprintf("%s/%s/_apis/Reporting/ChartConfiguration?%s", client.BaseUrl, url.PathEscape(*args.Project), queryParams.Encode())
+
+	var responseValue chartConfigurationCollection
+	if err := client.sendChartRequest(ctx, http.MethodGet, fullUrl, nil, &responseValue); err != nil {
+		return nil, err
+	}
+	if responseValue.Value == nil {
+		return &[]ChartConfiguration{}, nil
+	}
+	return responseValue.Value, nil
+}
+
+// Arguments for the GetChartConfigurations function
+type GetChartConfigurationsArgs struct {
+	// (required) Project ID or project name
+	Project *string
+	// (required) The scope of the charts, e.g. ChartScopeQueries
+	Scope *string
+	// (required) The key of the group of the charts, e.g. the ID of a work item query
+	GroupKey *string
+}
+
+// UpdateChartConfiguration updates a chart configuration
+func (client *ClientImpl) UpdateChartConfiguration(ctx context.Context, args UpdateChartConfigurationArgs) (*ChartConfiguration, error) {
+	if args.Project == nil || *args.Project == "" {
+		return nil, &azuredevops.ArgumentNilOrEmptyError{ArgumentName: "args.Project"}
+	}
+	if args.Chart == nil || args.Chart.ChartId == nil {
+		return nil, &azuredevops.ArgumentNilError{ArgumentName: "args.Chart.ChartId"}
+	}
+
+	fullUrl := fmt.Sprintf("%s/%s/_apis/Reporting/ChartConfiguration/%s?api-version=%s", client.BaseUrl, url.PathEscape(*args.Project), url.PathEscape(*args.Chart.ChartId), chartApiVersion)
+	var responseValue ChartConfiguration
+	if err := client.sendChartRequest(ctx, http.MethodPut, fullUrl, args.Chart, &responseValue); err != nil {
+		return nil, err
+	}
+	return &responseValue, nil
+}
+
+// Arguments for the UpdateChartConfiguration function
+type UpdateChartConfigurationArgs struct {
+	// (required) Project ID or project name
+	Project *string
+	// (required) The chart configuration to update, identified by its chart ID
+	Chart *ChartConfiguration
+}
+
+// DeleteChartConfiguration deletes a chart configuration
+func (client *ClientImpl) DeleteChartConfiguration(ctx context.Context, args DeleteChartConfigurationArgs) error {
+	if args.Project == nil || *args.Project == "" {
+		return &azuredevops.ArgumentNilOrEmptyError{ArgumentName: "args.Project"}
+	}
+	if args.ChartId == nil || *args.ChartId == "" {
+		return &azuredevops.ArgumentNilOrEmptyError{ArgumentName: "args.ChartId"}
+	}
+
+	fullUrl := fmt.Sprintf("%s/%s/_apis/Reporting/ChartConfiguration/%s?api-version=%s", client.BaseUrl, url.PathEscape(*args.Project), url.PathEscape(*args.ChartId), chartApiVersion)
+	return client.sendChartRequest(ctx, http.MethodDelete, fullUrl, nil, nil)
+}
+
+// Arguments for the DeleteChartConfiguration function
+type DeleteChartConfigurationArgs struct {
+	// (required) Project ID or project name
+	Project *string
+	// (required) The ID of the chart configuration
+	ChartId *string
+}
+
+func (client *ClientImpl) sendChartRequest(ctx context.Context, method string, fullUrl string, body interface{}, responseValue interface{}) error {
+	var bodyReader *bytes.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		bodyReader = bytes.NewReader(data)
+	}
+
+	var req *http.Request
+	var err error
+	if bodyReader != nil {
+		req, err = client.Client.CreateRequestMessage(ctx, method, fullUrl, "", bodyReader, "application/json", "application/json", nil)
+	} else {
+		req, err = client.Client.CreateRequestMessage(ctx, method, fullUrl, "", nil, "", "application/json", nil)
+	}
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Client.SendRequest(req)
+	if err != nil {
+		return err
+	}
+	if responseValue == nil {
+		return nil
+	}
+	return client.Client.UnmarshalBody(resp, responseValue)
+}
@@ -0,0 +1,126 @@
+package workitemtrackingextras
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/webapi"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/workitemtracking"
+)
+
+// A single request of a work item batch
+type BatchRequest struct {
+	// HTTP method of the request, `PATCH` to create or update a work item
+	Method *string `json:"method,omitempty"`
+	// Relative URI of the request, e.g. `/{project}/_apis/wit/workitems/$Task?api-version=7.1`
+	Uri *string `json:"uri,omitempty"`
+	// Request headers
+	Headers *map[string]string `json:"headers,omitempty"`
+	// JSON patch document of the request
+	Body *[]webapi.JsonPatchOperation `json:"body,omitempty"`
+}
+
+// The response of a single request of a work item batch
+type BatchResponse struct {
+	// HTTP status code of the request
+	Code *int `json:"code,omitempty"`
+	// Response headers
+	Headers *map[string]string `json:"headers,omitempty"`
+	// Raw JSON response body
+	Body *string `json:"body,omitempty"`
+}
+
+type batchResponseCollection struct {
+	Count *int             `json:"count,omitempty"`
+	Value *[]BatchResponse `json:"value,omitempty"`
+}
+
+// IsSuccess returns true if the request of this response succeeded
+func (r BatchResponse) IsSuccess() bool {
+	return r.Code != nil && *r.Code >= http.StatusOK && *r.Code < http.StatusMultipleChoices
+}
+
+// WorkItem decodes the work item from a successful response
+func (r BatchResponse) WorkItem() (*workitemtracking.WorkItem, error) {
+	if r.Body == nil {
+		return nil, fmt.Errorf("batch response has no body")
+	}
+	var workItem workitemtracking.WorkItem
+	if err := json.Unmarshal([]byte(*r.Body), &workItem); err != nil {
+		return nil, err
+	}
+	return &workItem, nil
+}
+
+// Error returns the error message of a failed response
+func (r BatchResponse) Error() string {
+	code := 0
+	if r.Code != nil {
+		code = *r.Code
+	}
+	if r.Body == nil {
+		return fmt.Sprintf("status code %d", code)
+	}
+
+	var wrapped struct {
+		Message *string `json:"message,omitempty"`
+	}
+	if err := json.Unmarshal([]byte(*r.Body), &wrapped); err == nil && wrapped.Message != nil {
+		return fmt.Sprintf("status code %d: %s", code, *wrapped.Message)
+	}
+	return fmt.Sprintf("status code %d: %s", code, *r.Body)
+}
+
+// The configuration of a chart, e.g. of a work item query
+type ChartConfiguration struct {
+	// ID of the chart
+	ChartId *string `json:"chartId,omitempty"`
+	// Type of the chart, e.g. `pieChart`
+	ChartType *string `json:"chartType,omitempty"`
+	// Key of the group the chart belongs to, e.g. the ID of a work item query
+	GroupKey *string `json:"groupKey,omitempty"`
+	// Scope of the chart, e.g. `WorkitemTracking.Queries`
+	Scope *string `json:"scope,omitempty"`
+	// Title of the chart
+	Title *string `json:"title,omitempty"`
+	// Options of the transformation of the data of the chart
+	TransformOptions *ChartTransformOptions `json:"transformOptions,omitempty"`
+	// Colors of the chart series
+	UserColors *[]interface{} `json:"userColors,omitempty"`
+}
+
+// The transformation of the data of a chart
+type ChartTransformOptions struct {
+	// Filter of the data, e.g. the ID of a work item query
+	Filter *string `json:"filter,omitempty"`
+	// Field the data is grouped by
+	GroupBy *string `json:"groupBy,omitempty"`
+	// Order of the groups
+	OrderBy *ChartOrderBy `json:"orderBy,omitempty"`
+	// Measure of the groups
+	Measure *ChartMeasure `json:"measure,omitempty"`
+	// Field of the series of stacked and pivot charts
+	Series *string `json:"series,omitempty"`
+}
+
+// The order of the groups of a chart
+type ChartOrderBy struct {
+	// `ascending` or `descending`
+	Direction *string `json:"direction,omitempty"`
+	// `value` or `label`
+	PropertyName *string `json:"propertyName,omitempty"`
+}
+
+// The measure of the groups of a chart
+type ChartMeasure struct {
+	// `count` or `sum`
+	Aggregation *string `json:"aggregation,omitempty"`
+	// Field that is aggregated, only used by `sum`
+	PropertyName *string `json:"propertyName,omitempty"`
+}
+
+type chartConfigurationCollection struct {
+	Count *int                  `json:"count,omitempty"`
+	Value *[]ChartConfiguration `json:"value,omitempty"`
+}